@@ -0,0 +1,89 @@
+package main
+
+import (
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+)
+
+// exitCodeInterrupted is the exit code of a command interrupted twice, as set by shells for SIGINT
+const exitCodeInterrupted = 130
+
+// cancellation records why the context of the command has been cancelled
+type cancellation struct {
+	mu      sync.Mutex
+	reason  string
+	timeout time.Duration
+}
+
+const (
+	cancelledByInterrupt = "interrupt"
+	cancelledByTimeout   = "timeout"
+)
+
+func (c *cancellation) cancel(f *factory.Factory, reason string) {
+	c.mu.Lock()
+	if c.reason == "" {
+		c.reason = reason
+	}
+	c.mu.Unlock()
+	f.Cancel()
+}
+
+// err returns the error reported when the command failed after its context has been cancelled
+func (c *cancellation) err(f *factory.Factory, cmdErr error) error {
+	if cmdErr == nil || f.Context.Err() == nil {
+		return cmdErr
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch c.reason {
+	case cancelledByTimeout:
+		return f.Localizer.MustLocalizeError("main.cancel.error.timeout", localize.NewEntry("Timeout", c.timeout))
+	case cancelledByInterrupt:
+		return f.Localizer.MustLocalizeError("main.cancel.error.interrupted")
+	default:
+		return cmdErr
+	}
+}
+
+// handleInterrupts cancels the context of the command on the first Ctrl-C, so that running API calls
+// are interrupted and the command can report what it has done. A second Ctrl-C exits immediately.
+func handleInterrupts(f *factory.Factory, c *cancellation) {
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		<-signals
+		f.Logger.Info()
+		f.Logger.Info(f.Localizer.MustLocalize("main.cancel.log.info.interrupting"))
+		c.cancel(f, cancelledByInterrupt)
+
+		<-signals
+		os.Exit(exitCodeInterrupted)
+	}()
+}
+
+// startTimeout cancels the context of the command once the duration set with --command-timeout has elapsed
+func startTimeout(f *factory.Factory, c *cancellation) {
+	timeout := flagutil.CommandTimeout()
+	if timeout <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	c.timeout = timeout
+	c.mu.Unlock()
+
+	time.AfterFunc(timeout, func() {
+		c.cancel(f, cancelledByTimeout)
+	})
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/root"
+	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory/fakes"
+	"github.com/spf13/cobra"
+)
+
+// TestTimeouts checks that the --timeout of a wait command and the global --command-timeout both apply
+func TestTimeouts(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "wait timeout",
+			args:    []string{"--timeout", "50ms", "--command-timeout", "1h"},
+			wantErr: `timed out after 50ms waiting for "my-kafka"`,
+		},
+		{
+			name:    "command timeout",
+			args:    []string{"--timeout", "1h", "--command-timeout", "50ms"},
+			wantErr: "the command did not complete within the --command-timeout of 50ms",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := fakes.NewFactory(t)
+			// the flags of some commands are completed with the saved contexts
+			_ = f.ServiceContext.Save(&servicecontext.Context{})
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/kafkas_mgmt/v1/kafkas/1", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": "1", "name": "my-kafka", "status": "provisioning"})
+			})
+			f.WithServer(t, mux)
+
+			cancelled := &cancellation{}
+			rootCmd := root.NewRootCommand(f.Factory, "dev")
+			rootCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
+				startTimeout(f.Factory, cancelled)
+			}
+			rootCmd.SetArgs(append([]string{"kafka", "wait-for", "--id", "1"}, tt.args...))
+
+			err := cancelled.err(f.Factory, rootCmd.Execute())
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Execute() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
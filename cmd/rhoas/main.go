@@ -5,36 +5,66 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/root"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/setup"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
 
 	"github.com/redhat-developer/app-services-cli/internal/telemetry"
 	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/color"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize/goi18n"
+	"github.com/redhat-developer/app-services-cli/pkg/core/reproducer"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory/defaultfactory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/jobs"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/versioncheck"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/wtrocki/go-github-selfupdate/selfupdate"
 
 	"github.com/redhat-developer/app-services-cli/internal/build"
 )
 
+// updateCheckTimeout is how long to wait for the background update check once the command has finished
+const updateCheckTimeout = 500 * time.Millisecond
+
 func main() {
-	localizer, err := goi18n.New(nil)
+	lang, err := localize.GetLanguage(flagutil.LocaleFromArgs(os.Args[1:]))
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
 
+	// a missing message is reported instead of crashing the CLI, it is caught by "rhoas debug i18n-check" and the tests
+	localizer, err := goi18n.New(goi18n.NewConfigWithLanguage(lang).WithMissingMessageHandler(func(id string, err error) {
+		fmt.Fprintf(os.Stderr, "%vWarning: could not localize message %q: %v\n", icon.InfoPrefix(), id, err)
+	}))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	// the context file is exported so that it is also used by the processes started by the command
+	if contextFile := flagutil.ContextFileFromArgs(os.Args[1:]); contextFile != "" {
+		if err = os.Setenv(servicecontext.ContextFileEnvName, contextFile); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
 	buildVersion := build.Version
 	cmdFactory := defaultfactory.New(localizer)
 
-	if err = initConfig(cmdFactory); err != nil {
+	firstRun, err := initConfig(cmdFactory)
+	if err != nil {
 		cmdFactory.Logger.Errorf(localizer.MustLocalize("main.config.error", localize.NewEntry("Error", err)))
 		os.Exit(1)
 	}
@@ -47,28 +77,37 @@ func main() {
 	rootCmd := root.NewRootCommand(cmdFactory, buildVersion)
 	rootCmd.InitDefaultHelpCmd()
 
+	// offer the guided setup when rhoas is run for the first time without a command
+	if firstRun && len(os.Args) == 1 && cmdFactory.IOStreams.CanPrompt() {
+		if accepted, offerErr := setup.Offer(cmdFactory); offerErr == nil && accepted {
+			rootCmd.SetArgs([]string{"setup"})
+		}
+	}
+
 	err = executeCommandWithTelemetry(rootCmd, cmdFactory)
 
 	if err == nil {
 		return
 	}
 	cmdFactory.Logger.Errorf("%v\n", rootError(err, localizer))
+	saveReproducer(cmdFactory, rootCmd, buildVersion, err)
 	build.CheckForUpdate(context.Background(), build.Version, cmdFactory.Logger, localizer)
 	os.Exit(1)
 }
 
-func initConfig(f *factory.Factory) error {
+// initConfig creates the config file when it does not exist, and reports whether it was created
+func initConfig(f *factory.Factory) (bool, error) {
 	if !config.HasCustomLocation() {
 		rhoasCfgDir, err := config.DefaultDir()
 		if err != nil {
-			return err
+			return false, err
 		}
 
 		// create rhoas config directory
 		if _, err = os.Stat(rhoasCfgDir); os.IsNotExist(err) {
 			err = os.MkdirAll(rhoasCfgDir, 0o700)
 			if err != nil {
-				return err
+				return false, err
 			}
 		}
 	}
@@ -76,18 +115,18 @@ func initConfig(f *factory.Factory) error {
 	cfgFile, err := f.Config.Load()
 
 	if cfgFile != nil {
-		return err
+		return false, err
 	}
 
 	if !os.IsNotExist(err) {
-		return err
+		return false, err
 	}
 
 	cfgFile = &config.Config{}
 	if err := f.Config.Save(cfgFile); err != nil {
-		return err
+		return false, err
 	}
-	return nil
+	return true, nil
 }
 
 func initProfiles(f *factory.Factory) error {
@@ -144,6 +183,71 @@ func initProfiles(f *factory.Factory) error {
 	return nil
 }
 
+// initPager pages the output of the commands with the pager annotation through the pager set by,
+// in order of precedence, the RHOAS_PAGER environment variable, the config file and the PAGER environment variable
+func initPager(f *factory.Factory, cmd *cobra.Command) {
+	if _, pageable := cmd.Annotations[cmdutil.PagerAnnotation]; !pageable || flagutil.PagerDisabled() {
+		f.IOStreams.SetPager("")
+		return
+	}
+
+	if pager, ok := os.LookupEnv("RHOAS_PAGER"); ok {
+		f.IOStreams.SetPager(pager)
+	} else if cfg, err := f.Config.Load(); err == nil && cfg.Pager != "" {
+		f.IOStreams.SetPager(cfg.Pager)
+	} else {
+		f.IOStreams.SetPager(os.Getenv("PAGER"))
+	}
+
+	f.IOStreams.EnablePager()
+}
+
+// initColor disables colored output when the --no-color flag is set and selects the color theme from,
+// in order of precedence, the RHOAS_COLOR_THEME environment variable and the config file
+func initColor(f *factory.Factory) {
+	if flagutil.ColorDisabled() {
+		color.Disable()
+	}
+
+	if theme, ok := os.LookupEnv("RHOAS_COLOR_THEME"); ok {
+		color.SetTheme(theme)
+		return
+	}
+
+	if cfg, err := f.Config.Load(); err == nil && cfg.ColorTheme != "" {
+		color.SetTheme(cfg.ColorTheme)
+	}
+}
+
+// initTimes keeps the timestamps in tables when the output is not a terminal,
+// so that scripts parsing the table output get absolute times
+func initTimes(f *factory.Factory) {
+	if !f.IOStreams.IsStdoutTTY() {
+		dump.Timestamps = true
+	}
+}
+
+// saveReproducer writes the reproducer bundle of the failed command when the --save-reproducer flag is set
+func saveReproducer(f *factory.Factory, rootCmd *cobra.Command, version string, cmdErr error) {
+	path := flagutil.ReproducerFile()
+	if path == "" {
+		return
+	}
+
+	// the flags of the executed command are needed to redact secrets given by their shorthand
+	var flags *pflag.FlagSet
+	if cmd, _, err := rootCmd.Find(os.Args[1:]); err == nil {
+		flags = cmd.Flags()
+	}
+
+	bundle := reproducer.New(version, os.Args[1:], flags, cmdErr, f.HTTPRecorder.Exchanges())
+	if err := bundle.Write(path); err != nil {
+		f.Logger.Errorf(f.Localizer.MustLocalize("main.reproducer.error", localize.NewEntry("Error", err)))
+		return
+	}
+	f.Logger.Info(f.Localizer.MustLocalize("main.reproducer.saved", localize.NewEntry("FilePath", path)))
+}
+
 // rootError creates the root error which is printed to the console
 // it wraps the error which has been returned from subcommands with a prefix
 func rootError(err error, localizer localize.Localizer) error {
@@ -171,20 +275,181 @@ func executeCommandWithTelemetry(rootCmd *cobra.Command, cmdFactory *factory.Fac
 		os.Exit(1)
 	}
 	commandPath := ""
+	var latestRelease <-chan *selfupdate.Release
+	var versionChecks <-chan versioncheck.Check
+	cancelled := &cancellation{}
+	handleInterrupts(cmdFactory, cancelled)
 	rootCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
 		cmdFactory.Logger.SetDebug(flagutil.DebugEnabled())
+		initPager(cmdFactory, cmd)
+		initColor(cmdFactory)
+		initTimes(cmdFactory)
+		cmdFactory.Tracer.SetCommand(cmd.CommandPath())
+		cmdFactory.UserAgent.SetCommand(cmd.CommandPath())
+		cmdFactory.UserAgent.SetSuffix(flagutil.UserAgentSuffix())
+		startTimeout(cmdFactory, cancelled)
+		if _, offline := cmd.Annotations[cmdutil.OfflineAnnotation]; offline || isCompletionCommand(cmd) {
+			return
+		}
 		if cmd.Runnable() && !cmd.Hidden {
 			commandPath = cmd.CommandPath()
 		}
-		_, newErr := cmdutil.DoSelfUpdateOnceADay(cmdFactory)
-		if newErr != nil {
-			cmdFactory.Logger.Errorf(cmdFactory.Localizer.MustLocalize("main.update.error", localize.NewEntry("Error", newErr)))
+		if cmd.Name() != "upgrade" {
+			latestRelease = cmdutil.CheckForUpdateOnceADay(cmdFactory)
+		}
+		if !flagutil.VersionCheckSkipped() && !build.IsDevBuild() {
+			versionChecks = versioncheck.CheckOnceADay(cmdFactory)
 		}
 	}
+	jobID := os.Getenv(jobs.EnvJobID)
+	if jobID != "" {
+		recordJobStarted(cmdFactory, jobID)
+	}
+
 	err = rootCmd.Execute()
+	cmdFactory.IOStreams.StopPager()
+	err = cancelled.err(cmdFactory, err)
+
+	if jobID != "" {
+		recordJobFinished(cmdFactory, jobID, err)
+	}
+
+	warnDeprecatedEndpoints(cmdFactory)
+	exportTrace(cmdFactory, err)
 
 	if commandPath != "" {
 		telemetry.Finish(commandPath, err)
 	}
+	if versionChecks != nil {
+		warnVersionSkew(cmdFactory, versionChecks)
+	}
+	// on failure the update notice is printed together with the error
+	if err == nil && latestRelease != nil {
+		printUpdateNotice(cmdFactory, latestRelease)
+	}
 	return err
 }
+
+// exportTrace sends the spans of the command to the OTLP endpoint when tracing is enabled
+func exportTrace(f *factory.Factory, cmdErr error) {
+	f.Tracer.Finish(cmdErr)
+	// the trace is exported even when the command has been cancelled
+	if err := f.Tracer.Export(context.Background()); err != nil {
+		f.Logger.Debug("Could not export the trace:", err)
+	}
+}
+
+// recordJobStarted records the process running the background job
+func recordJobStarted(f *factory.Factory, id string) {
+	store, err := jobs.DefaultStore(f.Config)
+	if err == nil {
+		err = jobs.Started(store, id)
+	}
+	if err != nil {
+		f.Logger.Debug("Could not record the background job:", err)
+	}
+}
+
+// recordJobFinished records the result of the background job run by the current process
+func recordJobFinished(f *factory.Factory, id string, cmdErr error) {
+	store, err := jobs.DefaultStore(f.Config)
+	if err == nil {
+		err = jobs.Finish(store, id, cmdErr)
+	}
+	if err != nil {
+		f.Logger.Debug("Could not record the background job:", err)
+	}
+}
+
+// warnVersionSkew warns when the CLI is older than the oldest version supported by the API.
+// It waits briefly for the background check to complete so that it never delays the command noticeably,
+// a check which did not complete in time is recorded as failed.
+func warnVersionSkew(f *factory.Factory, checks <-chan versioncheck.Check) {
+	var check versioncheck.Check
+	select {
+	case c, ok := <-checks:
+		if !ok {
+			return
+		}
+		check = c
+	case <-time.After(updateCheckTimeout):
+		check = versioncheck.Check{Err: context.DeadlineExceeded}
+	}
+
+	if check.Err != nil {
+		f.Logger.Debug("Could not check the version supported by the API:", check.Err)
+	}
+	if err := versioncheck.Save(f, check); err != nil {
+		f.Logger.Debug("Could not save the version check:", err)
+	}
+
+	if versioncheck.IsOutdated(build.Version, check.MinVersion) {
+		f.Logger.Info(f.Localizer.MustLocalize("main.versionCheck.outdated",
+			localize.NewEntry("Version", build.Version),
+			localize.NewEntry("MinVersion", check.MinVersion),
+			localize.NewEntry("Command", color.CodeSnippet("rhoas upgrade"))))
+	}
+}
+
+// isCompletionCommand returns whether the command generates or serves shell completions,
+// which must answer immediately and not print anything but the completions
+func isCompletionCommand(cmd *cobra.Command) bool {
+	switch cmd.Name() {
+	case cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd:
+		return true
+	}
+	for c := cmd; c != nil; c = c.Parent() {
+		if c.Name() == "completion" {
+			return true
+		}
+	}
+	return false
+}
+
+// warnDeprecatedEndpoints warns about the API endpoints called by the command which the API reported as deprecated
+func warnDeprecatedEndpoints(f *factory.Factory) {
+	if flagutil.VersionCheckSkipped() {
+		return
+	}
+
+	for _, endpoint := range f.APIDeprecations.Endpoints() {
+		entries := []*localize.TemplateEntry{
+			localize.NewEntry("Method", endpoint.Method),
+			localize.NewEntry("Path", endpoint.Path),
+		}
+		messageID := "main.versionCheck.deprecatedEndpoint"
+		if endpoint.Sunset != "" {
+			messageID = "main.versionCheck.deprecatedEndpointSunset"
+			entries = append(entries, localize.NewEntry("Sunset", endpoint.Sunset))
+		}
+		f.Logger.Info(f.Localizer.MustLocalize(messageID, entries...))
+	}
+}
+
+// printUpdateNotice prints a notice when the background update check found a newer release.
+// It waits briefly for the check to complete so that it never delays the command noticeably.
+func printUpdateNotice(f *factory.Factory, latestRelease <-chan *selfupdate.Release) {
+	var release *selfupdate.Release
+	select {
+	case release = <-latestRelease:
+	case <-time.After(updateCheckTimeout):
+		return
+	}
+	if release == nil {
+		return
+	}
+
+	if cfg, err := f.Config.Load(); err == nil {
+		cfg.LastUpdated = time.Now().UnixMilli()
+		if err = f.Config.Save(cfg); err != nil {
+			f.Logger.Errorf(f.Localizer.MustLocalize("main.update.error", localize.NewEntry("Error", err)))
+		}
+	}
+
+	if !cmdutil.IsNewerRelease(release, build.Version) {
+		return
+	}
+	f.Logger.Info()
+	f.Logger.Info(color.Info(f.Localizer.MustLocalize("common.log.info.updateAvailable")), color.CodeSnippet(release.Version.String()))
+	f.Logger.Info(f.Localizer.MustLocalize("main.update.hint", localize.NewEntry("Command", color.CodeSnippet("rhoas upgrade"))))
+}
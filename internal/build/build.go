@@ -47,12 +47,20 @@ var (
 
 	// BuildSource is a unique key which indicates the infrastructure on which the binary was built
 	BuildSource = "local"
+
+	// Commit is the Git commit the binary was built from, read from the Go build info when not set
+	Commit = ""
+
+	// Date is the time at which the binary was built, in RFC 3339 format.
+	// The time of the commit is read from the Go build info when not set.
+	Date = ""
 )
 
 // Auth Build variables
 var (
 	ProductionAPIURL            = "https://api.openshift.com"
 	StagingAPIURL               = "https://api.stage.openshift.com"
+	IntegrationAPIURL           = "https://api.integration.openshift.com"
 	ConsoleURL                  = "https://console.redhat.com"
 	DefaultClientID             = "rhoas-cli-prod"
 	DefaultUserAgentPrefix      = "rhoas-cli_"
@@ -0,0 +1,54 @@
+package build
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+// Info describes the binary which is running
+type Info struct {
+	Version     string `json:"version" yaml:"version"`
+	Commit      string `json:"commit" yaml:"commit"`
+	Date        string `json:"build_date" yaml:"build_date"`
+	GoVersion   string `json:"go_version" yaml:"go_version"`
+	OS          string `json:"os" yaml:"os"`
+	Arch        string `json:"arch" yaml:"arch"`
+	CGOEnabled  bool   `json:"cgo_enabled" yaml:"cgo_enabled"`
+	BuildSource string `json:"build_source" yaml:"build_source"`
+}
+
+// GetInfo returns the metadata of the running binary.
+// The commit and date set at build time take precedence over the version control
+// information recorded by the Go toolchain, which is missing when building outside of a Git checkout.
+func GetInfo() *Info {
+	info := &Info{
+		Version:     Version,
+		Commit:      Commit,
+		Date:        Date,
+		GoVersion:   runtime.Version(),
+		OS:          runtime.GOOS,
+		Arch:        runtime.GOARCH,
+		BuildSource: BuildSource,
+	}
+
+	buildInfo, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+	for _, setting := range buildInfo.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			if info.Commit == "" {
+				info.Commit = setting.Value
+			}
+		case "vcs.time":
+			if info.Date == "" {
+				info.Date = setting.Value
+			}
+		case "CGO_ENABLED":
+			info.CGOEnabled = setting.Value == "1"
+		}
+	}
+
+	return info
+}
@@ -0,0 +1,90 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+// Error categories sent instead of the error message, which may contain personal data
+const (
+	ErrorCategoryCanceled     = "canceled"
+	ErrorCategoryNetwork      = "network"
+	ErrorCategoryUnauthorized = "unauthorized"
+	ErrorCategoryForbidden    = "forbidden"
+	ErrorCategoryNotFound     = "not_found"
+	ErrorCategoryConflict     = "conflict"
+	ErrorCategoryRateLimited  = "rate_limited"
+	ErrorCategoryClientError  = "client_error"
+	ErrorCategoryServerError  = "server_error"
+	ErrorCategoryUsage        = "usage"
+	ErrorCategoryOther        = "other"
+)
+
+// apiError is implemented by the errors of the generated API clients
+type apiError interface {
+	error
+	Body() []byte
+}
+
+// usageErrorPrefixes are the beginnings of the errors returned by cobra for invalid flags and arguments
+var usageErrorPrefixes = []string{"unknown flag", "unknown shorthand flag", "unknown command", "required flag", "invalid argument", "accepts ", "flag needs an argument"}
+
+// CategorizeError returns the category of the error, empty when there is no error
+func CategorizeError(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, terminal.InterruptErr) {
+		return ErrorCategoryCanceled
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return ErrorCategoryNetwork
+	}
+
+	var clientErr apiError
+	if errors.As(err, &clientErr) {
+		// the error of the generated clients is the HTTP status, for example "404 Not Found"
+		status, _, _ := strings.Cut(clientErr.Error(), " ")
+		if code, convErr := strconv.Atoi(status); convErr == nil {
+			return categorizeStatus(code)
+		}
+	}
+
+	message := err.Error()
+	for _, prefix := range usageErrorPrefixes {
+		if strings.HasPrefix(message, prefix) {
+			return ErrorCategoryUsage
+		}
+	}
+
+	return ErrorCategoryOther
+}
+
+func categorizeStatus(code int) string {
+	switch {
+	case code == 401:
+		return ErrorCategoryUnauthorized
+	case code == 403:
+		return ErrorCategoryForbidden
+	case code == 404:
+		return ErrorCategoryNotFound
+	case code == 409:
+		return ErrorCategoryConflict
+	case code == 429:
+		return ErrorCategoryRateLimited
+	case code >= 500:
+		return ErrorCategoryServerError
+	case code >= 400:
+		return ErrorCategoryClientError
+	default:
+		return ErrorCategoryOther
+	}
+}
@@ -0,0 +1,40 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+)
+
+type fakeAPIError struct {
+	status string
+}
+
+func (e fakeAPIError) Error() string { return e.status }
+func (e fakeAPIError) Body() []byte  { return nil }
+
+func TestCategorizeError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "no error", err: nil, want: ""},
+		{name: "canceled", err: fmt.Errorf("waiting: %w", context.Canceled), want: ErrorCategoryCanceled},
+		{name: "network", err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}, want: ErrorCategoryNetwork},
+		{name: "not found", err: fakeAPIError{"404 Not Found"}, want: ErrorCategoryNotFound},
+		{name: "server error", err: fakeAPIError{"503 Service Unavailable"}, want: ErrorCategoryServerError},
+		{name: "bad request", err: fakeAPIError{"400 Bad Request"}, want: ErrorCategoryClientError},
+		{name: "usage", err: errors.New(`unknown flag: --nme`), want: ErrorCategoryUsage},
+		{name: "other", err: errors.New(`Kafka instance "my-kafka" not found`), want: ErrorCategoryOther},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CategorizeError(tt.err); got != tt.want {
+				t.Errorf("CategorizeError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
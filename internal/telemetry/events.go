@@ -0,0 +1,62 @@
+package telemetry
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+)
+
+const (
+	// eventsFileName is the file next to the config file which keeps the events sent
+	eventsFileName = "telemetry_events.json"
+	// maxStoredEvents is the number of events kept in the events file
+	maxStoredEvents = 50
+)
+
+// EventsFilePath returns the path of the file which keeps the most recent events sent
+func EventsFilePath(cfg config.IConfig) (string, error) {
+	location, err := cfg.Location()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(location), eventsFileName), nil
+}
+
+// LoadEvents returns the most recent events sent, oldest first
+func LoadEvents(path string) ([]TelemetryData, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return []TelemetryData{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var events []TelemetryData
+	if err = json.Unmarshal(data, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// storeEvent appends the event to the events file, dropping the oldest events
+func storeEvent(path string, event TelemetryData) error {
+	events, err := LoadEvents(path)
+	if err != nil {
+		// start again when the file is corrupted
+		events = []TelemetryData{}
+	}
+
+	events = append(events, event)
+	if len(events) > maxStoredEvents {
+		events = events[len(events)-maxStoredEvents:]
+	}
+
+	data, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
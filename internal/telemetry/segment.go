@@ -13,12 +13,12 @@ var writeKey = "DBNhpBzdrdof9K7g8OJcvfDfAJnBs1Sb"
 
 // TelemetryProperties contains all of the properties that are sent as the telemetry data
 type TelemetryProperties struct {
-	Duration int64  `json:"duration"`
-	Error    string `json:"error"`
-	Success  bool   `json:"success"`
-	TTY      bool   `json:"tty"`
-	Version  string `json:"version"`
-	OS       string `json:"os"`
+	Duration      int64  `json:"duration"`
+	ErrorCategory string `json:"error_category"`
+	Success       bool   `json:"success"`
+	TTY           bool   `json:"tty"`
+	Version       string `json:"version"`
+	OS            string `json:"os"`
 }
 
 // TelemetryData contains all of the data that is sent to Segment for telemetry
@@ -84,7 +84,7 @@ func (c *TelemetryClient) Upload(data *TelemetryData) error {
 		Set("duration(ms)", data.Properties.Duration).
 		Set("tty", data.Properties.TTY).
 		Set("os", data.Properties.OS).
-		Set("error", data.Properties.Error)
+		Set("error_category", data.Properties.ErrorCategory)
 
 	// queue the data that has telemetry information
 	return c.SegmentClient.Enqueue(analytics.Track{
@@ -8,7 +8,6 @@ import (
 
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
 
-	"github.com/AlecAivazis/survey/v2"
 	"github.com/redhat-developer/app-services-cli/internal/build"
 )
 
@@ -19,10 +18,15 @@ type Telemetry struct {
 	enabled       bool
 }
 
-// ControlTelemetryEnv is name of environment variable, if set to false it disables telemetry completely
-// hiding even the question
+// ControlTelemetryEnv is name of environment variable, it overrides the telemetry setting of the config file
 const ControlTelemetryEnv = "RHOAS_TELEMETRY"
 
+// Values of the telemetry setting in the config file
+const (
+	ConfigEnabled  = "enabled"
+	ConfigDisabled = "disabled"
+)
+
 // Start collecting telemetry data
 func CreateTelemetry(f *factory.Factory) (*Telemetry, error) {
 	t := &Telemetry{factory: f}
@@ -54,12 +58,6 @@ func (t *Telemetry) Init() error {
 		return nil
 	}
 
-	// if we are in non TTY mode - disable
-	if !t.factory.IOStreams.CanPrompt() {
-		t.enabled = false
-		return nil
-	}
-
 	// We have developer build - disable
 	if build.IsDevBuild() {
 		t.enabled = false
@@ -71,29 +69,8 @@ func (t *Telemetry) Init() error {
 		return err
 	}
 
-	// Check if device had seen telemetry consent
-	if cfg.Telemetry == "" {
-		var consentTelemetry bool
-		t.factory.Logger.Info(t.factory.Localizer.MustLocalize("common.telemetry.consent"))
-		prompt := &survey.Confirm{Message: t.factory.Localizer.MustLocalize("common.telemetry.question"), Default: false}
-		err = survey.AskOne(prompt, &consentTelemetry, nil)
-		if err != nil {
-			return err
-		}
-		t.enabled = consentTelemetry
-		if consentTelemetry {
-			cfg.Telemetry = "enabled"
-		} else {
-			cfg.Telemetry = "disabled"
-		}
-
-		err = t.factory.Config.Save(cfg)
-		if err != nil {
-			return err
-		}
-		return nil
-	}
-	t.enabled = cfg.Telemetry != "disabled"
+	// telemetry is only sent when the user opted in with "rhoas config set telemetry on"
+	t.enabled = cfg.Telemetry == ConfigEnabled
 
 	return nil
 }
@@ -108,7 +85,14 @@ func (t *Telemetry) Finish(event string, cmdError error) {
 	// convert to milliseconds
 	t.telemetryData.Properties.Duration = (time.Now().UnixNano() - t.telemetryData.Properties.Duration) / 1000000
 	t.telemetryData.Properties.Success = cmdError == nil
-	t.telemetryData.Properties.Error = SanitizeError(cmdError)
+	t.telemetryData.Properties.ErrorCategory = CategorizeError(cmdError)
+
+	// keep a local copy of the event so that it can be inspected with "rhoas telemetry show"
+	if path, err := EventsFilePath(t.factory.Config); err == nil {
+		if err = storeEvent(path, *t.telemetryData); err != nil {
+			t.factory.Logger.Debug("Cannot store telemetry event", err)
+		}
+	}
 
 	telemetryClient, err := NewClient()
 	if err != nil {
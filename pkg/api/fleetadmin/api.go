@@ -0,0 +1,185 @@
+package fleetadmin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Kafka is a Kafka instance as seen by the admin API, across all organizations
+type Kafka struct {
+	ID                  string     `json:"id" yaml:"id"`
+	Name                string     `json:"name" yaml:"name"`
+	Owner               string     `json:"owner" yaml:"owner"`
+	OrganisationID      string     `json:"organisation_id" yaml:"organisation_id"`
+	Status              string     `json:"status" yaml:"status"`
+	FailedReason        string     `json:"failed_reason,omitempty" yaml:"failed_reason,omitempty"`
+	CloudProvider       string     `json:"cloud_provider" yaml:"cloud_provider"`
+	Region              string     `json:"region" yaml:"region"`
+	MultiAZ             bool       `json:"multi_az" yaml:"multi_az"`
+	InstanceType        string     `json:"instance_type" yaml:"instance_type"`
+	SizeID              string     `json:"size_id,omitempty" yaml:"size_id,omitempty"`
+	ClusterID           string     `json:"cluster_id,omitempty" yaml:"cluster_id,omitempty"`
+	Namespace           string     `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	Version             string     `json:"version,omitempty" yaml:"version,omitempty"`
+	DesiredKafkaVersion string     `json:"desired_kafka_version,omitempty" yaml:"desired_kafka_version,omitempty"`
+	ActualKafkaVersion  string     `json:"actual_kafka_version,omitempty" yaml:"actual_kafka_version,omitempty"`
+	KafkaUpgrading      bool       `json:"kafka_upgrading" yaml:"kafka_upgrading"`
+	BootstrapServerHost string     `json:"bootstrap_server_host,omitempty" yaml:"bootstrap_server_host,omitempty"`
+	CreatedAt           *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	UpdatedAt           *time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
+	ExpiresAt           *time.Time `json:"expires_at,omitempty" yaml:"expires_at,omitempty"`
+}
+
+// KafkaList is a page of Kafka instances returned by the admin API
+type KafkaList struct {
+	Kind  string  `json:"kind" yaml:"kind"`
+	Page  int     `json:"page" yaml:"page"`
+	Size  int     `json:"size" yaml:"size"`
+	Total int     `json:"total" yaml:"total"`
+	Items []Kafka `json:"items" yaml:"items"`
+}
+
+// KafkaUpdateRequest is the change of a Kafka instance requested through the admin API
+type KafkaUpdateRequest struct {
+	KafkaVersion string `json:"kafka_version,omitempty"`
+}
+
+// FleetAdminAPI defines a collection of APIs grouped under the fleet manager admin API.
+// Only tokens with an admin role of the fleet manager are allowed to use it.
+type FleetAdminAPI struct {
+	KafkaAPI func() KafkaAPI
+}
+
+// KafkaAPI is the API definition for the admin Kafka API
+type KafkaAPI interface {
+	GetKafkas(ctx context.Context, opts ...QueryParam) (*KafkaList, *http.Response, error)
+	GetKafkaByID(ctx context.Context, id string) (*Kafka, *http.Response, error)
+	UpdateKafka(ctx context.Context, id string, update KafkaUpdateRequest) (*Kafka, *http.Response, error)
+}
+
+// Config defines the available configuration options
+// to customize the API client settings
+type Config struct {
+	// HTTPClient is a custom HTTP client
+	HTTPClient *http.Client
+	// Debug enables debug-level logging
+	Debug bool
+	// BaseURL sets a custom API server base URL
+	BaseURL *url.URL
+}
+
+const kafkasPath = "/api/kafkas_mgmt/v1/admin/kafkas"
+
+// NewKafkaAPIClient returns a new admin Kafka API client
+// using a custom config
+func NewKafkaAPIClient(cfg *Config) KafkaAPI {
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+
+	c := APIClient{
+		baseURL:    cfg.BaseURL,
+		httpClient: cfg.HTTPClient,
+	}
+
+	return &c
+}
+
+type APIClient struct {
+	httpClient *http.Client
+	baseURL    *url.URL
+}
+
+// GetKafkas returns a page of the Kafka instances of all organizations
+func (c *APIClient) GetKafkas(ctx context.Context, opts ...QueryParam) (*KafkaList, *http.Response, error) {
+	var kafkaList KafkaList
+	resp, err := c.do(ctx, "GET", resolveURI(c.baseURL, kafkasPath, opts...), nil, &kafkaList)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &kafkaList, resp, nil
+}
+
+// GetKafkaByID returns a Kafka instance of any organization
+func (c *APIClient) GetKafkaByID(ctx context.Context, id string) (*Kafka, *http.Response, error) {
+	var kafka Kafka
+	resp, err := c.do(ctx, "GET", resolveURI(c.baseURL, kafkasPath+"/"+url.PathEscape(id)), nil, &kafka)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &kafka, resp, nil
+}
+
+// UpdateKafka changes a Kafka instance of any organization, such as its Kafka version
+func (c *APIClient) UpdateKafka(ctx context.Context, id string, update KafkaUpdateRequest) (*Kafka, *http.Response, error) {
+	var kafka Kafka
+	resp, err := c.do(ctx, "PATCH", resolveURI(c.baseURL, kafkasPath+"/"+url.PathEscape(id)), update, &kafka)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &kafka, resp, nil
+}
+
+func (c *APIClient) do(ctx context.Context, method string, u *url.URL, body interface{}, v interface{}) (*http.Response, error) {
+	var reqBody bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&reqBody).Encode(body); err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequest(method, u.String(), &reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return resp, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return resp, errors.New(resp.Status)
+	}
+
+	return resp, json.NewDecoder(resp.Body).Decode(v)
+}
+
+// QueryParam is a function defining the query param options return signature
+type QueryParam func() (key string, value string)
+
+// WithQueryParam accepts a string query parameter
+func WithQueryParam(key string, value string) QueryParam {
+	return func() (string, string) {
+		return key, value
+	}
+}
+
+// resolveURI builds and returns a URI with query parameters
+func resolveURI(baseURL *url.URL, path string, opts ...QueryParam) *url.URL {
+	rel := url.URL{Path: path}
+	u := baseURL.ResolveReference(&rel)
+	q := u.Query()
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		key, val := opt()
+		q.Set(key, val)
+	}
+	u.RawQuery = q.Encode()
+
+	return u
+}
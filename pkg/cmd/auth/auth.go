@@ -0,0 +1,26 @@
+package auth
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/auth/refreshofflinetoken"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/auth/sessions"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+// NewAuthCommand creates a new command to inspect and renew the stored credentials
+func NewAuthCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "auth",
+		Short:   f.Localizer.MustLocalize("auth.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("auth.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("auth.cmd.example"),
+		Args:    cobra.NoArgs,
+	}
+
+	cmd.AddCommand(
+		sessions.NewSessionsCommand(f),
+		refreshofflinetoken.NewRefreshOfflineTokenCommand(f),
+	)
+
+	return cmd
+}
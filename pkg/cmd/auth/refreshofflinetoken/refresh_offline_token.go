@@ -0,0 +1,141 @@
+package refreshofflinetoken
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/internal/build"
+	"github.com/redhat-developer/app-services-cli/pkg/core/auth/token"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/browser"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	offlineToken string
+
+	f *factory.Factory
+}
+
+// NewRefreshOfflineTokenCommand creates a new command to replace the stored offline token with a new one
+func NewRefreshOfflineTokenCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "refresh-offline-token",
+		Short:   f.Localizer.MustLocalize("auth.refreshOfflineToken.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("auth.refreshOfflineToken.cmd.longDescription", localize.NewEntry("OfflineTokenURL", build.OfflineTokenURL)),
+		Example: f.Localizer.MustLocalize("auth.refreshOfflineToken.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.offlineToken == "" && !f.IOStreams.CanPrompt() {
+				return flagutil.RequiredWhenNonInteractiveError("token")
+			}
+
+			return runRefresh(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, f.Localizer)
+	flags.AddSecret(&opts.offlineToken, "token", "t", f.IOStreams.In, f.Localizer.MustLocalize("auth.refreshOfflineToken.flag.token", localize.NewEntry("OfflineTokenURL", build.OfflineTokenURL)))
+
+	return cmd
+}
+
+func runRefresh(opts *options) error {
+	f := opts.f
+
+	cfg, err := f.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	if cfg.APIUrl == "" || (cfg.AccessToken == "" && cfg.RefreshToken == "") {
+		return f.Localizer.MustLocalizeError("auth.refreshOfflineToken.error.notLoggedIn")
+	}
+
+	if opts.offlineToken == "" {
+		if opts.offlineToken, err = promptOfflineToken(opts); err != nil {
+			return err
+		}
+	}
+
+	offlineToken := strings.TrimSpace(opts.offlineToken)
+	if err = validateOfflineToken(offlineToken, time.Now()); err != nil {
+		return f.Localizer.MustLocalizeError("auth.refreshOfflineToken.error.invalidToken", localize.NewEntry("Error", err))
+	}
+
+	// only the credentials are swapped, the API and authentication URLs and the service contexts are kept
+	previous := *cfg
+	cfg.RefreshToken = offlineToken
+	cfg.AccessToken = ""
+	if cfg.ClientID == "" || cfg.ClientID == build.DefaultClientID {
+		cfg.ClientID = build.DefaultOfflineTokenClientID
+	}
+
+	if err = f.Config.Save(cfg); err != nil {
+		return err
+	}
+
+	if err = exchangeOfflineToken(f); err != nil {
+		if restoreErr := f.Config.Save(&previous); restoreErr != nil {
+			f.Logger.Debug("Could not restore the previous credentials:", restoreErr)
+		}
+		return f.Localizer.MustLocalizeError("auth.refreshOfflineToken.error.exchangeFailed", localize.NewEntry("Error", err))
+	}
+
+	f.Logger.Info(icon.SuccessPrefix(), f.Localizer.MustLocalize("auth.refreshOfflineToken.log.info.refreshed"))
+
+	return nil
+}
+
+// promptOfflineToken opens the page where the user can load a new offline token and asks for it
+func promptOfflineToken(opts *options) (string, error) {
+	f := opts.f
+
+	f.Logger.Info(f.Localizer.MustLocalize("auth.refreshOfflineToken.log.info.openingBrowser", localize.NewEntry("OfflineTokenURL", build.OfflineTokenURL)))
+	if err := browser.Open(build.OfflineTokenURL); err != nil {
+		f.Logger.Debug("Could not open the browser:", err)
+	}
+
+	prompt := &survey.Password{
+		Message: f.Localizer.MustLocalize("auth.refreshOfflineToken.input.token.message"),
+	}
+
+	var offlineToken string
+	err := survey.AskOne(prompt, &offlineToken, survey.WithValidator(survey.Required))
+
+	return offlineToken, err
+}
+
+// exchangeOfflineToken checks the new offline token by exchanging it for an access token,
+// which is stored in the config by the connection
+func exchangeOfflineToken(f *factory.Factory) error {
+	conn, err := f.Connection()
+	if err != nil {
+		return err
+	}
+
+	return conn.RefreshTokens(f.Context)
+}
+
+// validateOfflineToken checks that the offline token is a JWT which has not expired
+func validateOfflineToken(offlineToken string, now time.Time) error {
+	expires, left, err := token.GetExpiry(offlineToken, now)
+	if err != nil {
+		return err
+	}
+
+	if expires && left <= 0 {
+		return errors.New("the token has expired")
+	}
+
+	return nil
+}
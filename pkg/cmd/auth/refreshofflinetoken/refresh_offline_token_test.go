@@ -0,0 +1,37 @@
+package refreshofflinetoken
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+func Test_validateOfflineToken(t *testing.T) {
+	now := time.Now()
+	signed := func(claims jwt.MapClaims) string {
+		s, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{name: "token without expiry", token: signed(jwt.MapClaims{"typ": "Offline"})},
+		{name: "token not expired", token: signed(jwt.MapClaims{"exp": now.Add(time.Hour).Unix()})},
+		{name: "expired token", token: signed(jwt.MapClaims{"exp": now.Add(-time.Hour).Unix()}), wantErr: true},
+		{name: "not a JWT", token: "not-a-token", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateOfflineToken(tt.token, now); (err != nil) != tt.wantErr {
+				t.Errorf("validateOfflineToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
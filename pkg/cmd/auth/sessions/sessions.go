@@ -0,0 +1,154 @@
+package sessions
+
+import (
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/auth/token"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+const (
+	statusValid   = "valid"
+	statusExpired = "expired"
+	statusInvalid = "invalid"
+)
+
+// credentialRow describes a stored credential
+type credentialRow struct {
+	Credential string `json:"credential" yaml:"credential" header:"Credential"`
+	Username   string `json:"username,omitempty" yaml:"username,omitempty" header:"Username"`
+	ExpiresAt  string `json:"expires_at,omitempty" yaml:"expires_at,omitempty" header:"Expires At"`
+	Status     string `json:"status" yaml:"status" header:"Status"`
+}
+
+// sessions describes the credentials and contexts stored by the CLI
+type sessions struct {
+	ConfigFile   string          `json:"config_file" yaml:"config_file"`
+	ContextFile  string          `json:"context_file" yaml:"context_file"`
+	APIURL       string          `json:"api_url,omitempty" yaml:"api_url,omitempty"`
+	AuthURL      string          `json:"auth_url,omitempty" yaml:"auth_url,omitempty"`
+	Credentials  []credentialRow `json:"credentials" yaml:"credentials"`
+	ContextCount int             `json:"context_count" yaml:"context_count"`
+}
+
+type options struct {
+	outputFormat string
+
+	f *factory.Factory
+}
+
+// NewSessionsCommand creates a new command to view the stored credentials and when they expire
+func NewSessionsCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "sessions",
+		Short:   f.Localizer.MustLocalize("auth.sessions.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("auth.sessions.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("auth.sessions.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			return runSessions(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, f.Localizer)
+	flags.AddOutput(&opts.outputFormat)
+
+	return cmd
+}
+
+func runSessions(opts *options) error {
+	f := opts.f
+
+	cfg, err := f.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	result := sessions{
+		APIURL:      cfg.APIUrl,
+		AuthURL:     cfg.AuthURL,
+		Credentials: []credentialRow{},
+	}
+
+	if result.ConfigFile, err = f.Config.Location(); err != nil {
+		return err
+	}
+	if result.ContextFile, err = f.ServiceContext.Location(); err != nil {
+		return err
+	}
+
+	if svcContext, err := f.ServiceContext.Load(); err == nil {
+		result.ContextCount = len(svcContext.Contexts)
+	}
+
+	now := time.Now()
+	if cfg.AccessToken != "" {
+		result.Credentials = append(result.Credentials, newCredentialRow(f.Localizer.MustLocalize("auth.sessions.credential.accessToken"), cfg.AccessToken, now))
+	}
+	if cfg.RefreshToken != "" {
+		result.Credentials = append(result.Credentials, newCredentialRow(f.Localizer.MustLocalize("auth.sessions.credential.refreshToken"), cfg.RefreshToken, now))
+	}
+
+	if opts.outputFormat != dump.EmptyFormat {
+		return dump.Formatted(f.IOStreams.Out, opts.outputFormat, result)
+	}
+
+	if len(result.Credentials) == 0 {
+		f.Logger.Info(f.Localizer.MustLocalize("auth.sessions.log.info.noCredentials"))
+	} else {
+		rows := make([]credentialRow, len(result.Credentials))
+		for i, row := range result.Credentials {
+			row.Username = dump.OrPlaceholder(row.Username)
+			row.ExpiresAt = dump.OrPlaceholder(row.ExpiresAt)
+			rows[i] = row
+		}
+		dump.Table(f.IOStreams.Out, rows)
+		f.Logger.Info("")
+	}
+
+	f.Logger.Info(f.Localizer.MustLocalize("auth.sessions.log.info.files",
+		localize.NewEntry("ConfigFile", result.ConfigFile),
+		localize.NewEntry("ContextFile", result.ContextFile),
+		localize.NewEntry("ContextCount", result.ContextCount),
+	))
+
+	return nil
+}
+
+func newCredentialRow(name string, tokenStr string, now time.Time) credentialRow {
+	row := credentialRow{
+		Credential: name,
+		Status:     statusValid,
+	}
+
+	if username, ok := token.GetUsername(tokenStr); ok {
+		row.Username = username
+	}
+
+	expires, left, err := token.GetExpiry(tokenStr, now)
+	switch {
+	case err != nil:
+		row.Status = statusInvalid
+	case !expires:
+		// offline tokens do not expire until they are revoked
+	default:
+		row.ExpiresAt = now.Add(left).Format(time.RFC3339)
+		if left <= 0 {
+			row.Status = statusExpired
+		}
+	}
+
+	return row
+}
@@ -0,0 +1,99 @@
+package sessions
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory/fakes"
+)
+
+func Test_newCredentialRow(t *testing.T) {
+	now := time.Now()
+	signed := func(claims jwt.MapClaims) string {
+		s, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	tests := []struct {
+		name          string
+		token         string
+		wantUsername  string
+		wantExpiresAt string
+		wantStatus    string
+	}{
+		{
+			name:          "valid token",
+			token:         signed(jwt.MapClaims{"preferred_username": "alice", "exp": now.Add(time.Hour).Unix()}),
+			wantUsername:  "alice",
+			wantExpiresAt: now.Add(time.Hour).Format(time.RFC3339),
+			wantStatus:    statusValid,
+		},
+		{
+			name:          "expired token",
+			token:         signed(jwt.MapClaims{"exp": now.Add(-time.Hour).Unix()}),
+			wantExpiresAt: now.Add(-time.Hour).Format(time.RFC3339),
+			wantStatus:    statusExpired,
+		},
+		{
+			name:       "offline token without expiry",
+			token:      signed(jwt.MapClaims{"username": "bob"}),
+			wantStatus: statusValid,
+		},
+		{
+			name:       "malformed token",
+			token:      "not-a-token",
+			wantStatus: statusInvalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row := newCredentialRow("Access token", tt.token, now)
+			if row.Credential != "Access token" {
+				t.Errorf("Credential = %q, want %q", row.Credential, "Access token")
+			}
+			if tt.wantUsername != "" && row.Username != tt.wantUsername {
+				t.Errorf("Username = %q, want %q", row.Username, tt.wantUsername)
+			}
+			if row.ExpiresAt != tt.wantExpiresAt {
+				t.Errorf("ExpiresAt = %q, want %q", row.ExpiresAt, tt.wantExpiresAt)
+			}
+			if row.Status != tt.wantStatus {
+				t.Errorf("Status = %q, want %q", row.Status, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestSessionsCommand(t *testing.T) {
+	f := fakes.NewFactory(t)
+	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"exp": time.Now().Add(-time.Hour).Unix()}).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = f.Config.Save(&config.Config{AccessToken: accessToken, APIUrl: "https://api.example.com"})
+	_ = f.ServiceContext.Save(&servicecontext.Context{Contexts: map[string]servicecontext.ServiceConfig{"a": {}, "b": {}}})
+
+	cmd := NewSessionsCommand(f.Factory)
+	cmd.SetArgs([]string{"-o", "json"})
+	if err = cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	var got sessions
+	if err = json.Unmarshal(f.Out.Bytes(), &got); err != nil {
+		t.Fatalf("invalid output %q: %v", f.Out.String(), err)
+	}
+	if got.APIURL != "https://api.example.com" || got.ContextCount != 2 {
+		t.Errorf("APIURL = %q, ContextCount = %v", got.APIURL, got.ContextCount)
+	}
+	if len(got.Credentials) != 1 || got.Credentials[0].Status != statusExpired {
+		t.Errorf("Credentials = %+v, want one expired access token", got.Credentials)
+	}
+}
@@ -3,6 +3,7 @@ package clean
 import (
 	"context"
 
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/confirm"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
@@ -53,7 +54,7 @@ func NewCleanCommand(f *factory.Factory) *cobra.Command {
 
 	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", opts.localizer.MustLocalize("cluster.common.flag.kubeconfig.description"))
 	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", "", opts.localizer.MustLocalize("cluster.common.flag.namespace.description"))
-	cmd.Flags().BoolVarP(&opts.forceCreationWithoutAsk, "yes", "y", false, opts.localizer.MustLocalize("cluster.common.flag.yes.description"))
+	cmd.Flags().BoolVarP(&opts.forceCreationWithoutAsk, "yes", "y", confirm.DefaultYes(), opts.localizer.MustLocalize("cluster.common.flag.yes.description"))
 	return cmd
 }
 
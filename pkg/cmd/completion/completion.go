@@ -5,6 +5,8 @@ import (
 
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/completion/bash"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/completion/fish"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/completion/install"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/completion/metadata"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/completion/powershell"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/completion/zsh"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
@@ -24,6 +26,8 @@ func NewCompletionCommand(f *factory.Factory) *cobra.Command {
 		zsh.NewCommand(f),
 		fish.NewCommand(f),
 		powershell.NewCommand(f),
+		install.NewCommand(f),
+		metadata.NewCommand(f),
 	)
 
 	return cmd
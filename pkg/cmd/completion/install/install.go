@@ -0,0 +1,186 @@
+package install
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+const (
+	shellBash       = "bash"
+	shellZsh        = "zsh"
+	shellFish       = "fish"
+	shellPowershell = "powershell"
+)
+
+var validShells = []string{shellBash, shellZsh, shellFish}
+
+type options struct {
+	shell string
+	path  string
+
+	f *factory.Factory
+}
+
+// location is where the completion script of a shell is installed
+type location struct {
+	path string
+	// hint is the ID of the message telling what else is needed for the shell to load the script, if anything
+	hint string
+}
+
+// NewCommand creates a command which installs the completion script for the shell of the user
+func NewCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:         "install",
+		Short:       f.Localizer.MustLocalize("completion.install.cmd.shortDescription"),
+		Long:        f.Localizer.MustLocalize("completion.install.cmd.longDescription"),
+		Example:     f.Localizer.MustLocalize("completion.install.cmd.example"),
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{cmdutil.OfflineAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.shell == "" {
+				opts.shell = detectShell(os.Getenv("SHELL"), runtime.GOOS)
+			}
+
+			if opts.shell == shellPowershell {
+				return f.Localizer.MustLocalizeError("completion.install.error.powershell")
+			}
+			if opts.shell == "" {
+				return f.Localizer.MustLocalizeError("completion.install.error.unknownShell")
+			}
+			if !flagutil.IsValidInput(opts.shell, validShells...) {
+				return flagutil.InvalidValueError("shell", opts.shell, validShells...)
+			}
+
+			return runInstall(opts, cmd.Root())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.shell, "shell", "", flagutil.FlagDescription(f.Localizer, "completion.install.flag.shell.description", validShells...))
+	cmd.Flags().StringVar(&opts.path, "path", "", f.Localizer.MustLocalize("completion.install.flag.path.description"))
+
+	flagutil.EnableStaticFlagCompletion(cmd, "shell", validShells)
+
+	return cmd
+}
+
+func runInstall(opts *options, root *cobra.Command) error {
+	f := opts.f
+
+	var script bytes.Buffer
+	var err error
+	switch opts.shell {
+	case shellBash:
+		err = root.GenBashCompletion(&script)
+	case shellZsh:
+		err = root.GenZshCompletion(&script)
+	case shellFish:
+		err = root.GenFishCompletion(&script, true)
+	}
+	if err != nil {
+		return err
+	}
+
+	loc := location{path: opts.path}
+	if loc.path == "" {
+		home, homeErr := os.UserHomeDir()
+		if homeErr != nil {
+			return homeErr
+		}
+		loc = completionLocation(opts.shell, home, os.Getenv, brewPrefix())
+	}
+
+	if err = os.MkdirAll(filepath.Dir(loc.path), 0o755); err != nil {
+		return err
+	}
+	// nolint:gosec
+	if err = os.WriteFile(loc.path, script.Bytes(), 0o644); err != nil {
+		return err
+	}
+
+	f.Logger.Info(icon.SuccessPrefix(), f.Localizer.MustLocalize("completion.install.log.info.installed",
+		localize.NewEntry("Shell", opts.shell),
+		localize.NewEntry("Path", loc.path),
+	))
+	if loc.hint != "" {
+		f.Logger.Info(f.Localizer.MustLocalize(loc.hint, localize.NewEntry("Dir", filepath.Dir(loc.path))))
+	}
+	f.Logger.Info(f.Localizer.MustLocalize("completion.install.log.info.restartShell"))
+
+	return nil
+}
+
+// detectShell returns the name of the shell of the user from the value of the SHELL environment variable
+func detectShell(shellEnv string, goos string) string {
+	if shellEnv == "" {
+		if goos == "windows" {
+			return shellPowershell
+		}
+		return ""
+	}
+
+	name := strings.TrimSuffix(filepath.Base(shellEnv), ".exe")
+	switch name {
+	case "pwsh":
+		return shellPowershell
+	default:
+		return name
+	}
+}
+
+// completionLocation returns where the completion script of the shell is loaded from without any setup,
+// preferring the directories of Homebrew when it is installed
+func completionLocation(shell string, home string, getenv func(string) string, brewPrefix string) location {
+	switch shell {
+	case shellBash:
+		if brewPrefix != "" {
+			return location{path: filepath.Join(brewPrefix, "etc", "bash_completion.d", "rhoas"), hint: "completion.install.log.info.bashHint"}
+		}
+		dataHome := getenv("XDG_DATA_HOME")
+		if dataHome == "" {
+			dataHome = filepath.Join(home, ".local", "share")
+		}
+		return location{path: filepath.Join(dataHome, "bash-completion", "completions", "rhoas"), hint: "completion.install.log.info.bashHint"}
+	case shellZsh:
+		if brewPrefix != "" {
+			return location{path: filepath.Join(brewPrefix, "share", "zsh", "site-functions", "_rhoas")}
+		}
+		return location{path: filepath.Join(home, ".zsh", "completions", "_rhoas"), hint: "completion.install.log.info.zshHint"}
+	default:
+		configHome := getenv("XDG_CONFIG_HOME")
+		if configHome == "" {
+			configHome = filepath.Join(home, ".config")
+		}
+		return location{path: filepath.Join(configHome, "fish", "completions", "rhoas.fish")}
+	}
+}
+
+// brewPrefix returns the installation prefix of Homebrew, or an empty string when it is not installed
+func brewPrefix() string {
+	brew, err := exec.LookPath("brew")
+	if err != nil {
+		return ""
+	}
+
+	out, err := exec.Command(brew, "--prefix").Output()
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(out))
+}
@@ -0,0 +1,62 @@
+package install
+
+import (
+	"testing"
+)
+
+func TestDetectShell(t *testing.T) {
+	tests := []struct {
+		shellEnv string
+		goos     string
+		want     string
+	}{
+		{shellEnv: "/bin/bash", goos: "linux", want: "bash"},
+		{shellEnv: "/usr/local/bin/zsh", goos: "darwin", want: "zsh"},
+		{shellEnv: "/usr/bin/fish", goos: "linux", want: "fish"},
+		{shellEnv: "/usr/bin/pwsh", goos: "linux", want: "powershell"},
+		{shellEnv: "", goos: "windows", want: "powershell"},
+		{shellEnv: "", goos: "linux", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.shellEnv+"_"+tt.goos, func(t *testing.T) {
+			if got := detectShell(tt.shellEnv, tt.goos); got != tt.want {
+				t.Errorf("detectShell() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompletionLocation(t *testing.T) {
+	noEnv := func(string) string { return "" }
+	xdgEnv := func(key string) string {
+		return map[string]string{"XDG_DATA_HOME": "/data", "XDG_CONFIG_HOME": "/config"}[key]
+	}
+
+	tests := []struct {
+		name       string
+		shell      string
+		getenv     func(string) string
+		brewPrefix string
+		wantPath   string
+		wantHint   bool
+	}{
+		{name: "bash", shell: "bash", getenv: noEnv, wantPath: "/home/u/.local/share/bash-completion/completions/rhoas", wantHint: true},
+		{name: "bash with XDG_DATA_HOME", shell: "bash", getenv: xdgEnv, wantPath: "/data/bash-completion/completions/rhoas", wantHint: true},
+		{name: "bash with brew", shell: "bash", getenv: noEnv, brewPrefix: "/opt/homebrew", wantPath: "/opt/homebrew/etc/bash_completion.d/rhoas", wantHint: true},
+		{name: "zsh", shell: "zsh", getenv: noEnv, wantPath: "/home/u/.zsh/completions/_rhoas", wantHint: true},
+		{name: "zsh with brew", shell: "zsh", getenv: noEnv, brewPrefix: "/opt/homebrew", wantPath: "/opt/homebrew/share/zsh/site-functions/_rhoas"},
+		{name: "fish", shell: "fish", getenv: noEnv, wantPath: "/home/u/.config/fish/completions/rhoas.fish"},
+		{name: "fish with XDG_CONFIG_HOME", shell: "fish", getenv: xdgEnv, wantPath: "/config/fish/completions/rhoas.fish"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := completionLocation(tt.shell, "/home/u", tt.getenv, tt.brewPrefix)
+			if got.path != tt.wantPath {
+				t.Errorf("completionLocation().path = %q, want %q", got.path, tt.wantPath)
+			}
+			if (got.hint != "") != tt.wantHint {
+				t.Errorf("completionLocation().hint = %q, want hint %v", got.hint, tt.wantHint)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package metadata
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// commandMetadata describes a command, its flags and its subcommands
+type commandMetadata struct {
+	Name       string            `json:"name"`
+	Path       string            `json:"path"`
+	Use        string            `json:"use"`
+	Short      string            `json:"short"`
+	Aliases    []string          `json:"aliases,omitempty"`
+	Deprecated string            `json:"deprecated,omitempty"`
+	Runnable   bool              `json:"runnable"`
+	Flags      []flagMetadata    `json:"flags"`
+	Commands   []commandMetadata `json:"commands"`
+}
+
+// flagMetadata describes a flag and the constraints on its value
+type flagMetadata struct {
+	Name        string   `json:"name"`
+	Shorthand   string   `json:"shorthand,omitempty"`
+	Type        string   `json:"type"`
+	Default     string   `json:"default"`
+	Description string   `json:"description"`
+	Persistent  bool     `json:"persistent"`
+	Required    bool     `json:"required"`
+	Deprecated  string   `json:"deprecated,omitempty"`
+	ValidValues []string `json:"valid_values,omitempty"`
+}
+
+// NewCommand creates a command which prints the command tree of the CLI as JSON
+func NewCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "metadata",
+		Short:                 f.Localizer.MustLocalize("completion.metadata.cmd.shortDescription"),
+		Long:                  f.Localizer.MustLocalize("completion.metadata.cmd.longDescription"),
+		Example:               f.Localizer.MustLocalize("completion.metadata.cmd.example"),
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return dump.Formatted(f.IOStreams.Out, dump.JSONFormat, newCommandMetadata(cmd.Root()))
+		},
+	}
+
+	return cmd
+}
+
+func newCommandMetadata(cmd *cobra.Command) commandMetadata {
+	metadata := commandMetadata{
+		Name:       cmd.Name(),
+		Path:       cmd.CommandPath(),
+		Use:        cmd.Use,
+		Short:      cmd.Short,
+		Aliases:    cmd.Aliases,
+		Deprecated: cmd.Deprecated,
+		Runnable:   cmd.Runnable(),
+		Flags:      []flagMetadata{},
+		Commands:   []commandMetadata{},
+	}
+
+	persistentFlags := cmd.PersistentFlags()
+	cmd.NonInheritedFlags().VisitAll(func(flag *pflag.Flag) {
+		if flag.Hidden {
+			return
+		}
+		metadata.Flags = append(metadata.Flags, newFlagMetadata(flag, persistentFlags.Lookup(flag.Name) != nil))
+	})
+
+	for _, subCmd := range cmd.Commands() {
+		if !subCmd.IsAvailableCommand() {
+			continue
+		}
+		metadata.Commands = append(metadata.Commands, newCommandMetadata(subCmd))
+	}
+
+	return metadata
+}
+
+func newFlagMetadata(flag *pflag.Flag, persistent bool) flagMetadata {
+	_, required := flag.Annotations[cobra.BashCompOneRequiredFlag]
+
+	return flagMetadata{
+		Name:        flag.Name,
+		Shorthand:   flag.Shorthand,
+		Type:        flag.Value.Type(),
+		Default:     flag.DefValue,
+		Description: flag.Usage,
+		Persistent:  persistent,
+		Required:    required,
+		Deprecated:  flag.Deprecated,
+		ValidValues: flag.Annotations[flagutil.ValidValuesAnnotation],
+	}
+}
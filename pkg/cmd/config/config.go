@@ -0,0 +1,26 @@
+package config
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/config/get"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/config/set"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+// NewConfigCommand creates a new command to read and change CLI settings
+func NewConfigCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "config",
+		Short:   f.Localizer.MustLocalize("config.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("config.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("config.cmd.example"),
+		Args:    cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		get.NewGetCommand(f),
+		set.NewSetCommand(f),
+	)
+
+	return cmd
+}
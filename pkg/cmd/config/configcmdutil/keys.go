@@ -0,0 +1,110 @@
+package configcmdutil
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+
+	"github.com/redhat-developer/app-services-cli/internal/telemetry"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/color"
+)
+
+// Key is a setting of the config file which can be read and changed with "rhoas config"
+type Key struct {
+	// ValidValues are the accepted values, any value is accepted when empty
+	ValidValues []string
+	// Validate checks values which are not from a fixed list, it is optional
+	Validate func(value string) error
+	Get      func(cfg *config.Config) string
+	Set      func(cfg *config.Config, value string)
+}
+
+const (
+	telemetryOn  = "on"
+	telemetryOff = "off"
+)
+
+// Keys are the settings supported by "rhoas config", by name
+var Keys = map[string]Key{
+	"telemetry": {
+		ValidValues: []string{telemetryOn, telemetryOff},
+		Get: func(cfg *config.Config) string {
+			if cfg.Telemetry == telemetry.ConfigEnabled {
+				return telemetryOn
+			}
+			return telemetryOff
+		},
+		Set: func(cfg *config.Config, value string) {
+			if value == telemetryOn {
+				cfg.Telemetry = telemetry.ConfigEnabled
+			} else {
+				cfg.Telemetry = telemetry.ConfigDisabled
+			}
+		},
+	},
+	"http-cache": {
+		ValidValues: []string{config.HTTPCacheOn, config.HTTPCacheOff},
+		Get: func(cfg *config.Config) string {
+			if cfg.HTTPCache == config.HTTPCacheOn {
+				return config.HTTPCacheOn
+			}
+			return config.HTTPCacheOff
+		},
+		Set: func(cfg *config.Config, value string) {
+			cfg.HTTPCache = value
+		},
+	},
+	"color-theme": {
+		ValidValues: color.Themes,
+		Get: func(cfg *config.Config) string {
+			if cfg.ColorTheme == "" {
+				return color.ThemeDark
+			}
+			return cfg.ColorTheme
+		},
+		Set: func(cfg *config.Config, value string) {
+			cfg.ColorTheme = value
+		},
+	},
+	"topic-name-policy": {
+		Get: func(cfg *config.Config) string {
+			return cfg.TopicNamePolicy
+		},
+		Set: func(cfg *config.Config, value string) {
+			cfg.TopicNamePolicy = value
+		},
+	},
+	"max-concurrent-requests": {
+		Validate: func(value string) error {
+			if n, err := strconv.Atoi(value); err != nil || n < 1 {
+				return errors.New("the value must be a positive integer")
+			}
+			return nil
+		},
+		Get: func(cfg *config.Config) string {
+			return strconv.Itoa(cfg.GetMaxConcurrentRequests())
+		},
+		Set: func(cfg *config.Config, value string) {
+			cfg.MaxConcurrentRequests, _ = strconv.Atoi(value)
+		},
+	},
+	"pager": {
+		Get: func(cfg *config.Config) string {
+			return cfg.Pager
+		},
+		Set: func(cfg *config.Config, value string) {
+			cfg.Pager = value
+		},
+	},
+}
+
+// KeyNames returns the sorted names of the supported settings
+func KeyNames() []string {
+	names := make([]string, 0, len(Keys))
+	for name := range Keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,64 @@
+package get
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/config/configcmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	Config    config.IConfig
+	IO        *iostreams.IOStreams
+	localizer localize.Localizer
+
+	key string
+}
+
+// NewGetCommand creates a new command to print a CLI setting
+func NewGetCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		Config:    f.Config,
+		IO:        f.IOStreams,
+		localizer: f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:         "get <key>",
+		Short:       f.Localizer.MustLocalize("config.get.cmd.shortDescription"),
+		Long:        f.Localizer.MustLocalize("config.get.cmd.longDescription"),
+		Example:     f.Localizer.MustLocalize("config.get.cmd.example"),
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{cmdutil.OfflineAnnotation: "true"},
+		ValidArgs:   configcmdutil.KeyNames(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.key = args[0]
+
+			return runGet(opts)
+		},
+	}
+
+	return cmd
+}
+
+func runGet(opts *options) error {
+	key, ok := configcmdutil.Keys[opts.key]
+	if !ok {
+		return opts.localizer.MustLocalizeError("config.common.error.unknownKey", localize.NewEntry("Key", opts.key), localize.NewEntry("Keys", strings.Join(configcmdutil.KeyNames(), ", ")))
+	}
+
+	cfg, err := opts.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(opts.IO.Out, key.Get(cfg))
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package set
+
+import (
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/config/configcmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	Config    config.IConfig
+	Logger    logging.Logger
+	localizer localize.Localizer
+
+	key   string
+	value string
+}
+
+// NewSetCommand creates a new command to change a CLI setting
+func NewSetCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		Config:    f.Config,
+		Logger:    f.Logger,
+		localizer: f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:         "set <key> <value>",
+		Short:       f.Localizer.MustLocalize("config.set.cmd.shortDescription"),
+		Long:        f.Localizer.MustLocalize("config.set.cmd.longDescription"),
+		Example:     f.Localizer.MustLocalize("config.set.cmd.example"),
+		Args:        cobra.ExactArgs(2),
+		Annotations: map[string]string{cmdutil.OfflineAnnotation: "true"},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+			switch len(args) {
+			case 0:
+				return configcmdutil.KeyNames(), cobra.ShellCompDirectiveNoFileComp
+			case 1:
+				return configcmdutil.Keys[args[0]].ValidValues, cobra.ShellCompDirectiveNoFileComp
+			}
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.key = args[0]
+			opts.value = args[1]
+
+			return runSet(opts)
+		},
+	}
+
+	return cmd
+}
+
+func runSet(opts *options) error {
+	key, ok := configcmdutil.Keys[opts.key]
+	if !ok {
+		return opts.localizer.MustLocalizeError("config.common.error.unknownKey", localize.NewEntry("Key", opts.key), localize.NewEntry("Keys", strings.Join(configcmdutil.KeyNames(), ", ")))
+	}
+	if len(key.ValidValues) > 0 && !flagutil.IsValidInput(opts.value, key.ValidValues...) {
+		return opts.localizer.MustLocalizeError("config.set.error.invalidValue",
+			localize.NewEntry("Key", opts.key),
+			localize.NewEntry("Value", opts.value),
+			localize.NewEntry("ValidValues", strings.Join(key.ValidValues, ", ")),
+		)
+	}
+
+	if key.Validate != nil {
+		if err := key.Validate(opts.value); err != nil {
+			return opts.localizer.MustLocalizeError("config.set.error.invalidValueFormat",
+				localize.NewEntry("Key", opts.key),
+				localize.NewEntry("Value", opts.value),
+				localize.NewEntry("Error", err),
+			)
+		}
+	}
+
+	cfg, err := opts.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	key.Set(cfg, opts.value)
+
+	if err = opts.Config.Save(cfg); err != nil {
+		return err
+	}
+
+	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("config.set.log.info.success", localize.NewEntry("Key", opts.key), localize.NewEntry("Value", opts.value)))
+
+	return nil
+}
@@ -13,9 +13,7 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	connectormgmtclient "github.com/redhat-developer/app-services-sdk-go/connectormgmt/apiv1/client"
-	connectorerror "github.com/redhat-developer/app-services-sdk-go/connectormgmt/apiv1/error"
 
-	"github.com/redhat-developer/app-services-cli/pkg/shared/connection"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
 
 	"github.com/spf13/cobra"
@@ -27,6 +25,7 @@ type options struct {
 	connectorType string
 	outputFormat  string
 	overwrite     bool
+	catalogFile   string
 
 	f *factory.Factory
 }
@@ -62,12 +61,13 @@ func NewBuildCommand(f *factory.Factory) *cobra.Command {
 			return runBuild(opts)
 		},
 	}
-	flags := flagutil.NewFlagSet(cmd, f.Localizer)
+	flags := connectorcmdutil.NewFlagSet(cmd, f)
 	flags.StringVar(&opts.outputFile, "output-file", "", f.Localizer.MustLocalize("connector.build.file.flag.description"))
 	flags.StringVar(&opts.connectorType, "type", "", f.Localizer.MustLocalize("connector.build.type.flag.description"))
 	flags.StringVar(&opts.name, "name", "", f.Localizer.MustLocalize("connector.build.name.flag.description"))
 	cmd.Flags().BoolVar(&opts.overwrite, "overwrite", false, opts.f.Localizer.MustLocalize("connector.build.overwrite.flag.description"))
 	flags.AddOutput(&opts.outputFormat)
+	flags.AddCatalogFile(&opts.catalogFile)
 
 	_ = cmd.MarkFlagRequired("type")
 
@@ -95,27 +95,11 @@ func runBuild(opts *options) error {
 		return opts.f.Localizer.MustLocalizeError("connector.common.error.FileAlreadyExists", localize.NewEntry("Name", color.CodeSnippet(opts.outputFile)))
 	}
 
-	var conn connection.Connection
-	conn, err := f.Connection()
-	if err != nil {
-		return err
-	}
-
 	if opts.connectorType == "" {
 		return opts.f.Localizer.MustLocalizeError("connector.type.error.notFound", localize.NewEntry("Id", opts.connectorType))
 	}
 
-	api := conn.API()
-
-	request := api.ConnectorsMgmt().ConnectorTypesApi.GetConnectorTypeByID(f.Context, opts.connectorType)
-	response, _, err := request.Execute()
-
-	if apiErr := connectorerror.GetAPIError(err); apiErr != nil {
-		if apiErr.GetCode() == connectorerror.ERROR_7 {
-			return opts.f.Localizer.MustLocalizeError("connector.type.error.notFound", localize.NewEntry("Id", opts.connectorType))
-		}
-	}
-
+	response, err := connectorcmdutil.GetConnectorType(f, opts.connectorType, opts.catalogFile)
 	if err != nil {
 		return err
 	}
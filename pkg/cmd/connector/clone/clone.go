@@ -0,0 +1,152 @@
+package clone
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	connectormgmtclient "github.com/redhat-developer/app-services-sdk-go/connectormgmt/apiv1/client"
+	connectorerror "github.com/redhat-developer/app-services-sdk-go/connectormgmt/apiv1/error"
+
+	"gopkg.in/AlecAivazis/survey.v1"
+
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	id           string
+	name         string
+	overrides    []string
+	clientID     string
+	clientSecret string
+	outputFormat string
+
+	f *factory.Factory
+}
+
+// NewCloneCommand creates a new command to copy an existing Connector
+func NewCloneCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "clone <id>",
+		Short:   f.Localizer.MustLocalize("connector.clone.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("connector.clone.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("connector.clone.cmd.example"),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.id = args[0]
+
+			validOutputFormats := flagutil.ValidOutputFormats
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, validOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, validOutputFormats...)
+			}
+
+			return runClone(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, f.Localizer)
+	flags.StringVar(&opts.name, "name", "", f.Localizer.MustLocalize("connector.clone.flag.name.description"))
+	flags.StringArrayVar(&opts.overrides, "set", []string{}, f.Localizer.MustLocalize("connector.clone.flag.set.description"))
+	flags.StringVar(&opts.clientID, "client-id", "", f.Localizer.MustLocalize("connector.clone.flag.clientId.description"))
+	flags.AddSecret(&opts.clientSecret, "client-secret", "", f.IOStreams.In, f.Localizer.MustLocalize("connector.clone.flag.clientSecret.description"))
+	flags.AddOutput(&opts.outputFormat)
+
+	_ = cmd.MarkFlagRequired("name")
+
+	return cmd
+}
+
+func runClone(opts *options) error {
+	f := opts.f
+
+	overrides, err := parseOverrides(opts.overrides)
+	if err != nil {
+		return f.Localizer.MustLocalizeError("connector.clone.error.invalidOverride", localize.NewEntry("Error", err))
+	}
+
+	var conn connection.Connection
+	conn, err = f.Connection()
+	if err != nil {
+		return err
+	}
+
+	api := conn.API()
+
+	source, httpRes, err := api.ConnectorsMgmt().ConnectorsApi.GetConnector(f.Context, opts.id).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		return err
+	}
+
+	config := copyConfig(source.GetConnector())
+	for _, o := range overrides {
+		if err = setPath(config, o.path, o.value); err != nil {
+			return f.Localizer.MustLocalizeError("connector.clone.error.invalidOverride", localize.NewEntry("Error", err))
+		}
+	}
+
+	request := connectormgmtclient.ConnectorRequest{
+		Name:            opts.name,
+		ConnectorTypeId: source.GetConnectorTypeId(),
+		NamespaceId:     source.GetNamespaceId(),
+		Channel:         source.Channel,
+		DesiredState:    source.GetDesiredState(),
+		Annotations:     source.Annotations,
+		Kafka:           source.GetKafka(),
+		SchemaRegistry:  source.SchemaRegistry,
+		ServiceAccount:  source.GetServiceAccount(),
+		Connector:       config,
+	}
+
+	if opts.clientID != "" {
+		request.ServiceAccount.ClientId = opts.clientID
+	}
+
+	// The service account secret is never returned by the API
+	request.ServiceAccount.ClientSecret = opts.clientSecret
+	if request.ServiceAccount.ClientSecret == "" {
+		if !f.IOStreams.CanPrompt() {
+			return f.Localizer.MustLocalizeError("connector.clone.error.clientSecretRequired")
+		}
+		prompt := &survey.Password{
+			Message: f.Localizer.MustLocalize("connector.clone.input.clientSecret.message"),
+		}
+		if err = survey.AskOne(prompt, &request.ServiceAccount.ClientSecret, survey.Required); err != nil {
+			return err
+		}
+	}
+
+	f.Logger.Info(f.Localizer.MustLocalize("connector.clone.log.info.cloning", localize.NewEntry("ID", opts.id), localize.NewEntry("Name", opts.name)))
+
+	response, httpRes, err := api.ConnectorsMgmt().ConnectorsApi.CreateConnector(f.Context).ConnectorRequest(request).Async(true).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+
+	if apiErr := connectorerror.GetAPIError(err); apiErr != nil {
+		return f.Localizer.MustLocalizeError("connector.type.create.error.other", localize.NewEntry("Error", apiErr.GetReason()))
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = contextutil.SetCurrentConnectorInstance(&response, &conn, f); err != nil {
+		return err
+	}
+
+	if err = dump.Formatted(f.IOStreams.Out, opts.outputFormat, response); err != nil {
+		return err
+	}
+
+	f.Logger.Info(f.Localizer.MustLocalize("connector.clone.info.success", localize.NewEntry("Name", opts.name)))
+
+	return nil
+}
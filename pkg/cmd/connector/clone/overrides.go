@@ -0,0 +1,82 @@
+package clone
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// override is a single "--set path=value" flag value
+type override struct {
+	path  []string
+	value string
+}
+
+// parseOverrides splits each "path=value" pair, nested properties are separated with dots
+func parseOverrides(values []string) ([]override, error) {
+	overrides := make([]override, 0, len(values))
+	for _, v := range values {
+		key, value, found := strings.Cut(v, "=")
+		if !found || key == "" {
+			return nil, fmt.Errorf("%q is not in the format path=value", v)
+		}
+
+		path := strings.Split(key, ".")
+		for _, p := range path {
+			if p == "" {
+				return nil, fmt.Errorf("%q is not a valid property path", key)
+			}
+		}
+
+		overrides = append(overrides, override{path: path, value: value})
+	}
+	return overrides, nil
+}
+
+// copyConfig returns a deep copy of the connector configuration
+func copyConfig(config map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(config))
+	for k, v := range config {
+		if nested, ok := v.(map[string]interface{}); ok {
+			v = copyConfig(nested)
+		}
+		copied[k] = v
+	}
+	return copied
+}
+
+// setPath sets the value at the given property path, creating intermediate objects as needed.
+// Numbers, booleans and arrays keep their type, any other property is set as a string.
+func setPath(config map[string]interface{}, path []string, value string) error {
+	current := config
+	for _, p := range path[:len(path)-1] {
+		next, exists := current[p]
+		if !exists {
+			nested := map[string]interface{}{}
+			current[p] = nested
+			current = nested
+			continue
+		}
+
+		nested, ok := next.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%q is not an object", strings.Join(path, "."))
+		}
+		current = nested
+	}
+
+	key := path[len(path)-1]
+	switch current[key].(type) {
+	case float64, bool, []interface{}:
+		var typed interface{}
+		if err := json.Unmarshal([]byte(value), &typed); err != nil {
+			return fmt.Errorf("invalid value for %q: %w", strings.Join(path, "."), err)
+		}
+		current[key] = typed
+	default:
+		// strings, new properties and redacted secret properties
+		current[key] = value
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package clone
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_setPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  map[string]interface{}
+		set     string
+		want    map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name:   "replaces a string property",
+			config: map[string]interface{}{"kafka_topic": "orders"},
+			set:    "kafka_topic=other-topic",
+			want:   map[string]interface{}{"kafka_topic": "other-topic"},
+		},
+		{
+			name:   "keeps the type of numbers",
+			config: map[string]interface{}{"batch_size": float64(10)},
+			set:    "batch_size=20",
+			want:   map[string]interface{}{"batch_size": float64(20)},
+		},
+		{
+			name:   "creates nested properties",
+			config: map[string]interface{}{},
+			set:    "data_shape.consumes.format=application/json",
+			want: map[string]interface{}{
+				"data_shape": map[string]interface{}{
+					"consumes": map[string]interface{}{"format": "application/json"},
+				},
+			},
+		},
+		{
+			name:   "replaces redacted secrets",
+			config: map[string]interface{}{"password": map[string]interface{}{}},
+			set:    "password=s3cret",
+			want:   map[string]interface{}{"password": "s3cret"},
+		},
+		{
+			name:    "fails on invalid numbers",
+			config:  map[string]interface{}{"batch_size": float64(10)},
+			set:     "batch_size=ten",
+			wantErr: true,
+		},
+		{
+			name:    "fails when a parent is not an object",
+			config:  map[string]interface{}{"kafka_topic": "orders"},
+			set:     "kafka_topic.name=other",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			overrides, err := parseOverrides([]string{tt.set})
+			if err != nil {
+				t.Fatalf("parseOverrides() error = %v", err)
+			}
+			err = setPath(tt.config, overrides[0].path, overrides[0].value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("setPath() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(tt.config, tt.want) {
+				t.Errorf("setPath() config = %v, want %v", tt.config, tt.want)
+			}
+		})
+	}
+}
+
+func Test_parseOverrides(t *testing.T) {
+	for _, v := range []string{"kafka_topic", "=value", "a..b=value"} {
+		if _, err := parseOverrides([]string{v}); err == nil {
+			t.Errorf("parseOverrides(%q) expected an error", v)
+		}
+	}
+}
@@ -104,14 +104,13 @@ func mapResponseItemsToRows(items []connectormgmtclient.ConnectorCluster) []item
 
 	for i := range items {
 		k := items[i]
-		name := k.GetName()
 		status := k.GetStatus()
 
 		row := itemRow{
-			ID:     k.GetId(),
-			Name:   name,
-			Owner:  k.GetOwner(),
-			Status: string(status.GetState()),
+			ID:     dump.OrPlaceholder(k.GetId()),
+			Name:   dump.OrPlaceholder(k.GetName()),
+			Owner:  dump.OrPlaceholder(k.GetOwner()),
+			Status: dump.OrPlaceholder(string(status.GetState())),
 		}
 
 		rows[i] = row
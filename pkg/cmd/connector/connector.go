@@ -3,6 +3,7 @@ package connector
 import (
 	"github.com/redhat-developer/app-services-cli/internal/doc"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/connector/build"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/connector/clone"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/connector/cluster"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/connector/connector_type"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/connector/create"
@@ -14,6 +15,8 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/connector/stop"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/connector/update"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/connector/use"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/connector/validate"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/connector/waitfor"
 
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
 	"github.com/spf13/cobra"
@@ -41,10 +44,13 @@ func NewConnectorsCommand(f *factory.Factory) *cobra.Command {
 		connector_type.NewTypeCommand(f),
 		list.NewListCommand(f),
 		create.NewCreateCommand(f),
+		clone.NewCloneCommand(f),
 		build.NewBuildCommand(f),
 		delete.NewDeleteCommand(f),
 		describe.NewDescribeCommand(f),
 		update.NewUpdateCommand(f),
+		validate.NewValidateCommand(f),
+		waitfor.NewWaitForCommand(f),
 	)
 
 	return cmd
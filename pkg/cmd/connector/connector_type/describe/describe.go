@@ -4,10 +4,8 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/connector/connectorcmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
-	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
-	connectorerror "github.com/redhat-developer/app-services-sdk-go/connectormgmt/apiv1/error"
 
 	"github.com/spf13/cobra"
 )
@@ -15,6 +13,7 @@ import (
 type options struct {
 	type_id      string
 	outputFormat string
+	catalogFile  string
 
 	f *factory.Factory
 }
@@ -44,6 +43,7 @@ func NewDescribeCommand(f *factory.Factory) *cobra.Command {
 	flags := connectorcmdutil.NewFlagSet(cmd, f)
 	flags.StringVar(&opts.type_id, "type", "", f.Localizer.MustLocalize("connector.type.describe.flag.id"))
 	flags.AddOutput(&opts.outputFormat)
+	flags.AddCatalogFile(&opts.catalogFile)
 
 	_ = cmd.MarkFlagRequired("type")
 
@@ -60,29 +60,7 @@ func runDescribe(opts *options) error {
 		return opts.f.Localizer.MustLocalizeError("connector.type.error.noType")
 	}
 
-	conn, err := opts.f.Connection()
-	if err != nil {
-		return err
-	}
-
-	api := conn.API()
-
-	request := api.ConnectorsMgmt().ConnectorTypesApi.GetConnectorTypeByID(opts.f.Context, opts.type_id)
-
-	response, httpRes, err := request.Execute()
-	if httpRes != nil {
-		defer httpRes.Body.Close()
-	}
-
-	if apiErr := connectorerror.GetAPIError(err); apiErr != nil {
-		switch apiErr.GetCode() {
-		case connectorerror.ERROR_7:
-			return opts.f.Localizer.MustLocalizeError("connector.type.error.notFound", localize.NewEntry("Id", opts.type_id))
-		default:
-			return err
-		}
-	}
-
+	response, err := connectorcmdutil.GetConnectorType(opts.f, opts.type_id, opts.catalogFile)
 	if err != nil {
 		return err
 	}
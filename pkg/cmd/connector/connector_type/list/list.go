@@ -12,6 +12,7 @@ import (
 
 	"github.com/redhat-developer/app-services-cli/pkg/shared/connection"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/searchutil"
 	connectormgmtclient "github.com/redhat-developer/app-services-sdk-go/connectormgmt/apiv1/client"
 	connectorerror "github.com/redhat-developer/app-services-sdk-go/connectormgmt/apiv1/error"
 	"github.com/spf13/cobra"
@@ -26,6 +27,7 @@ type options struct {
 	limit        int
 	page         int
 	outputFormat string
+	catalogFile  string
 
 	f *factory.Factory
 }
@@ -55,12 +57,19 @@ func NewListCommand(f *factory.Factory) *cobra.Command {
 				return flagutil.InvalidValueError("output", opts.outputFormat, validOutputFormats...)
 			}
 
+			if opts.search != DefaultSearch {
+				if err := searchutil.ValidateSearchInput(opts.search); err != nil {
+					return err
+				}
+			}
+
 			return runUpdateCommand(opts)
 		},
 	}
 
 	flags := connectorcmdutil.NewFlagSet(cmd, f)
 	flags.AddOutput(&opts.outputFormat)
+	flags.AddCatalogFile(&opts.catalogFile)
 	flags.StringVar(&opts.search, "search", DefaultSearch, f.Localizer.MustLocalize("connector.type.list.flag.search.description"))
 	flags.IntVar(&opts.limit, "limit", int(cmdutil.ConvertPageValueToInt32(build.DefaultPageSize)), f.Localizer.MustLocalize("connector.type.list.flag.page.description"))
 	flags.IntVar(&opts.page, "page", int(cmdutil.ConvertPageValueToInt32(build.DefaultPageNumber)), f.Localizer.MustLocalize("connector.type.list.flag.page.description"))
@@ -71,10 +80,75 @@ func NewListCommand(f *factory.Factory) *cobra.Command {
 func runUpdateCommand(opts *options) error {
 	f := opts.f
 
+	var types connectormgmtclient.ConnectorTypeList
+	var err error
+	if opts.catalogFile != "" {
+		types, err = listFromCatalog(opts)
+	} else {
+		types, err = listFromAPI(opts)
+	}
+	if err != nil {
+		return err
+	}
+
+	rows := mapResponseToConnectorTypes(&types)
+	switch opts.outputFormat {
+	case dump.EmptyFormat:
+		for i := 0; i < len(rows); i++ {
+			if err = dump.Formatted(f.IOStreams.Out, opts.outputFormat, rows[i]); err != nil {
+				return err
+			}
+		}
+		f.Logger.Info("")
+	default:
+		return dump.Formatted(f.IOStreams.Out, opts.outputFormat, types)
+	}
+
+	start := (opts.page - 1) * opts.limit
+	end := start + len(rows)
+	opts.f.Logger.Info(fmt.Sprintf("[%v %v : %v - %v]", opts.f.Localizer.MustLocalize("connector.common.page.prompt"), opts.page, start, end))
+
+	return nil
+}
+
+// listFromCatalog returns the page of the connector types of the catalog file matching the search
+func listFromCatalog(opts *options) (connectormgmtclient.ConnectorTypeList, error) {
+	catalog, err := connectorcmdutil.LoadCatalog(opts.catalogFile, opts.f.Localizer)
+	if err != nil {
+		return connectormgmtclient.ConnectorTypeList{}, err
+	}
+
+	items := catalog.Search(opts.search)
+	total := len(items)
+
+	start := (opts.page - 1) * opts.limit
+	if start < 0 {
+		start = 0
+	}
+	if start > total {
+		start = total
+	}
+	end := start + opts.limit
+	if end > total {
+		end = total
+	}
+
+	return connectormgmtclient.ConnectorTypeList{
+		Kind:  "ConnectorTypeList",
+		Page:  int32(opts.page),
+		Size:  int32(end - start),
+		Total: int32(total),
+		Items: items[start:end],
+	}, nil
+}
+
+func listFromAPI(opts *options) (connectormgmtclient.ConnectorTypeList, error) {
+	f := opts.f
+
 	var conn connection.Connection
 	conn, err := f.Connection()
 	if err != nil {
-		return err
+		return connectormgmtclient.ConnectorTypeList{}, err
 	}
 
 	api := conn.API()
@@ -84,7 +158,7 @@ func runUpdateCommand(opts *options) error {
 	request = request.Size(strconv.Itoa(opts.limit))
 
 	if opts.search != DefaultSearch {
-		query := connectorcmdutil.NewSearchQuery(opts.search).Filter("name").Filter("description").Build()
+		query := searchutil.NewSearchQuery(opts.search).Filter("name").Filter("description").Build()
 		request = request.Search(query)
 	}
 
@@ -97,32 +171,16 @@ func runUpdateCommand(opts *options) error {
 	if apiErr := connectorerror.GetAPIError(err); apiErr != nil {
 		switch apiErr.GetCode() {
 		case connectorerror.ERROR_11:
-			return opts.f.Localizer.MustLocalizeError("connector.common.error.unauthorized")
+			return types, opts.f.Localizer.MustLocalizeError("connector.common.error.unauthorized")
 		case connectorerror.ERROR_23:
-			return opts.f.Localizer.MustLocalizeError("connector.common.error.parse.search")
+			return types, opts.f.Localizer.MustLocalizeError("connector.common.error.parse.search")
 
 		default:
-			return err
+			return types, err
 		}
 	}
-	rows := mapResponseToConnectorTypes(&types)
-	switch opts.outputFormat {
-	case dump.EmptyFormat:
-		for i := 0; i < len(rows); i++ {
-			if err = dump.Formatted(f.IOStreams.Out, opts.outputFormat, rows[i]); err != nil {
-				return err
-			}
-		}
-		f.Logger.Info("")
-	default:
-		return dump.Formatted(f.IOStreams.Out, opts.outputFormat, types)
-	}
 
-	start := (opts.page - 1) * opts.limit
-	end := start + len(rows)
-	opts.f.Logger.Info(fmt.Sprintf("[%v %v : %v - %v]", opts.f.Localizer.MustLocalize("connector.common.page.prompt"), opts.page, start, end))
-
-	return nil
+	return types, err
 }
 
 func mapResponseToConnectorTypes(list *connectormgmtclient.ConnectorTypeList) []connectorOutput {
@@ -0,0 +1,78 @@
+package pull
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/connector/connectorcmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/color"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+
+	"github.com/spf13/cobra"
+)
+
+const defaultOutputFile = "connector-catalog.json"
+
+type options struct {
+	outputFile string
+	overwrite  bool
+
+	f *factory.Factory
+}
+
+// NewPullCommand creates a new command to save the connector types to a catalog file
+func NewPullCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "pull",
+		Short:   f.Localizer.MustLocalize("connector.type.pull.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("connector.type.pull.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("connector.type.pull.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// If the file already exists, and the --overwrite flag is not set then return an error
+			// indicating that the user should explicitly request overwriting of the file
+			if _, err := os.Stat(opts.outputFile); err == nil && !opts.overwrite {
+				return opts.f.Localizer.MustLocalizeError("connector.common.error.FileAlreadyExists", localize.NewEntry("Name", color.CodeSnippet(opts.outputFile)))
+			}
+
+			return runPull(opts)
+		},
+	}
+
+	flags := connectorcmdutil.NewFlagSet(cmd, f)
+	flags.StringVar(&opts.outputFile, "output-file", defaultOutputFile, f.Localizer.MustLocalize("connector.type.pull.flag.outputFile.description"))
+	flags.BoolVar(&opts.overwrite, "overwrite", false, f.Localizer.MustLocalize("connector.type.pull.flag.overwrite.description"))
+
+	return cmd
+}
+
+func runPull(opts *options) error {
+	f := opts.f
+
+	catalog, err := connectorcmdutil.PullCatalog(f)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(catalog, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err = os.WriteFile(opts.outputFile, data, 0o600); err != nil {
+		return err
+	}
+
+	f.Logger.Info(icon.SuccessPrefix(), f.Localizer.MustLocalizePlural("connector.type.pull.log.info.success", len(catalog.Items),
+		localize.NewEntry("Count", len(catalog.Items)),
+		localize.NewEntry("Path", opts.outputFile),
+	))
+
+	return nil
+}
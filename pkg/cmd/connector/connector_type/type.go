@@ -5,6 +5,7 @@ import (
 
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/connector/connector_type/describe"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/connector/connector_type/list"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/connector/connector_type/pull"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
 	"github.com/spf13/cobra"
 )
@@ -24,6 +25,7 @@ func NewTypeCommand(f *factory.Factory) *cobra.Command {
 	cmd.AddCommand(
 		list.NewListCommand(f),
 		describe.NewDescribeCommand(f),
+		pull.NewPullCommand(f),
 	)
 
 	return cmd
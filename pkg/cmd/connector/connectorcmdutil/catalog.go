@@ -0,0 +1,298 @@
+package connectorcmdutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	connectormgmtclient "github.com/redhat-developer/app-services-sdk-go/connectormgmt/apiv1/client"
+	connectorerror "github.com/redhat-developer/app-services-sdk-go/connectormgmt/apiv1/error"
+)
+
+// catalogPageSize is the number of connector types fetched in each request when pulling the catalog
+const catalogPageSize = 100
+
+// Catalog is a local copy of the connector types, used to work without access to the API
+type Catalog struct {
+	PulledAt time.Time                           `json:"pulled_at"`
+	Items    []connectormgmtclient.ConnectorType `json:"items"`
+}
+
+// PullCatalog fetches all the connector types from the API
+func PullCatalog(f *factory.Factory) (*Catalog, error) {
+	conn, err := f.Connection()
+	if err != nil {
+		return nil, err
+	}
+
+	api := conn.API().ConnectorsMgmt().ConnectorTypesApi
+
+	catalog := &Catalog{PulledAt: time.Now().UTC()}
+	for page := 1; ; page++ {
+		types, httpRes, err := api.GetConnectorTypes(f.Context).
+			Page(strconv.Itoa(page)).
+			Size(strconv.Itoa(catalogPageSize)).
+			Execute()
+		if httpRes != nil {
+			_ = httpRes.Body.Close()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		catalog.Items = append(catalog.Items, types.GetItems()...)
+		if len(types.GetItems()) < catalogPageSize || len(catalog.Items) >= int(types.GetTotal()) {
+			break
+		}
+	}
+
+	sort.SliceStable(catalog.Items, func(i, j int) bool {
+		return catalog.Items[i].GetId() < catalog.Items[j].GetId()
+	})
+
+	return catalog, nil
+}
+
+// LoadCatalog reads a catalog written by "rhoas connector type pull"
+func LoadCatalog(path string, localizer localize.Localizer) (*Catalog, error) {
+	// #nosec G304
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, localizer.MustLocalizeError("connector.catalog.error.read", localize.NewEntry("Path", path), localize.NewEntry("Error", err))
+	}
+
+	var catalog Catalog
+	if err = json.Unmarshal(data, &catalog); err != nil {
+		return nil, localizer.MustLocalizeError("connector.catalog.error.read", localize.NewEntry("Path", path), localize.NewEntry("Error", err))
+	}
+
+	return &catalog, nil
+}
+
+// GetConnectorType returns the connector type with the given ID from the catalog file
+// when it is set, or from the API
+func GetConnectorType(f *factory.Factory, id string, catalogFile string) (*connectormgmtclient.ConnectorType, error) {
+	if catalogFile != "" {
+		catalog, err := LoadCatalog(catalogFile, f.Localizer)
+		if err != nil {
+			return nil, err
+		}
+		connectorType, ok := catalog.Type(id)
+		if !ok {
+			return nil, f.Localizer.MustLocalizeError("connector.type.error.notFound", localize.NewEntry("Id", id))
+		}
+		return connectorType, nil
+	}
+
+	conn, err := f.Connection()
+	if err != nil {
+		return nil, err
+	}
+
+	connectorType, httpRes, err := conn.API().ConnectorsMgmt().ConnectorTypesApi.GetConnectorTypeByID(f.Context, id).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+
+	if apiErr := connectorerror.GetAPIError(err); apiErr != nil && apiErr.GetCode() == connectorerror.ERROR_7 {
+		return nil, f.Localizer.MustLocalizeError("connector.type.error.notFound", localize.NewEntry("Id", id))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &connectorType, nil
+}
+
+// Type returns the connector type with the given ID
+func (c *Catalog) Type(id string) (*connectormgmtclient.ConnectorType, bool) {
+	for i := range c.Items {
+		if c.Items[i].GetId() == id {
+			return &c.Items[i], true
+		}
+	}
+	return nil, false
+}
+
+// Search returns the connector types whose name or description matches the search query,
+// where "%" matches any text as in the search of the API
+func (c *Catalog) Search(search string) []connectormgmtclient.ConnectorType {
+	if search == "" {
+		return c.Items
+	}
+
+	matches := []connectormgmtclient.ConnectorType{}
+	for _, item := range c.Items {
+		if matchesSearch(item.GetName(), search) || matchesSearch(item.GetDescription(), search) {
+			matches = append(matches, item)
+		}
+	}
+	return matches
+}
+
+// matchesSearch matches the value with a case insensitive query where "%" is a wildcard
+func matchesSearch(value string, search string) bool {
+	value = strings.ToLower(value)
+	parts := strings.Split(strings.ToLower(search), "%")
+
+	last := len(parts) - 1
+	if last == 0 {
+		return value == parts[0]
+	}
+
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	value = value[len(parts[0]):]
+
+	for _, part := range parts[1:last] {
+		i := strings.Index(value, part)
+		if i < 0 {
+			return false
+		}
+		value = value[i+len(part):]
+	}
+
+	return strings.HasSuffix(value, parts[last])
+}
+
+// ConfigError is a problem found in a connector configuration
+type ConfigError struct {
+	// Path is the location of the invalid value, such as "processors[1].name"
+	Path    string `json:"path" yaml:"path" header:"Path"`
+	Message string `json:"message" yaml:"message" header:"Message"`
+}
+
+func (e ConfigError) String() string {
+	return e.Path + ": " + e.Message
+}
+
+// ValidateConfig checks the connector configuration against the JSON Schema of its type.
+// It supports the keywords used by the connector types: type, enum, required, properties, items and oneOf.
+// It returns the problems found, ordered by path, which are empty when the configuration is valid.
+func ValidateConfig(schema map[string]interface{}, config interface{}) []ConfigError {
+	problems := validateValue("", schema, config)
+	for i := range problems {
+		if problems[i].Path == "" {
+			problems[i].Path = rootPath
+		}
+	}
+
+	sort.SliceStable(problems, func(i, j int) bool {
+		return problems[i].Path < problems[j].Path
+	})
+
+	return problems
+}
+
+// rootPath is the path of the problems of the connector configuration itself
+const rootPath = "connector"
+
+func validateValue(path string, schema map[string]interface{}, value interface{}) []ConfigError {
+	if oneOf, ok := schema["oneOf"].([]interface{}); ok {
+		for _, option := range oneOf {
+			if optionSchema, ok := option.(map[string]interface{}); ok && len(validateValue(path, optionSchema, value)) == 0 {
+				return nil
+			}
+		}
+		return []ConfigError{{Path: path, Message: "does not match any of the allowed schemas"}}
+	}
+
+	if !matchesType(schema["type"], value) {
+		return []ConfigError{{Path: path, Message: fmt.Sprintf("must be of type %v", schema["type"])}}
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok && !containsValue(enum, value) {
+		return []ConfigError{{Path: path, Message: fmt.Sprintf("must be one of %v", enum)}}
+	}
+
+	var problems []ConfigError
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if required, ok := schema["required"].([]interface{}); ok {
+			for _, name := range required {
+				if _, set := v[fmt.Sprint(name)]; !set {
+					problems = append(problems, ConfigError{Path: joinPath(path, fmt.Sprint(name)), Message: "is required"})
+				}
+			}
+		}
+		properties, _ := schema["properties"].(map[string]interface{})
+		for name, propertyValue := range v {
+			if propertySchema, ok := properties[name].(map[string]interface{}); ok {
+				problems = append(problems, validateValue(joinPath(path, name), propertySchema, propertyValue)...)
+			}
+		}
+	case []interface{}:
+		if itemSchema, ok := schema["items"].(map[string]interface{}); ok {
+			for i, item := range v {
+				problems = append(problems, validateValue(fmt.Sprintf("%v[%d]", path, i), itemSchema, item)...)
+			}
+		}
+	}
+
+	return problems
+}
+
+// matchesType checks the JSON type of the value, the schema type can be a name or a list of names
+func matchesType(schemaType interface{}, value interface{}) bool {
+	switch t := schemaType.(type) {
+	case string:
+		return isJSONType(t, value)
+	case []interface{}:
+		for _, name := range t {
+			if isJSONType(fmt.Sprint(name), value) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func isJSONType(name string, value interface{}) bool {
+	switch name {
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		n, ok := value.(float64)
+		return ok && n == float64(int64(n))
+	case "null":
+		return value == nil
+	}
+	return true
+}
+
+func containsValue(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if fmt.Sprint(v) == fmt.Sprint(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func joinPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
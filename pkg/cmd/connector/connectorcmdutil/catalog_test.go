@@ -0,0 +1,102 @@
+package connectorcmdutil
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func Test_matchesSearch(t *testing.T) {
+	tests := []struct {
+		value  string
+		search string
+		want   bool
+	}{
+		{value: "Amazon Kinesis sink", search: "Amazon%", want: true},
+		{value: "Amazon Kinesis sink", search: "%kinesis%", want: true},
+		{value: "Amazon Kinesis sink", search: "%sink", want: true},
+		{value: "Amazon Kinesis sink", search: "Kinesis%", want: false},
+		{value: "Amazon Kinesis sink", search: "amazon kinesis sink", want: true},
+		{value: "Amazon Kinesis sink", search: "Amazon", want: false},
+		{value: "Amazon Kinesis sink", search: "Amazon%Kinesis%sink", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.search, func(t *testing.T) {
+			if got := matchesSearch(tt.value, tt.search); got != tt.want {
+				t.Errorf("matchesSearch(%q, %q) = %v, want %v", tt.value, tt.search, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	var schema map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+		"type": "object",
+		"required": ["aws_stream", "kafka_topic"],
+		"properties": {
+			"aws_stream": {"type": "string"},
+			"aws_region": {"type": "string", "enum": ["us-east-1", "eu-west-1"]},
+			"kafka_topic": {"type": "string"},
+			"batch_size": {"type": "integer"},
+			"processors": {"type": "array", "items": {"type": "object", "required": ["name"]}},
+			"error_handler": {"oneOf": [
+				{"type": "object", "required": ["log"]},
+				{"type": "object", "required": ["stop"]}
+			]}
+		}
+	}`), &schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		config string
+		want   []ConfigError
+	}{
+		{
+			name:   "valid",
+			config: `{"aws_stream": "s", "aws_region": "eu-west-1", "kafka_topic": "t", "batch_size": 10, "error_handler": {"stop": {}}}`,
+			want:   nil,
+		},
+		{
+			name:   "missing required properties",
+			config: `{"aws_stream": "s"}`,
+			want:   []ConfigError{{Path: "kafka_topic", Message: "is required"}},
+		},
+		{
+			name:   "wrong types and values",
+			config: `{"aws_stream": 1, "aws_region": "mars", "kafka_topic": "t", "batch_size": 1.5}`,
+			want: []ConfigError{
+				{Path: "aws_region", Message: "must be one of [us-east-1 eu-west-1]"},
+				{Path: "aws_stream", Message: "must be of type string"},
+				{Path: "batch_size", Message: "must be of type integer"},
+			},
+		},
+		{
+			name:   "nested problems",
+			config: `{"aws_stream": "s", "kafka_topic": "t", "processors": [{"name": "a"}, {}], "error_handler": {"retry": {}}}`,
+			want: []ConfigError{
+				{Path: "error_handler", Message: "does not match any of the allowed schemas"},
+				{Path: "processors[1].name", Message: "is required"},
+			},
+		},
+		{
+			name:   "not an object",
+			config: `[]`,
+			want:   []ConfigError{{Path: "connector", Message: "must be of type object"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var config interface{}
+			if err := json.Unmarshal([]byte(tt.config), &config); err != nil {
+				t.Fatal(err)
+			}
+			if got := ValidateConfig(schema, config); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package connectorcmdutil
+
+import (
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/util"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+)
+
+// ReadConnectorFile reads a connector configuration from a file or URL, or from standard input when file is empty
+func ReadConnectorFile(f *factory.Factory, file string) ([]byte, error) {
+	var specifiedFile *os.File
+	var err error
+	if file == "" {
+		f.Logger.Info(f.Localizer.MustLocalize("common.message.reading.file"))
+		specifiedFile, err = util.CreateFileFromStdin()
+		if err != nil {
+			return nil, errors.Wrap(err, f.Localizer.MustLocalize("connector.message.reading.file.error"))
+		}
+	} else {
+		if util.IsURL(file) {
+			specifiedFile, err = util.GetContentFromFileURL(f.Context, file)
+		} else {
+			specifiedFile, err = os.Open(file)
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, f.Localizer.MustLocalize("connector.message.reading.file.error"))
+		}
+	}
+	defer specifiedFile.Close()
+	byteValue, err := io.ReadAll(specifiedFile)
+	if err != nil {
+		return nil, errors.Wrap(err, f.Localizer.MustLocalize("connector.message.reading.file.error"))
+	}
+	return byteValue, nil
+}
@@ -50,3 +50,17 @@ func (fs *FlagSet) AddConnectorName(ruleType *string) *flagutil.FlagOptions {
 	return flagutil.WithFlagOptions(fs.cmd, flagName)
 
 }
+
+// AddCatalogFile adds a flag for reading the connector types from a catalog file instead of the API
+func (fs *FlagSet) AddCatalogFile(path *string) *flagutil.FlagOptions {
+	flagName := "catalog-file"
+
+	fs.StringVar(
+		path,
+		flagName,
+		"",
+		fs.factory.Localizer.MustLocalize("connector.common.flag.catalogFile.description"),
+	)
+
+	return flagutil.WithFlagOptions(fs.cmd, flagName)
+}
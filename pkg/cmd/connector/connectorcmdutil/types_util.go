@@ -1,7 +1,6 @@
 package connectorcmdutil
 
 import (
-	"fmt"
 	"strings"
 
 	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
@@ -9,36 +8,6 @@ import (
 	"github.com/spf13/cobra"
 )
 
-type TypeSearchQuery struct {
-	filters []string
-	input   string
-}
-
-func NewSearchQuery(input string) *TypeSearchQuery {
-	return &TypeSearchQuery{
-		input:   input,
-		filters: make([]string, 0),
-	}
-}
-
-func (sq *TypeSearchQuery) Filter(filter string) *TypeSearchQuery {
-	sq.filters = append(sq.filters, filter)
-	return sq
-}
-
-func (sq *TypeSearchQuery) Build() string {
-	query := ""
-
-	for i := 0; i < len(sq.filters); i++ {
-		query += fmt.Sprintf("%[1]s like %[3]s%[2]s%[3]s", sq.filters[i], sq.input, "%")
-		if i+1 < len(sq.filters) {
-			query += " or "
-		}
-	}
-
-	return query
-}
-
 func FilterValidTypesArgs(f *factory.Factory, toComplete string) ([]string, cobra.ShellCompDirective) {
 	validTypes := []string{}
 	directive := cobra.ShellCompDirectiveNoSpace
@@ -2,10 +2,9 @@ package create
 
 import (
 	"encoding/json"
-	"io"
 	"math/rand"
-	"os"
 	"strconv"
+	"strings"
 
 	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/kafkautil"
@@ -15,9 +14,10 @@ import (
 	_ "embed"
 
 	"github.com/pkg/errors"
-	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/util"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/connector/connectorcmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	connectorerror "github.com/redhat-developer/app-services-sdk-go/connectormgmt/apiv1/error"
 	svcacctmgmtclient "github.com/redhat-developer/app-services-sdk-go/serviceaccountmgmt/apiv1/client"
@@ -38,6 +38,8 @@ type options struct {
 	name           string
 	outputFormat   string
 	serviceAccount bool
+	catalogFile    string
+	dryRun         bool
 	f              *factory.Factory
 }
 
@@ -63,12 +65,14 @@ func NewCreateCommand(f *factory.Factory) *cobra.Command {
 			return runCreate(opts)
 		},
 	}
-	flags := flagutil.NewFlagSet(cmd, f.Localizer)
+	flags := connectorcmdutil.NewFlagSet(cmd, f)
 	flags.StringVarP(&opts.file, "file", "f", "", f.Localizer.MustLocalize("connector.file.flag.description"))
 	flags.StringVar(&opts.kafkaId, "kafka", "", f.Localizer.MustLocalize("connector.flag.kafka.description"))
 	flags.StringVar(&opts.namespace, "namespace", "", f.Localizer.MustLocalize("connector.flag.namespace.description"))
 	flags.StringVar(&opts.name, "name", "", f.Localizer.MustLocalize("connector.flag.name.description"))
 	flags.BoolVar(&opts.serviceAccount, "create-service-account", false, f.Localizer.MustLocalize("connector.flag.sa.description"))
+	flags.AddCatalogFile(&opts.catalogFile)
+	flags.BoolVar(&opts.dryRun, "dry-run", false, f.Localizer.MustLocalize("connector.create.flag.dryRun.description"))
 	flags.AddOutput(&opts.outputFormat)
 
 	return cmd
@@ -77,7 +81,7 @@ func NewCreateCommand(f *factory.Factory) *cobra.Command {
 func runCreate(opts *options) error {
 	f := opts.f
 	// Load the connector from the file
-	fileContent, err := readFileFromInput(opts)
+	fileContent, err := connectorcmdutil.ReadConnectorFile(f, opts.file)
 	if err != nil {
 		return err
 	}
@@ -88,6 +92,16 @@ func runCreate(opts *options) error {
 		return errors.Wrap(err, opts.f.Localizer.MustLocalize("connector.message.reading.file.error"))
 	}
 
+	// the configuration is validated before submission when the types are read from a catalog file
+	if opts.catalogFile != "" || opts.dryRun {
+		if err = validateConnector(opts, &userConnector); err != nil {
+			return err
+		}
+	}
+	if opts.dryRun {
+		return nil
+	}
+
 	opts.f.Logger.Info(opts.f.Localizer.MustLocalize("connector.create.start"))
 
 	err = setDefaultValuesFromFlags(&userConnector, opts)
@@ -138,6 +152,28 @@ func runCreate(opts *options) error {
 	return nil
 }
 
+// validateConnector checks the connector configuration against the schema of its connector type
+func validateConnector(opts *options, connector *connectormgmtclient.ConnectorRequest) error {
+	connectorType, err := connectorcmdutil.GetConnectorType(opts.f, connector.ConnectorTypeId, opts.catalogFile)
+	if err != nil {
+		return err
+	}
+
+	if problems := connectorcmdutil.ValidateConfig(connectorType.Schema, connector.Connector); len(problems) > 0 {
+		lines := make([]string, len(problems))
+		for i, problem := range problems {
+			lines[i] = "\n  - " + problem.String()
+		}
+		return opts.f.Localizer.MustLocalizeError("connector.create.error.invalidConfig",
+			localize.NewEntry("Type", connector.ConnectorTypeId),
+			localize.NewEntry("Problems", strings.Join(lines, "")),
+		)
+	}
+
+	opts.f.Logger.Info(icon.SuccessPrefix(), opts.f.Localizer.MustLocalize("connector.create.log.info.validConfig", localize.NewEntry("Type", connector.ConnectorTypeId)))
+	return nil
+}
+
 func createServiceAccount(opts *factory.Factory, shortDescription string) (*svcacctmgmtclient.ServiceAccountData, error) {
 	conn, err := opts.Connection()
 	if err != nil {
@@ -295,30 +331,3 @@ func askForValue(field string, opts *options) (string, error) {
 	}
 	return value, nil
 }
-
-func readFileFromInput(opts *options) ([]byte, error) {
-	var specifiedFile *os.File
-	var err error
-	if opts.file == "" {
-		opts.f.Logger.Info(opts.f.Localizer.MustLocalize("common.message.reading.file"))
-		specifiedFile, err = util.CreateFileFromStdin()
-		if err != nil {
-			return nil, errors.Wrap(err, opts.f.Localizer.MustLocalize("connector.message.reading.file.error"))
-		}
-	} else {
-		if util.IsURL(opts.file) {
-			specifiedFile, err = util.GetContentFromFileURL(opts.f.Context, opts.file)
-		} else {
-			specifiedFile, err = os.Open(opts.file)
-		}
-		if err != nil {
-			return nil, errors.Wrap(err, opts.f.Localizer.MustLocalize("connector.message.reading.file.error"))
-		}
-	}
-	defer specifiedFile.Close()
-	byteValue, err := io.ReadAll(specifiedFile)
-	if err != nil {
-		return nil, errors.Wrap(err, opts.f.Localizer.MustLocalize("connector.message.reading.file.error"))
-	}
-	return byteValue, nil
-}
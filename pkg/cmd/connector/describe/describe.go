@@ -1,9 +1,12 @@
 package describe
 
 import (
+	"io/ioutil"
+
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/connector/connectorcmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 
 	"github.com/redhat-developer/app-services-cli/pkg/shared/connection"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
@@ -70,11 +73,46 @@ func runDescribe(opts *options) error {
 		return err
 	}
 
-	if err = dump.Formatted(f.IOStreams.Out, opts.outputFormat, response); err != nil {
+	// The raw document keeps the full status block from the fleet manager,
+	// fall back to the SDK model if it cannot be read
+	var document interface{} = response
+	var status connectorStatus
+	if body, readErr := ioutil.ReadAll(httpRes.Body); readErr == nil {
+		if raw, rawStatus, parseErr := parseConnector(body); parseErr == nil {
+			document = raw
+			status = rawStatus
+		}
+	}
+
+	if err = dump.Formatted(f.IOStreams.Out, opts.outputFormat, document); err != nil {
 		return err
 	}
 
+	printStatus(opts, status)
+
 	f.Logger.Info(f.Localizer.MustLocalize("connector.describe.info.success"))
 
 	return nil
 }
+
+// printStatus prints the failure reason and the recent state transitions
+func printStatus(opts *options, status connectorStatus) {
+	f := opts.f
+
+	if status.Error == "" && len(status.Conditions) == 0 {
+		return
+	}
+
+	f.Logger.Info("")
+	f.Logger.Info(f.Localizer.MustLocalize("connector.describe.log.info.state", localize.NewEntry("State", dump.OrPlaceholder(status.State))))
+	if status.Error != "" {
+		f.Logger.Info(f.Localizer.MustLocalize("connector.describe.log.info.error", localize.NewEntry("Error", status.Error)))
+	}
+
+	if len(status.Conditions) > 0 {
+		f.Logger.Info("")
+		f.Logger.Info(f.Localizer.MustLocalize("connector.describe.log.info.history"))
+		dump.Table(f.IOStreams.ErrOut, stateHistory(status.Conditions))
+	}
+	f.Logger.Info("")
+}
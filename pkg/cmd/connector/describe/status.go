@@ -0,0 +1,88 @@
+package describe
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+)
+
+// maxHistoryEntries is the number of state transitions shown in the history
+const maxHistoryEntries = 10
+
+// connectorStatus is the raw status block returned by the fleet manager.
+// It carries more detail than the SDK model, which only keeps the state and error.
+type connectorStatus struct {
+	State      string      `json:"state"`
+	Error      string      `json:"error"`
+	Conditions []condition `json:"conditions"`
+}
+
+type condition struct {
+	Type               string `json:"type"`
+	Status             string `json:"status"`
+	Reason             string `json:"reason"`
+	Message            string `json:"message"`
+	LastTransitionTime string `json:"last_transition_time"`
+}
+
+type historyRow struct {
+	Time    string `header:"Time"`
+	Type    string `header:"Type"`
+	Status  string `header:"Status"`
+	Reason  string `header:"Reason"`
+	Message string `header:"Message"`
+}
+
+// parseConnector decodes the raw connector document and its status block
+func parseConnector(body []byte) (document map[string]interface{}, status connectorStatus, err error) {
+	if err = json.Unmarshal(body, &document); err != nil {
+		return nil, status, err
+	}
+
+	var raw struct {
+		Status connectorStatus `json:"status"`
+	}
+	if err = json.Unmarshal(body, &raw); err != nil {
+		return nil, status, err
+	}
+
+	return document, raw.Status, nil
+}
+
+// stateHistory returns the most recent state transitions, newest first
+func stateHistory(conditions []condition) []historyRow {
+	sorted := make([]condition, len(conditions))
+	copy(sorted, conditions)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return transitionTime(sorted[i]).After(transitionTime(sorted[j]))
+	})
+
+	if len(sorted) > maxHistoryEntries {
+		sorted = sorted[:maxHistoryEntries]
+	}
+
+	rows := make([]historyRow, len(sorted))
+	for i, c := range sorted {
+		rows[i] = historyRow{
+			Time:    dump.OrPlaceholder(c.LastTransitionTime),
+			Type:    dump.OrPlaceholder(c.Type),
+			Status:  dump.OrPlaceholder(c.Status),
+			Reason:  dump.OrPlaceholder(c.Reason),
+			Message: dump.OrPlaceholder(c.Message),
+		}
+	}
+
+	return rows
+}
+
+// transitionTime parses the transition timestamp, conditions without one sort last
+func transitionTime(c condition) time.Time {
+	t, err := time.Parse(time.RFC3339, c.LastTransitionTime)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
@@ -0,0 +1,45 @@
+package describe
+
+import (
+	"testing"
+)
+
+func Test_stateHistory(t *testing.T) {
+	conditions := []condition{
+		{Type: "Ready", Status: "False", Reason: "Error", LastTransitionTime: "2022-05-10T10:00:00Z"},
+		{Type: "Provisioning", Status: "True", LastTransitionTime: "2022-05-10T09:00:00Z"},
+		{Type: "Unknown"},
+		{Type: "Deleting", Status: "False", LastTransitionTime: "2022-05-10T12:30:00+02:00"},
+	}
+
+	got := stateHistory(conditions)
+
+	wantTypes := []string{"Deleting", "Ready", "Provisioning", "Unknown"}
+	if len(got) != len(wantTypes) {
+		t.Fatalf("stateHistory() returned %v rows, want %v", len(got), len(wantTypes))
+	}
+	for i, want := range wantTypes {
+		if got[i].Type != want {
+			t.Errorf("stateHistory()[%v].Type = %v, want %v", i, got[i].Type, want)
+		}
+	}
+	if got[3].Time != "-" {
+		t.Errorf("stateHistory()[3].Time = %v, want placeholder", got[3].Time)
+	}
+}
+
+func Test_parseConnector(t *testing.T) {
+	body := []byte(`{"id":"abc","status":{"state":"failed","error":"image pull failed","phase":"failed","conditions":[{"type":"Ready","reason":"Error"}]}}`)
+
+	document, status, err := parseConnector(body)
+	if err != nil {
+		t.Fatalf("parseConnector() error = %v", err)
+	}
+	if status.State != "failed" || status.Error != "image pull failed" || len(status.Conditions) != 1 {
+		t.Errorf("parseConnector() status = %+v", status)
+	}
+	raw, ok := document["status"].(map[string]interface{})
+	if !ok || raw["phase"] != "failed" {
+		t.Errorf("parseConnector() did not keep the raw status block: %v", document["status"])
+	}
+}
@@ -40,11 +40,12 @@ func NewListCommand(f *factory.Factory) *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:     "list",
-		Short:   f.Localizer.MustLocalize("connector.list.cmd.shortDescription"),
-		Long:    f.Localizer.MustLocalize("connector.list.cmd.longDescription"),
-		Example: f.Localizer.MustLocalize("connector.list.cmd.example"),
-		Args:    cobra.NoArgs,
+		Use:         "list",
+		Short:       f.Localizer.MustLocalize("connector.list.cmd.shortDescription"),
+		Long:        f.Localizer.MustLocalize("connector.list.cmd.longDescription"),
+		Example:     f.Localizer.MustLocalize("connector.list.cmd.example"),
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{cmdutil.PagerAnnotation: "true"},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
 				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
@@ -108,13 +109,13 @@ func mapResponseItemsToRows(items []connectormgmtclient.Connector) []itemRow {
 
 	for i := range items {
 		k := items[i]
-		name := k.GetName()
+		status := k.GetStatus()
 
 		row := itemRow{
-			ID:     k.GetId(),
-			Name:   name,
-			Owner:  k.GetOwner(),
-			Status: string(*k.Status.State),
+			ID:     dump.OrPlaceholder(k.GetId()),
+			Name:   dump.OrPlaceholder(k.GetName()),
+			Owner:  dump.OrPlaceholder(k.GetOwner()),
+			Status: dump.OrPlaceholder(string(status.GetState())),
 		}
 
 		rows[i] = row
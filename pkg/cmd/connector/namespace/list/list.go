@@ -103,13 +103,11 @@ func mapResponseItemsToRows(items []connectormgmtclient.ConnectorNamespace) []it
 
 	for i := range items {
 		k := items[i]
-		name := k.GetName()
-
 		row := itemRow{
-			ID:      k.GetId(),
-			Name:    name,
-			Owner:   k.GetOwner(),
-			Cluster: k.GetClusterId(),
+			ID:      dump.OrPlaceholder(k.GetId()),
+			Name:    dump.OrPlaceholder(k.GetName()),
+			Owner:   dump.OrPlaceholder(k.GetOwner()),
+			Cluster: dump.OrPlaceholder(k.GetClusterId()),
 		}
 
 		rows[i] = row
@@ -24,6 +24,7 @@ type options struct {
 	id      string
 
 	outputFormat string
+	dryRun       bool
 	f            *factory.Factory
 }
 
@@ -68,6 +69,7 @@ func NewUpdateCommand(f *factory.Factory) *cobra.Command {
 	flags.StringVar(&opts.id, "id", "", f.Localizer.MustLocalize("connector.flag.id.description"))
 	flags.StringVar(&opts.name, "name", "", f.Localizer.MustLocalize("connector.flag.name.description"))
 	flags.StringVar(&opts.kafkaID, "kafka-id", "", f.Localizer.MustLocalize("connector.flag.kafkaID.description"))
+	flags.BoolVar(&opts.dryRun, "dry-run", false, f.Localizer.MustLocalize("connector.update.flag.dryRun.description"))
 	flags.AddOutput(&opts.outputFormat)
 
 	return cmd
@@ -87,6 +89,12 @@ func runUpdate(opts *options) error {
 		return err
 	}
 
+	// the connector is changed in place, so its initial state is kept for the dry run
+	original, err := json.Marshal(connector)
+	if err != nil {
+		return err
+	}
+
 	connectorChanged := false
 	if opts.name != "" {
 		connector.SetName(opts.name)
@@ -121,6 +129,21 @@ func runUpdate(opts *options) error {
 		return err
 	}
 
+	if opts.dryRun {
+		diff, diffErr := dump.NewDiff("Connector", connector.GetName(), json.RawMessage(original), patchData)
+		if diffErr != nil {
+			return diffErr
+		}
+
+		if err = dump.PrintDiff(opts.f.IOStreams.Out, opts.outputFormat, diff); err != nil {
+			return err
+		}
+
+		opts.f.Logger.Info(opts.f.Localizer.MustLocalize("connector.update.info.dryRun"))
+
+		return nil
+	}
+
 	a := conn.API().ConnectorsMgmt().ConnectorsApi.PatchConnector(opts.f.Context, connector.GetId())
 	a = a.Body(patchData)
 	updated, httpRes, err := a.Execute()
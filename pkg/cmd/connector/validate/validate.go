@@ -0,0 +1,112 @@
+package validate
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/connector/connectorcmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	connectormgmtclient "github.com/redhat-developer/app-services-sdk-go/connectormgmt/apiv1/client"
+
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	file         string
+	catalogFile  string
+	outputFormat string
+
+	f *factory.Factory
+}
+
+// validationResult is the result of the validation of a connector configuration
+type validationResult struct {
+	ConnectorTypeID string                         `json:"connector_type_id" yaml:"connector_type_id"`
+	Valid           bool                           `json:"valid" yaml:"valid"`
+	Errors          []connectorcmdutil.ConfigError `json:"errors" yaml:"errors"`
+}
+
+// NewValidateCommand creates a new command to validate a connector configuration against the schema of its type
+func NewValidateCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "validate",
+		Short:   f.Localizer.MustLocalize("connector.validate.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("connector.validate.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("connector.validate.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			return runValidate(opts)
+		},
+	}
+
+	flags := connectorcmdutil.NewFlagSet(cmd, f)
+	flags.StringVarP(&opts.file, "file", "f", "", f.Localizer.MustLocalize("connector.file.flag.description"))
+	flags.AddCatalogFile(&opts.catalogFile)
+	flags.AddOutput(&opts.outputFormat)
+
+	return cmd
+}
+
+func runValidate(opts *options) error {
+	f := opts.f
+
+	fileContent, err := connectorcmdutil.ReadConnectorFile(f, opts.file)
+	if err != nil {
+		return err
+	}
+
+	var connector connectormgmtclient.ConnectorRequest
+	if err = json.Unmarshal(fileContent, &connector); err != nil {
+		return errors.Wrap(err, f.Localizer.MustLocalize("connector.message.reading.file.error"))
+	}
+
+	if connector.ConnectorTypeId == "" {
+		return f.Localizer.MustLocalizeError("connector.validate.error.noType")
+	}
+
+	connectorType, err := connectorcmdutil.GetConnectorType(f, connector.ConnectorTypeId, opts.catalogFile)
+	if err != nil {
+		return err
+	}
+
+	problems := connectorcmdutil.ValidateConfig(connectorType.Schema, connector.Connector)
+	result := validationResult{
+		ConnectorTypeID: connector.ConnectorTypeId,
+		Valid:           len(problems) == 0,
+		Errors:          problems,
+	}
+	if result.Errors == nil {
+		result.Errors = []connectorcmdutil.ConfigError{}
+	}
+
+	if opts.outputFormat != dump.EmptyFormat {
+		if err = dump.Formatted(f.IOStreams.Out, opts.outputFormat, result); err != nil {
+			return err
+		}
+	} else if !result.Valid {
+		dump.Table(f.IOStreams.Out, result.Errors)
+		f.Logger.Info("")
+	}
+
+	if !result.Valid {
+		return f.Localizer.MustLocalizeError("connector.validate.error.invalid",
+			localize.NewEntry("Count", len(problems)),
+			localize.NewEntry("Type", connector.ConnectorTypeId),
+		)
+	}
+
+	f.Logger.Info(icon.SuccessPrefix(), f.Localizer.MustLocalize("connector.create.log.info.validConfig", localize.NewEntry("Type", connector.ConnectorTypeId)))
+	return nil
+}
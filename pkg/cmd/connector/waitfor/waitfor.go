@@ -0,0 +1,127 @@
+package waitfor
+
+import (
+	"context"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/connector/connectorcmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/jobs"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/waitutil"
+	connectormgmtclient "github.com/redhat-developer/app-services-sdk-go/connectormgmt/apiv1/client"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultCondition = "status.state=ready"
+	defaultTimeout   = 20 * time.Minute
+)
+
+type options struct {
+	id        string
+	condition string
+	timeout   time.Duration
+	async     bool
+
+	f *factory.Factory
+}
+
+// NewWaitForCommand creates a new command which waits until a connector satisfies a condition
+func NewWaitForCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "wait-for",
+		Short:   f.Localizer.MustLocalize("connector.waitFor.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("connector.waitFor.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("connector.waitFor.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.timeout <= 0 {
+				return f.Localizer.MustLocalizeError("waitFor.error.invalidTimeout", localize.NewEntry("Timeout", opts.timeout))
+			}
+
+			if opts.async {
+				return jobs.RunAsync(f)
+			}
+
+			return runWaitFor(opts)
+		},
+	}
+
+	flags := connectorcmdutil.NewFlagSet(cmd, f)
+
+	flags.AddConnectorID(&opts.id)
+	flags.StringVar(&opts.condition, "condition", defaultCondition, f.Localizer.MustLocalize("waitFor.flag.condition.description"))
+	flags.DurationVar(&opts.timeout, "timeout", defaultTimeout, f.Localizer.MustLocalize("waitFor.flag.timeout.description"))
+	flags.AddAsync(&opts.async)
+
+	return cmd
+}
+
+func runWaitFor(opts *options) error {
+	f := opts.f
+
+	condition, err := waitutil.ParseCondition(opts.condition)
+	if err != nil {
+		return err
+	}
+
+	var conn connection.Connection
+	if conn, err = f.Connection(); err != nil {
+		return err
+	}
+
+	var connector *connectormgmtclient.Connector
+	if opts.id == "" {
+		connector, err = contextutil.GetCurrentConnectorInstance(&conn, f)
+	} else {
+		connector, err = getConnector(f.Context, conn, opts.id)
+	}
+	if err != nil {
+		return err
+	}
+
+	fetch := func(ctx context.Context) (interface{}, error) {
+		return getConnector(ctx, conn, connector.GetId())
+	}
+
+	abort := func(resource interface{}) error {
+		connector := resource.(*connectormgmtclient.Connector)
+		status := connector.GetStatus()
+		if status.GetState() == connectormgmtclient.CONNECTORSTATE_FAILED {
+			return f.Localizer.MustLocalizeError("connector.waitFor.error.failed",
+				localize.NewEntry("Name", connector.GetName()),
+				localize.NewEntry("Reason", status.GetError()),
+			)
+		}
+		return nil
+	}
+
+	_, err = waitutil.UntilWithSpinner(f, connector.GetName(), fetch, condition, waitutil.Options{
+		Interval: cmdutil.DefaultPollTime,
+		Timeout:  opts.timeout,
+		Abort:    abort,
+	})
+
+	return err
+}
+
+func getConnector(ctx context.Context, conn connection.Connection, id string) (*connectormgmtclient.Connector, error) {
+	connector, httpRes, err := conn.API().ConnectorsMgmt().ConnectorsApi.GetConnector(ctx, id).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &connector, nil
+}
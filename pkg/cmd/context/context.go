@@ -5,7 +5,10 @@ import (
 	connectorUse "github.com/redhat-developer/app-services-cli/pkg/cmd/connector/use"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/context/create"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/context/delete"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/context/group"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/context/list"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/context/rename"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/context/setdescription"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/context/unset"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/context/use"
 	kafkaUse "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/use"
@@ -57,6 +60,9 @@ func NewContextCmd(f *factory.Factory) *cobra.Command {
 		list.NewListCommand(f),
 		create.NewCreateCommand(f),
 		delete.NewDeleteCommand(f),
+		rename.NewRenameCommand(f),
+		setdescription.NewSetDescriptionCommand(f),
+		group.NewGroupCommand(f),
 		unset.NewUnsetCommand(f),
 
 		// reused sub-commands
@@ -22,7 +22,8 @@ type options struct {
 	Context        context.Context
 	ServiceContext servicecontext.IContext
 
-	name string
+	name        string
+	description string
 }
 
 // NewCreateCommand creates a new command to create contexts
@@ -57,6 +58,13 @@ func NewCreateCommand(f *factory.Factory) *cobra.Command {
 		opts.localizer.MustLocalize("context.common.flag.name"),
 	)
 
+	flags.StringVar(
+		&opts.description,
+		"description",
+		"",
+		opts.localizer.MustLocalize("context.create.flag.description"),
+	)
+
 	return cmd
 
 }
@@ -94,7 +102,9 @@ func runCreate(opts *options) error {
 		return opts.localizer.MustLocalizeError("context.create.log.alreadyExists", localize.NewEntry("Name", opts.name))
 	}
 
-	svcContextsMap[opts.name] = servicecontext.ServiceConfig{}
+	svcContextsMap[opts.name] = servicecontext.ServiceConfig{
+		Description: opts.description,
+	}
 	svcContext.CurrentContext = opts.name
 
 	svcContext.Contexts = svcContextsMap
@@ -2,6 +2,7 @@ package delete
 
 import (
 	"context"
+	"strings"
 
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/context/contextcmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
@@ -80,6 +81,17 @@ func runDelete(opts *options) error {
 		return err
 	}
 
+	// the context of a group is deleted with the group, and a member context must be removed from its groups first
+	if _, isGroup := svcContext.Groups[opts.name]; isGroup {
+		return opts.localizer.MustLocalizeError("context.delete.error.group", localize.NewEntry("Name", opts.name))
+	}
+	if groups := servicecontext.GroupsOf(svcContext, opts.name); len(groups) > 0 {
+		return opts.localizer.MustLocalizeError("context.delete.error.groupMember",
+			localize.NewEntry("Name", opts.name),
+			localize.NewEntry("Groups", strings.Join(groups, ", ")),
+		)
+	}
+
 	delete(svcContext.Contexts, opts.name)
 
 	err = opts.ServiceContext.Save(svcContext)
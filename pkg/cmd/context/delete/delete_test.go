@@ -0,0 +1,50 @@
+package delete
+
+import (
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory/fakes"
+)
+
+func TestDeleteGroupContexts(t *testing.T) {
+	tests := []struct {
+		name    string
+		context string
+		wantErr bool
+	}{
+		{name: "member of a group", context: "kafka-eu", wantErr: true},
+		{name: "context of a group", context: "prod", wantErr: true},
+		{name: "context outside of groups", context: "scratch"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := fakes.NewFactory(t)
+			_ = f.ServiceContext.Save(&servicecontext.Context{
+				Contexts: map[string]servicecontext.ServiceConfig{
+					"kafka-eu": {KafkaID: "kafka-a"},
+					"prod":     {KafkaID: "kafka-a"},
+					"scratch":  {},
+				},
+				CurrentContext: "prod",
+				Groups: map[string]servicecontext.Group{
+					"prod": {Contexts: []string{"kafka-eu"}},
+				},
+			})
+
+			cmd := NewDeleteCommand(f.Factory)
+			cmd.SetArgs([]string{"--name", tt.context})
+			cmd.SetOut(f.Out)
+			cmd.SetErr(f.ErrOut)
+			err := cmd.Execute()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Execute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			svcContext, _ := f.ServiceContext.Load()
+			if _, exists := svcContext.Contexts[tt.context]; exists != tt.wantErr {
+				t.Errorf("context exists = %v, want %v", exists, tt.wantErr)
+			}
+		})
+	}
+}
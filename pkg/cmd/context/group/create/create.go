@@ -0,0 +1,125 @@
+package create
+
+import (
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/context/contextcmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/context/group/groupcmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	name     string
+	contexts []string
+
+	f *factory.Factory
+}
+
+// NewCreateCommand creates a new command to create context groups
+func NewCreateCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "create",
+		Short:   f.Localizer.MustLocalize("context.group.create.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("context.group.create.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("context.group.create.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCreate(opts)
+		},
+	}
+
+	flags := groupcmdutil.NewFlagSet(cmd, f)
+
+	flags.StringVar(
+		&opts.name,
+		"name",
+		"",
+		f.Localizer.MustLocalize("context.group.common.flag.name"),
+	)
+	_ = cmd.MarkFlagRequired("name")
+	flags.StringSliceVar(
+		&opts.contexts,
+		"context",
+		[]string{},
+		f.Localizer.MustLocalize("context.group.create.flag.context.description"),
+	)
+	_ = cmd.MarkFlagRequired("context")
+
+	_ = cmd.RegisterFlagCompletionFunc("context", func(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		svcContext, err := f.ServiceContext.Load()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		names := make([]string, 0, len(svcContext.Contexts))
+		for name := range svcContext.Contexts {
+			if _, isGroup := svcContext.Groups[name]; !isGroup {
+				names = append(names, name)
+			}
+		}
+		return names, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	return cmd
+}
+
+func runCreate(opts *options) error {
+	f := opts.f
+
+	svcContext, err := f.ServiceContext.Load()
+	if err != nil {
+		return err
+	}
+
+	validator := &contextcmdutil.Validator{
+		Localizer:  f.Localizer,
+		SvcContext: svcContext,
+	}
+
+	if err = validator.ValidateName(opts.name); err != nil {
+		return err
+	}
+
+	// "context group use" stores the merged services as a context with the same name
+	if _, ok := svcContext.Groups[opts.name]; ok {
+		return f.Localizer.MustLocalizeError("context.group.create.error.alreadyExists", localize.NewEntry("Name", opts.name))
+	}
+	if _, ok := svcContext.Contexts[opts.name]; ok {
+		return f.Localizer.MustLocalizeError("context.group.create.error.contextExists", localize.NewEntry("Name", opts.name))
+	}
+
+	for _, ctxName := range opts.contexts {
+		if _, ok := svcContext.Contexts[ctxName]; !ok {
+			return f.Localizer.MustLocalizeError("context.common.error.context.notFound", localize.NewEntry("Name", ctxName))
+		}
+		if _, isGroup := svcContext.Groups[ctxName]; isGroup {
+			return f.Localizer.MustLocalizeError("context.group.create.error.nestedGroup", localize.NewEntry("Name", ctxName))
+		}
+	}
+
+	if svcContext.Groups == nil {
+		svcContext.Groups = make(map[string]servicecontext.Group)
+	}
+
+	svcContext.Groups[opts.name] = servicecontext.Group{
+		Contexts: opts.contexts,
+	}
+
+	if err = f.ServiceContext.Save(svcContext); err != nil {
+		return err
+	}
+
+	f.Logger.Info(icon.SuccessPrefix(), f.Localizer.MustLocalize("context.group.create.log.successMessage",
+		localize.NewEntry("Name", opts.name),
+		localize.NewEntry("Contexts", strings.Join(opts.contexts, ", ")),
+	))
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package delete
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/context/group/groupcmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	name string
+
+	f *factory.Factory
+}
+
+// NewDeleteCommand creates a new command to delete context groups
+func NewDeleteCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Short:   f.Localizer.MustLocalize("context.group.delete.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("context.group.delete.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("context.group.delete.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDelete(opts)
+		},
+	}
+
+	flags := groupcmdutil.NewFlagSet(cmd, f)
+	_ = flags.AddGroupName(&opts.name).Required()
+
+	return cmd
+}
+
+func runDelete(opts *options) error {
+	f := opts.f
+
+	svcContext, err := f.ServiceContext.Load()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := svcContext.Groups[opts.name]; !ok {
+		return f.Localizer.MustLocalizeError("context.group.common.error.notFound", localize.NewEntry("Name", opts.name))
+	}
+
+	delete(svcContext.Groups, opts.name)
+	// the member contexts are kept, only the context generated by "context group use" is removed
+	delete(svcContext.Contexts, opts.name)
+
+	if svcContext.CurrentContext == opts.name {
+		svcContext.CurrentContext = ""
+		f.Logger.Info(f.Localizer.MustLocalize("context.delete.log.warning.currentUnset"))
+	}
+
+	if err = f.ServiceContext.Save(svcContext); err != nil {
+		return err
+	}
+
+	f.Logger.Info(icon.SuccessPrefix(), f.Localizer.MustLocalize("context.group.delete.log.successMessage", localize.NewEntry("Name", opts.name)))
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package group
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/context/group/create"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/context/group/delete"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/context/group/list"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/context/group/use"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+// NewGroupCommand creates a new command to manage groups of service contexts
+func NewGroupCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "group",
+		Short:   f.Localizer.MustLocalize("context.group.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("context.group.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("context.group.cmd.example"),
+		Args:    cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		create.NewCreateCommand(f),
+		use.NewUseCommand(f),
+		list.NewListCommand(f),
+		delete.NewDeleteCommand(f),
+	)
+
+	return cmd
+}
@@ -0,0 +1,58 @@
+package groupcmdutil
+
+import (
+	"sort"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+type FlagSet struct {
+	cmd     *cobra.Command
+	factory *factory.Factory
+	*flagutil.FlagSet
+}
+
+// NewFlagSet returns a new flag set with common context group flags
+func NewFlagSet(cmd *cobra.Command, f *factory.Factory) *FlagSet {
+	return &FlagSet{
+		cmd:     cmd,
+		factory: f,
+		FlagSet: flagutil.NewFlagSet(cmd, f.Localizer),
+	}
+}
+
+// AddGroupName adds a flag for setting the name of the context group
+func (fs *FlagSet) AddGroupName(name *string) *flagutil.FlagOptions {
+	flagName := "name"
+
+	fs.StringVar(
+		name,
+		flagName,
+		"",
+		fs.factory.Localizer.MustLocalize("context.group.common.flag.name"),
+	)
+
+	_ = fs.cmd.RegisterFlagCompletionFunc(flagName, func(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return GroupNames(fs.factory), cobra.ShellCompDirectiveNoSpace
+	})
+
+	return flagutil.WithFlagOptions(fs.cmd, flagName)
+}
+
+// GroupNames returns the names of the context groups in the context file
+func GroupNames(f *factory.Factory) []string {
+	svcContext, err := f.ServiceContext.Load()
+	if err != nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(svcContext.Groups))
+	for name := range svcContext.Groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
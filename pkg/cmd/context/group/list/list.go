@@ -0,0 +1,86 @@
+package list
+
+import (
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/context/group/groupcmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	outputFormat string
+
+	f *factory.Factory
+}
+
+type groupRow struct {
+	Name     string `json:"name" header:"Name"`
+	Contexts string `json:"contexts" header:"Contexts"`
+	Current  string `json:"current" header:"Current"`
+}
+
+// NewListCommand creates a new command to list context groups
+func NewListCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   f.Localizer.MustLocalize("context.group.list.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("context.group.list.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("context.group.list.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			return runList(opts)
+		},
+	}
+
+	flags := groupcmdutil.NewFlagSet(cmd, f)
+	flags.AddOutput(&opts.outputFormat)
+	flags.AddPrintSchema(map[string]servicecontext.Group{})
+
+	return cmd
+}
+
+func runList(opts *options) error {
+	f := opts.f
+
+	svcContext, err := f.ServiceContext.Load()
+	if err != nil {
+		return err
+	}
+
+	if opts.outputFormat != dump.EmptyFormat {
+		return dump.Formatted(f.IOStreams.Out, opts.outputFormat, svcContext.Groups)
+	}
+
+	if len(svcContext.Groups) == 0 {
+		f.Logger.Info(f.Localizer.MustLocalize("context.group.list.log.info.noGroups"))
+		return nil
+	}
+
+	names := groupcmdutil.GroupNames(f)
+	rows := make([]groupRow, len(names))
+	for i, name := range names {
+		rows[i] = groupRow{
+			Name:     name,
+			Contexts: strings.Join(svcContext.Groups[name].Contexts, ", "),
+		}
+		if name == svcContext.CurrentContext {
+			rows[i].Current = icon.SuccessPrefix()
+		}
+	}
+	dump.Table(f.IOStreams.Out, rows)
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package use
+
+import (
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/context/group/groupcmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	name string
+
+	f *factory.Factory
+}
+
+// NewUseCommand creates a new command to switch to all the contexts of a context group
+func NewUseCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "use [name]",
+		Short:   f.Localizer.MustLocalize("context.group.use.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("context.group.use.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("context.group.use.cmd.example"),
+		Args:    cobra.MaximumNArgs(1),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return groupcmdutil.GroupNames(f), cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				if opts.name != "" && opts.name != args[0] {
+					return f.Localizer.MustLocalizeError("context.group.use.error.nameConflict")
+				}
+				opts.name = args[0]
+			}
+
+			if !f.IOStreams.CanPrompt() && opts.name == "" {
+				return flagutil.RequiredWhenNonInteractiveError("name")
+			}
+
+			return runUse(opts)
+		},
+	}
+
+	flags := groupcmdutil.NewFlagSet(cmd, f)
+	flags.AddGroupName(&opts.name)
+
+	return cmd
+}
+
+func runUse(opts *options) error {
+	f := opts.f
+
+	svcContext, err := f.ServiceContext.Load()
+	if err != nil {
+		return err
+	}
+
+	if opts.name == "" {
+		opts.name, err = runInteractivePrompt(opts)
+		if err != nil || opts.name == "" {
+			return err
+		}
+	}
+
+	group, ok := svcContext.Groups[opts.name]
+	if !ok {
+		return f.Localizer.MustLocalizeError("context.group.common.error.notFound", localize.NewEntry("Name", opts.name))
+	}
+
+	configs := make([]servicecontext.ServiceConfig, 0, len(group.Contexts))
+	for _, ctxName := range group.Contexts {
+		cfg, ok := svcContext.Contexts[ctxName]
+		if !ok {
+			return f.Localizer.MustLocalizeError("context.group.use.error.contextNotFound",
+				localize.NewEntry("Name", opts.name),
+				localize.NewEntry("Context", ctxName),
+			)
+		}
+		configs = append(configs, cfg)
+	}
+
+	// The merged services are stored as a regular context, so every command which reads the current
+	// context works with the group unchanged. The context is kept in sync with the member contexts
+	// when the contexts are loaded and saved.
+	if svcContext.Contexts == nil {
+		svcContext.Contexts = make(map[string]servicecontext.ServiceConfig)
+	}
+	svcContext.Contexts[opts.name] = servicecontext.Merge(configs...)
+	svcContext.CurrentContext = opts.name
+
+	if err = f.ServiceContext.Save(svcContext); err != nil {
+		return err
+	}
+
+	f.Logger.Info(icon.SuccessPrefix(), f.Localizer.MustLocalize("context.group.use.log.successMessage",
+		localize.NewEntry("Name", opts.name),
+		localize.NewEntry("Contexts", strings.Join(group.Contexts, ", ")),
+	))
+
+	return nil
+}
+
+func runInteractivePrompt(opts *options) (string, error) {
+	f := opts.f
+
+	names := groupcmdutil.GroupNames(f)
+	if len(names) == 0 {
+		f.Logger.Info(f.Localizer.MustLocalize("context.group.list.log.info.noGroups"))
+		return "", nil
+	}
+
+	prompt := &survey.Select{
+		Message:  f.Localizer.MustLocalize("context.group.common.flag.name"),
+		Options:  names,
+		PageSize: 10,
+	}
+
+	var name string
+	if err := survey.AskOne(prompt, &name); err != nil {
+		return "", err
+	}
+
+	return name, nil
+}
@@ -2,7 +2,7 @@ package list
 
 import (
 	"context"
-	"fmt"
+	"sort"
 
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/context/contextcmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
@@ -26,6 +26,12 @@ type options struct {
 	outputFormat string
 }
 
+type contextRow struct {
+	Name        string `header:"Name"`
+	Description string `header:"Description"`
+	Current     string `header:"Current"`
+}
+
 // NewListCommand creates a new command to list available contexts
 func NewListCommand(f *factory.Factory) *cobra.Command {
 
@@ -51,6 +57,7 @@ func NewListCommand(f *factory.Factory) *cobra.Command {
 	flags := contextcmdutil.NewFlagSet(cmd, f)
 
 	flags.AddOutput(&opts.outputFormat)
+	flags.AddPrintSchema(map[string]servicecontext.ServiceConfig{})
 
 	return cmd
 }
@@ -70,17 +77,23 @@ func runList(opts *options) error {
 	}
 
 	if opts.outputFormat == dump.EmptyFormat {
-		currentCtx := svcContext.CurrentContext
-		var profileList string
-
+		names := make([]string, 0, len(svcContextsMap))
 		for name := range svcContextsMap {
-			if currentCtx != "" && name == currentCtx {
-				profileList += fmt.Sprintln(name, icon.SuccessPrefix())
-			} else {
-				profileList += fmt.Sprintln(name)
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		rows := make([]contextRow, len(names))
+		for i, name := range names {
+			rows[i] = contextRow{
+				Name:        name,
+				Description: dump.OrPlaceholder(svcContextsMap[name].Description),
+			}
+			if name == svcContext.CurrentContext {
+				rows[i].Current = icon.SuccessPrefix()
 			}
 		}
-		opts.Logger.Info(profileList)
+		dump.Table(opts.IO.Out, rows)
 		return nil
 	}
 
@@ -0,0 +1,111 @@
+package rename
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/context/contextcmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	IO             *iostreams.IOStreams
+	Logger         logging.Logger
+	localizer      localize.Localizer
+	ServiceContext servicecontext.IContext
+
+	oldName string
+	newName string
+}
+
+// NewRenameCommand creates a new command to rename contexts
+func NewRenameCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		IO:             f.IOStreams,
+		Logger:         f.Logger,
+		localizer:      f.Localizer,
+		ServiceContext: f.ServiceContext,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "rename <old-name> <new-name>",
+		Short:   f.Localizer.MustLocalize("context.rename.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("context.rename.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("context.rename.cmd.example"),
+		Args:    cobra.ExactArgs(2),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			svcContext, err := f.ServiceContext.Load()
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			names := make([]string, 0, len(svcContext.Contexts))
+			for name := range svcContext.Contexts {
+				if _, isGroup := svcContext.Groups[name]; !isGroup {
+					names = append(names, name)
+				}
+			}
+			return names, cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.oldName = args[0]
+			opts.newName = args[1]
+
+			return runRename(opts)
+		},
+	}
+
+	return cmd
+}
+
+func runRename(opts *options) error {
+	svcContext, err := opts.ServiceContext.Load()
+	if err != nil {
+		return err
+	}
+
+	if _, err = contextutil.GetContext(svcContext, opts.localizer, opts.oldName); err != nil {
+		return err
+	}
+
+	// "context group use" stores the merged services as a context with the name of the group
+	if _, isGroup := svcContext.Groups[opts.oldName]; isGroup {
+		return opts.localizer.MustLocalizeError("context.rename.error.group", localize.NewEntry("Name", opts.oldName))
+	}
+
+	validator := &contextcmdutil.Validator{
+		Localizer:  opts.localizer,
+		SvcContext: svcContext,
+	}
+
+	if err = validator.ValidateName(opts.newName); err != nil {
+		return err
+	}
+
+	if err = validator.ValidateNameIsAvailable(opts.newName); err != nil {
+		return err
+	}
+
+	if _, isGroup := svcContext.Groups[opts.newName]; isGroup {
+		return opts.localizer.MustLocalizeError("context.rename.error.groupExists", localize.NewEntry("Name", opts.newName))
+	}
+
+	servicecontext.Rename(svcContext, opts.oldName, opts.newName)
+
+	if err = opts.ServiceContext.Save(svcContext); err != nil {
+		return err
+	}
+
+	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("context.rename.log.successMessage",
+		localize.NewEntry("OldName", opts.oldName),
+		localize.NewEntry("NewName", opts.newName),
+	))
+
+	return nil
+}
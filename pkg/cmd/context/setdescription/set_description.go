@@ -0,0 +1,91 @@
+package setdescription
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	IO             *iostreams.IOStreams
+	Logger         logging.Logger
+	localizer      localize.Localizer
+	ServiceContext servicecontext.IContext
+
+	name        string
+	description string
+}
+
+// NewSetDescriptionCommand creates a new command to set the description of an existing context
+func NewSetDescriptionCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		IO:             f.IOStreams,
+		Logger:         f.Logger,
+		localizer:      f.Localizer,
+		ServiceContext: f.ServiceContext,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "set-description <name> <description>",
+		Short:   f.Localizer.MustLocalize("context.setDescription.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("context.setDescription.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("context.setDescription.cmd.example"),
+		Args:    cobra.ExactArgs(2),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			svcContext, err := f.ServiceContext.Load()
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			names := make([]string, 0, len(svcContext.Contexts))
+			for name := range svcContext.Contexts {
+				names = append(names, name)
+			}
+			return names, cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.name = args[0]
+			opts.description = args[1]
+
+			return runSetDescription(opts)
+		},
+	}
+
+	return cmd
+}
+
+func runSetDescription(opts *options) error {
+	svcContext, err := opts.ServiceContext.Load()
+	if err != nil {
+		return err
+	}
+
+	ctx, err := contextutil.GetContext(svcContext, opts.localizer, opts.name)
+	if err != nil {
+		return err
+	}
+
+	// the description of a group context is kept when the group is refreshed
+	ctx.Description = opts.description
+	svcContext.Contexts[opts.name] = *ctx
+
+	if err = opts.ServiceContext.Save(svcContext); err != nil {
+		return err
+	}
+
+	if opts.description == "" {
+		opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("context.setDescription.log.cleared", localize.NewEntry("Name", opts.name)))
+		return nil
+	}
+
+	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("context.setDescription.log.successMessage", localize.NewEntry("Name", opts.name)))
+
+	return nil
+}
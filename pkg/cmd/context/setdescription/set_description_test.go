@@ -0,0 +1,64 @@
+package setdescription
+
+import (
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory/fakes"
+)
+
+func TestSetDescriptionCommand(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		wantErr     bool
+		wantContext string
+		want        string
+	}{
+		{name: "set", args: []string{"dev", "Payments team"}, wantContext: "dev", want: "Payments team"},
+		{name: "replace", args: []string{"prod", "Production"}, wantContext: "prod", want: "Production"},
+		{name: "remove", args: []string{"prod", ""}, wantContext: "prod", want: ""},
+		{name: "group context", args: []string{"team", "Both environments"}, wantContext: "team", want: "Both environments"},
+		{name: "unknown context", args: []string{"staging", "Staging"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := fakes.NewFactory(t)
+			// "context group use" stores the context of the group with the contexts
+			_ = f.ServiceContext.Save(&servicecontext.Context{
+				CurrentContext: "team",
+				Contexts: map[string]servicecontext.ServiceConfig{
+					"dev":  {KafkaID: "kafka-a"},
+					"prod": {KafkaID: "kafka-b", Description: "Old description"},
+					"team": {KafkaID: "kafka-a"},
+				},
+				Groups: map[string]servicecontext.Group{
+					"team": {Contexts: []string{"dev", "prod"}},
+				},
+			})
+
+			cmd := NewSetDescriptionCommand(f.Factory)
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Execute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			svcContext, err := f.ServiceContext.Load()
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := svcContext.Contexts[tt.wantContext]
+			if got.Description != tt.want {
+				t.Errorf("description = %q, want %q", got.Description, tt.want)
+			}
+			// the services of the context are kept
+			if tt.wantContext == "dev" && got.KafkaID != "kafka-a" {
+				t.Errorf("KafkaID = %q, want the Kafka instance of the context", got.KafkaID)
+			}
+		})
+	}
+}
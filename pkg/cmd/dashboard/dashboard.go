@@ -0,0 +1,416 @@
+package dashboard
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/topiccmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/httputil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/color"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/kafkautil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/svcstatus"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/spf13/cobra"
+)
+
+const (
+	clearScreen       = "\033[H\033[2J"
+	defaultInterval   = 10 * time.Second
+	consumerGroupSize = 100
+)
+
+type kafkaRow struct {
+	Selected      string `header:" "`
+	Name          string `header:"Name"`
+	Status        string `header:"Status"`
+	CloudProvider string `header:"Cloud Provider"`
+	Region        string `header:"Region"`
+}
+
+type topicRow struct {
+	Name       string `header:"Topic"`
+	Partitions int    `header:"Partitions"`
+	Lag        int64  `header:"Consumer Lag"`
+}
+
+type groupRow struct {
+	GroupID         string `header:"Consumer Group"`
+	State           string `header:"State"`
+	ActiveConsumers int32  `header:"Active Consumers"`
+	Lag             int64  `header:"Total Lag"`
+}
+
+type options struct {
+	interval time.Duration
+
+	f *factory.Factory
+}
+
+// state is the data currently displayed by the dashboard
+type state struct {
+	kafkas     []kafkamgmtclient.KafkaRequest
+	selected   int
+	selectedID string
+	topics     []topicRow
+	groups     []groupRow
+	loading    bool
+	err        error
+	updatedAt  time.Time
+	// seq identifies the latest refresh, the results of older refreshes are discarded
+	seq int
+}
+
+// update is the data loaded by a refresh, applied to the state by the main loop
+type update struct {
+	seq        int
+	kafkas     []kafkamgmtclient.KafkaRequest
+	selectedID string
+	topics     []topicRow
+	groups     []groupRow
+	err        error
+	updatedAt  time.Time
+}
+
+// NewDashboardCommand creates a command which displays an interactive dashboard of the Kafka instances
+func NewDashboardCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "dashboard",
+		Short:   f.Localizer.MustLocalize("dashboard.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("dashboard.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("dashboard.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !f.IOStreams.CanPrompt() || !f.IOStreams.IsStdoutTTY() {
+				return f.Localizer.MustLocalizeError("dashboard.error.notInteractive")
+			}
+
+			if opts.interval < time.Second {
+				return f.Localizer.MustLocalizeError("dashboard.error.invalidInterval", localize.NewEntry("Interval", opts.interval))
+			}
+
+			return runDashboard(opts)
+		},
+	}
+
+	cmd.Flags().DurationVar(&opts.interval, "interval", defaultInterval, f.Localizer.MustLocalize("dashboard.flag.interval.description"))
+
+	return cmd
+}
+
+// nolint:funlen
+func runDashboard(opts *options) error {
+	f := opts.f
+
+	in, inOk := f.IOStreams.In.(*os.File)
+	out, outOk := f.IOStreams.Out.(*os.File)
+	if !inOk || !outOk {
+		return f.Localizer.MustLocalizeError("dashboard.error.notInteractive")
+	}
+
+	s := &state{}
+	if svcContext, err := f.ServiceContext.Load(); err == nil {
+		if currCtx, err := contextutil.GetCurrentContext(svcContext, f.Localizer); err == nil {
+			s.selectedID = currCtx.KafkaID
+		}
+	}
+
+	reader := terminal.NewRuneReader(terminal.Stdio{In: in, Out: out, Err: f.IOStreams.ErrOut})
+	if err := reader.SetTermMode(); err != nil {
+		return err
+	}
+	defer func() {
+		_ = reader.RestoreTermMode()
+	}()
+
+	// ctx is cancelled on quit, which stops the key reader and the refresh in progress
+	ctx, cancel := context.WithCancel(f.Context)
+	defer cancel()
+
+	keys := make(chan rune)
+	go func() {
+		defer close(keys)
+		for {
+			r, _, err := reader.ReadRune()
+			if err != nil {
+				return
+			}
+			select {
+			case keys <- r:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	updates := make(chan update)
+	cancelRefresh := func() {}
+	defer func() {
+		cancelRefresh()
+	}()
+
+	// refresh cancels the refresh in progress and loads the data in the background
+	refresh := func(listKafkas bool) {
+		cancelRefresh()
+		var refreshCtx context.Context
+		refreshCtx, cancelRefresh = context.WithCancel(ctx)
+
+		u := s.startRefresh()
+		go func() {
+			u = opts.load(refreshCtx, u, listKafkas)
+			select {
+			case updates <- u:
+			case <-refreshCtx.Done():
+			}
+		}()
+	}
+
+	ticker := time.NewTicker(opts.interval)
+	defer ticker.Stop()
+
+	refresh(true)
+	s.render(opts, out)
+
+	for {
+		select {
+		case <-ticker.C:
+			// a slow refresh is not restarted by the ticker, so it can complete
+			if !s.loading {
+				refresh(true)
+			}
+			continue
+		case u := <-updates:
+			s.apply(u)
+		case key, ok := <-keys:
+			if !ok {
+				return nil
+			}
+			switch key {
+			case 'q', terminal.KeyEscape, terminal.KeyInterrupt, terminal.KeyEndTransmission:
+				fmt.Fprint(out, clearScreen)
+				return nil
+			case terminal.KeyArrowUp, 'k':
+				if s.selected > 0 {
+					s.selectKafka(s.selected - 1)
+					refresh(false)
+				}
+			case terminal.KeyArrowDown, 'j':
+				if s.selected < len(s.kafkas)-1 {
+					s.selectKafka(s.selected + 1)
+					refresh(false)
+				}
+			case 'r':
+				refresh(true)
+			default:
+				continue
+			}
+		}
+		s.render(opts, out)
+	}
+}
+
+// selectKafka selects the Kafka instance at the given index and clears the details of the previous one
+func (s *state) selectKafka(index int) {
+	s.selected = index
+	s.selectedID = s.kafkas[index].GetId()
+	s.topics, s.groups, s.err = nil, nil, nil
+}
+
+// startRefresh starts a new refresh of the state, discarding the results of the refreshes in progress
+func (s *state) startRefresh() update {
+	s.seq++
+	s.loading = true
+
+	return update{
+		seq:        s.seq,
+		kafkas:     s.kafkas,
+		selectedID: s.selectedID,
+	}
+}
+
+// apply updates the state with the data loaded by the latest refresh,
+// the selected Kafka instance is kept when it still exists
+func (s *state) apply(u update) {
+	if u.seq != s.seq {
+		return
+	}
+
+	s.kafkas = u.kafkas
+	s.selected = selectedIndex(u.kafkas, u.selectedID)
+	if len(s.kafkas) > 0 {
+		s.selectedID = s.kafkas[s.selected].GetId()
+	}
+	s.topics, s.groups, s.err = u.topics, u.groups, u.err
+	s.updatedAt = u.updatedAt
+	s.loading = false
+}
+
+// selectedIndex returns the index of the Kafka instance with the given ID, or the first one when it is not found
+func selectedIndex(kafkas []kafkamgmtclient.KafkaRequest, id string) int {
+	for i := range kafkas {
+		if kafkas[i].GetId() == id {
+			return i
+		}
+	}
+	return 0
+}
+
+// load reloads the Kafka instances when listKafkas is set, and the details of the selected instance
+func (opts *options) load(ctx context.Context, u update, listKafkas bool) update {
+	f := opts.f
+	u.updatedAt = time.Now()
+
+	conn, err := f.Connection()
+	if err != nil {
+		u.err = err
+		return u
+	}
+
+	if listKafkas {
+		u.kafkas, u.err = kafkautil.ListKafkas(httputil.WithoutCache(ctx), conn.API().KafkaMgmt(), "")
+		if u.err != nil {
+			return u
+		}
+	}
+
+	selected := selectedIndex(u.kafkas, u.selectedID)
+	if selected >= len(u.kafkas) || u.kafkas[selected].GetStatus() != svcstatus.StatusReady {
+		return u
+	}
+
+	api, _, err := conn.API().KafkaAdmin(u.kafkas[selected].GetId())
+	if err != nil {
+		u.err = err
+		return u
+	}
+
+	topics, err := topiccmdutil.FetchAllTopics(ctx, api)
+	if err != nil {
+		u.err = err
+		return u
+	}
+
+	groupList, httpRes, err := api.GroupsApi.GetConsumerGroups(ctx).Size(consumerGroupSize).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		u.err = err
+		return u
+	}
+
+	u.topics, u.groups = mapDetailsToRows(topics, groupList.GetItems())
+	return u
+}
+
+func mapDetailsToRows(topics []kafkainstanceclient.Topic, groups []kafkainstanceclient.ConsumerGroup) ([]topicRow, []groupRow) {
+	topicLag := map[string]int64{}
+	groupRows := make([]groupRow, 0, len(groups))
+
+	for _, group := range groups {
+		row := groupRow{
+			GroupID: group.GetGroupId(),
+			State:   string(group.GetState()),
+		}
+		metrics := group.GetMetrics()
+		row.ActiveConsumers = metrics.GetActiveConsumers()
+
+		for _, consumer := range group.GetConsumers() {
+			row.Lag += consumer.GetLag()
+			topicLag[consumer.GetTopic()] += consumer.GetLag()
+		}
+		groupRows = append(groupRows, row)
+	}
+
+	topicRows := make([]topicRow, 0, len(topics))
+	for _, topic := range topics {
+		topicRows = append(topicRows, topicRow{
+			Name:       topic.GetName(),
+			Partitions: len(topic.GetPartitions()),
+			Lag:        topicLag[topic.GetName()],
+		})
+	}
+
+	return topicRows, groupRows
+}
+
+func (s *state) render(opts *options, out io.Writer) {
+	localizer := opts.f.Localizer
+
+	var buf bytes.Buffer
+	buf.WriteString(clearScreen)
+	fmt.Fprintln(&buf, color.Bold(localizer.MustLocalize("dashboard.title")))
+	fmt.Fprintln(&buf)
+
+	if len(s.kafkas) == 0 && s.err == nil && !s.loading {
+		fmt.Fprintln(&buf, localizer.MustLocalize("kafka.common.log.info.noKafkaInstances"))
+	}
+
+	rows := make([]kafkaRow, len(s.kafkas))
+	for i := range s.kafkas {
+		k := s.kafkas[i]
+		rows[i] = kafkaRow{
+			Name:          dump.OrPlaceholder(k.GetName()),
+			Status:        dump.OrPlaceholder(k.GetStatus()),
+			CloudProvider: dump.OrPlaceholder(k.GetCloudProvider()),
+			Region:        dump.OrPlaceholder(k.GetRegion()),
+		}
+		if i == s.selected {
+			rows[i].Selected = ">"
+		}
+	}
+	if len(rows) > 0 {
+		dump.Table(&buf, rows)
+	}
+
+	if s.selected < len(s.kafkas) {
+		selected := s.kafkas[s.selected]
+		fmt.Fprintln(&buf)
+		fmt.Fprintln(&buf, color.Bold(selected.GetName()))
+
+		switch {
+		case s.err != nil:
+			fmt.Fprintln(&buf, color.Error(s.err.Error()))
+		case selected.GetStatus() != svcstatus.StatusReady:
+			fmt.Fprintln(&buf, localizer.MustLocalize("dashboard.log.info.notReady", localize.NewEntry("Status", selected.GetStatus())))
+		case s.loading && len(s.topics) == 0 && len(s.groups) == 0:
+			fmt.Fprintln(&buf, localizer.MustLocalize("dashboard.log.info.loading"))
+		default:
+			if len(s.topics) > 0 {
+				dump.Table(&buf, s.topics)
+			} else {
+				fmt.Fprintln(&buf, localizer.MustLocalize("dashboard.log.info.noTopics"))
+			}
+			fmt.Fprintln(&buf)
+			if len(s.groups) > 0 {
+				dump.Table(&buf, s.groups)
+			} else {
+				fmt.Fprintln(&buf, localizer.MustLocalize("dashboard.log.info.noConsumerGroups"))
+			}
+		}
+	} else if s.err != nil {
+		fmt.Fprintln(&buf, color.Error(s.err.Error()))
+	}
+
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, localizer.MustLocalize("dashboard.log.info.help",
+		localize.NewEntry("UpdatedAt", s.updatedAt.Format(time.Kitchen)),
+		localize.NewEntry("Interval", opts.interval),
+	))
+
+	_, _ = out.Write(buf.Bytes())
+}
@@ -0,0 +1,153 @@
+package dashboard
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+func Test_mapDetailsToRows(t *testing.T) {
+	topic := func(name string, partitions int) kafkainstanceclient.Topic {
+		p := make([]kafkainstanceclient.Partition, partitions)
+		return kafkainstanceclient.Topic{Name: &name, Partitions: &p}
+	}
+	stable := kafkainstanceclient.CONSUMERGROUPSTATE_STABLE
+	empty := kafkainstanceclient.CONSUMERGROUPSTATE_EMPTY
+	activeConsumers := int32(2)
+
+	topics := []kafkainstanceclient.Topic{topic("orders", 3), topic("payments", 1), topic("unused", 2)}
+	groups := []kafkainstanceclient.ConsumerGroup{
+		{
+			GroupId: "billing",
+			State:   &stable,
+			Metrics: &kafkainstanceclient.ConsumerGroupMetrics{ActiveConsumers: &activeConsumers},
+			Consumers: []kafkainstanceclient.Consumer{
+				*kafkainstanceclient.NewConsumer("billing", "orders", 0, 10, 5),
+				*kafkainstanceclient.NewConsumer("billing", "orders", 1, 10, 1),
+				*kafkainstanceclient.NewConsumer("billing", "payments", 0, 10, 4),
+			},
+		},
+		{
+			GroupId: "shipping",
+			State:   &empty,
+			Consumers: []kafkainstanceclient.Consumer{
+				*kafkainstanceclient.NewConsumer("shipping", "orders", 0, 10, 2),
+			},
+		},
+	}
+
+	wantTopics := []topicRow{
+		{Name: "orders", Partitions: 3, Lag: 8},
+		{Name: "payments", Partitions: 1, Lag: 4},
+		{Name: "unused", Partitions: 2, Lag: 0},
+	}
+	wantGroups := []groupRow{
+		{GroupID: "billing", State: "STABLE", ActiveConsumers: 2, Lag: 10},
+		{GroupID: "shipping", State: "EMPTY", ActiveConsumers: 0, Lag: 2},
+	}
+
+	gotTopics, gotGroups := mapDetailsToRows(topics, groups)
+	if !reflect.DeepEqual(gotTopics, wantTopics) {
+		t.Errorf("topic rows = %+v, want %+v", gotTopics, wantTopics)
+	}
+	if !reflect.DeepEqual(gotGroups, wantGroups) {
+		t.Errorf("group rows = %+v, want %+v", gotGroups, wantGroups)
+	}
+}
+
+func kafkas(ids ...string) []kafkamgmtclient.KafkaRequest {
+	kafkas := make([]kafkamgmtclient.KafkaRequest, len(ids))
+	for i, id := range ids {
+		kafkas[i] = kafkamgmtclient.KafkaRequest{Id: id}
+	}
+	return kafkas
+}
+
+func TestState_apply(t *testing.T) {
+	tests := []struct {
+		name         string
+		selectedID   string
+		kafkas       []kafkamgmtclient.KafkaRequest
+		wantSelected int
+		wantID       string
+	}{
+		{name: "selected instance is kept", selectedID: "b", kafkas: kafkas("a", "b", "c"), wantSelected: 1, wantID: "b"},
+		{name: "selected instance moved", selectedID: "b", kafkas: kafkas("b", "c"), wantSelected: 0, wantID: "b"},
+		{name: "selected instance deleted", selectedID: "b", kafkas: kafkas("a", "c"), wantSelected: 0, wantID: "a"},
+		{name: "no instances", selectedID: "b", kafkas: nil, wantSelected: 0, wantID: "b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &state{selectedID: tt.selectedID}
+
+			u := s.startRefresh()
+			if !s.loading {
+				t.Errorf("loading = false after startRefresh()")
+			}
+			if u.selectedID != tt.selectedID {
+				t.Errorf("refresh of %q, want %q", u.selectedID, tt.selectedID)
+			}
+
+			u.kafkas = tt.kafkas
+			u.topics = []topicRow{{Name: "orders"}}
+			s.apply(u)
+
+			if s.selected != tt.wantSelected || s.selectedID != tt.wantID {
+				t.Errorf("selected = %v (%q), want %v (%q)", s.selected, s.selectedID, tt.wantSelected, tt.wantID)
+			}
+			if s.loading {
+				t.Errorf("loading = true after apply()")
+			}
+			if len(s.topics) != 1 {
+				t.Errorf("topics = %v, want the topics of the refresh", s.topics)
+			}
+		})
+	}
+}
+
+func TestState_apply_staleRefresh(t *testing.T) {
+	s := &state{}
+
+	stale := s.startRefresh()
+	latest := s.startRefresh()
+
+	stale.kafkas = kafkas("stale")
+	s.apply(stale)
+	if len(s.kafkas) != 0 || !s.loading {
+		t.Errorf("the result of a stale refresh was applied: %+v", s)
+	}
+
+	latest.kafkas = kafkas("latest")
+	latest.err = errors.New("boom")
+	s.apply(latest)
+	if s.selectedID != "latest" || s.err == nil || s.loading {
+		t.Errorf("the result of the latest refresh was not applied: %+v", s)
+	}
+}
+
+func TestState_selectKafka(t *testing.T) {
+	s := &state{
+		kafkas: kafkas("a", "b"),
+		topics: []topicRow{{Name: "orders"}},
+		groups: []groupRow{{GroupID: "billing"}},
+		err:    errors.New("boom"),
+	}
+
+	s.selectKafka(1)
+
+	if s.selected != 1 || s.selectedID != "b" {
+		t.Errorf("selected = %v (%q), want 1 (%q)", s.selected, s.selectedID, "b")
+	}
+	if s.topics != nil || s.groups != nil || s.err != nil {
+		t.Errorf("the details of the previous instance were kept: %+v", s)
+	}
+
+	// the details are loaded by a refresh which keeps the Kafka instances
+	u := s.startRefresh()
+	if !reflect.DeepEqual(u.kafkas, s.kafkas) || u.selectedID != "b" {
+		t.Errorf("startRefresh() = %+v, want the current instances and selection", u)
+	}
+}
@@ -0,0 +1,25 @@
+package debug
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/debug/i18ncheck"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+// NewDebugCommand creates a new command group with tools to troubleshoot the CLI itself.
+// newRoot builds the root command, so that the commands can inspect the whole command tree.
+func NewDebugCommand(f *factory.Factory, newRoot func(*factory.Factory) *cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "debug",
+		Short:   f.Localizer.MustLocalize("debug.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("debug.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("debug.cmd.example"),
+		Args:    cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		i18ncheck.NewI18nCheckCommand(f, newRoot),
+	)
+
+	return cmd
+}
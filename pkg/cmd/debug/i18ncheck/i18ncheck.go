@@ -0,0 +1,123 @@
+package i18ncheck
+
+import (
+	"sort"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize/goi18n"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+	"golang.org/x/text/language"
+)
+
+type options struct {
+	outputFormat string
+
+	f       *factory.Factory
+	newRoot func(*factory.Factory) *cobra.Command
+}
+
+// messageProblem is a message which could not be localized
+type messageProblem struct {
+	ID    string `json:"id" yaml:"id" header:"Message ID"`
+	Error string `json:"error" yaml:"error" header:"Error"`
+}
+
+// NewI18nCheckCommand creates a new command to check that the messages of all commands can be localized
+func NewI18nCheckCommand(f *factory.Factory, newRoot func(*factory.Factory) *cobra.Command) *cobra.Command {
+	opts := &options{
+		f:       f,
+		newRoot: newRoot,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "i18n-check",
+		Short:   f.Localizer.MustLocalize("debug.i18nCheck.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("debug.i18nCheck.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("debug.i18nCheck.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			lang, err := localize.GetLanguage(cmd.Flag(flagutil.LocaleFlagName).Value.String())
+			if err != nil {
+				return err
+			}
+
+			return runCheck(opts, lang)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, f.Localizer)
+	flags.AddOutput(&opts.outputFormat)
+
+	return cmd
+}
+
+func runCheck(opts *options, lang *language.Tag) error {
+	f := opts.f
+
+	problems, commandCount, err := checkCommands(opts, lang)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case opts.outputFormat != dump.EmptyFormat:
+		if err = dump.Formatted(f.IOStreams.Out, opts.outputFormat, problems); err != nil {
+			return err
+		}
+	case len(problems) == 0:
+		f.Logger.Info(icon.SuccessPrefix(), f.Localizer.MustLocalize("debug.i18nCheck.log.info.noProblems",
+			localize.NewEntry("Count", commandCount),
+			localize.NewEntry("Language", lang),
+		))
+	default:
+		dump.Table(f.IOStreams.Out, problems)
+		f.Logger.Info("")
+	}
+
+	if len(problems) > 0 {
+		return f.Localizer.MustLocalizeError("debug.i18nCheck.error.problems",
+			localize.NewEntry("Count", len(problems)),
+			localize.NewEntry("Language", lang),
+		)
+	}
+
+	return nil
+}
+
+// checkCommands builds the whole command tree with a localizer which records the messages
+// that could not be localized, and returns them with the number of commands in the tree
+func checkCommands(opts *options, lang *language.Tag) ([]messageProblem, int, error) {
+	problems := []messageProblem{}
+	localizer, err := goi18n.New(goi18n.NewConfigWithLanguage(lang).WithMissingMessageHandler(func(id string, err error) {
+		problems = append(problems, messageProblem{ID: id, Error: err.Error()})
+	}))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	checkFactory := *opts.f
+	checkFactory.Localizer = localizer
+	root := opts.newRoot(&checkFactory)
+
+	sort.Slice(problems, func(i, j int) bool {
+		return problems[i].ID < problems[j].ID
+	})
+
+	return problems, countCommands(root), nil
+}
+
+func countCommands(cmd *cobra.Command) int {
+	count := 1
+	for _, c := range cmd.Commands() {
+		count += countCommands(c)
+	}
+	return count
+}
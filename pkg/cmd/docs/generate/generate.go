@@ -0,0 +1,119 @@
+package generate
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	rhoasdoc "github.com/redhat-developer/app-services-cli/internal/doc"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/cobra/doc"
+)
+
+const (
+	markdown = "markdown"
+	asciidoc = "asciidoc"
+	man      = "man"
+)
+
+var validFormats = []string{markdown, man, asciidoc}
+
+type options struct {
+	dir        string
+	format     string
+	filePrefix string
+	logger     logging.Logger
+	localizer  localize.Localizer
+}
+
+// NewGenerateCommand creates a command which generates the reference documentation of the CLI
+func NewGenerateCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		logger:    f.Logger,
+		localizer: f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:         "generate",
+		Short:       f.Localizer.MustLocalize("docs.generate.cmd.shortDescription"),
+		Long:        f.Localizer.MustLocalize("docs.generate.cmd.longDescription"),
+		Example:     f.Localizer.MustLocalize("docs.generate.cmd.example"),
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{cmdutil.OfflineAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if !flagutil.IsValidInput(opts.format, validFormats...) {
+				return flagutil.InvalidValueError("format", opts.format, validFormats...)
+			}
+
+			return runGenerate(cmd, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.format, "format", markdown, f.Localizer.MustLocalize("docs.generate.flag.format.description"))
+	cmd.Flags().StringVar(&opts.dir, "output", "./docs", f.Localizer.MustLocalize("docs.generate.flag.output.description"))
+	cmd.Flags().StringVar(&opts.filePrefix, "file-prefix", "ref-cli-", f.Localizer.MustLocalize("docs.generate.flag.filePrefix.description"))
+
+	flagutil.EnableStaticFlagCompletion(cmd, "format", validFormats)
+
+	return cmd
+}
+
+func runGenerate(cmd *cobra.Command, opts *options) (err error) {
+	cmd.Root().DisableAutoGenTag = true
+
+	if err = os.MkdirAll(opts.dir, 0o755); err != nil {
+		return err
+	}
+
+	switch opts.format {
+	case markdown:
+		err = doc.GenMarkdownTree(cmd.Root(), opts.dir)
+	case man:
+		header := &doc.GenManHeader{
+			Title:   "RHOAS",
+			Section: "1",
+			Manual:  opts.localizer.MustLocalize("docs.generate.man.manual"),
+			Source:  fmt.Sprintf("Copyright (c) %v Red Hat, Inc.", time.Now().Year()),
+		}
+		err = doc.GenManTree(cmd.Root(), header, opts.dir)
+	case asciidoc:
+		docsDir := path.Clean(opts.dir + "/modules")
+		assembliesDir := path.Clean(opts.dir + "/assemblies")
+		err = os.MkdirAll(docsDir, 0o755)
+		if err != nil {
+			return err
+		}
+		err = os.MkdirAll(assembliesDir, 0o755)
+		if err != nil {
+			return err
+		}
+
+		options := rhoasdoc.GeneratorOptions{
+			Dir:           docsDir,
+			GenerateIndex: true,
+			IndexFile:     assembliesDir + "/assembly-cli-command-reference.adoc"}
+
+		options.FileNameGenerator = func(c *cobra.Command) string {
+			basename := opts.filePrefix + rhoasdoc.GetNormalizedCommandPath(c) + ".adoc"
+			return filepath.Join(options.Dir, basename)
+		}
+
+		err = rhoasdoc.GenAsciidocTree(cmd.Root(), &options)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	opts.logger.Info(opts.localizer.MustLocalize("docs.generate.log.info.success", localize.NewEntry("Dir", opts.dir)))
+
+	return nil
+}
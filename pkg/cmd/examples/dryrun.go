@@ -0,0 +1,104 @@
+package examples
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kballard/go-shellquote"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// shellOperators end the rhoas part of an example command line
+var shellOperators = []string{"|", "||", "&&", ";", "&"}
+
+// dryRun resolves the example against the command tree and parses its arguments
+// and flags in the same way as when it is executed, without running the command.
+// Examples which do not run the CLI, such as showing the content of a file, are skipped.
+func dryRun(root *cobra.Command, example string) (skipped bool, err error) {
+	args, err := exampleArgs(example, root.Name())
+	if err != nil {
+		return false, err
+	}
+	if args == nil {
+		return true, nil
+	}
+
+	return false, validate(root, args[1:])
+}
+
+func validate(root *cobra.Command, args []string) error {
+	cmd, flags, err := root.Find(args)
+	if err != nil {
+		return err
+	}
+	defer resetFlags(cmd)
+
+	if err = cmd.ParseFlags(flags); err != nil {
+		return err
+	}
+	if !cmd.Runnable() {
+		if help, _ := cmd.Flags().GetBool("help"); help {
+			return nil
+		}
+		return fmt.Errorf("unknown command %q for %q", strings.Join(cmd.Flags().Args(), " "), cmd.CommandPath())
+	}
+	if err = cmd.ValidateArgs(cmd.Flags().Args()); err != nil {
+		return err
+	}
+	return cmd.ValidateRequiredFlags()
+}
+
+// exampleArgs returns the arguments of the first command of the example which runs the named executable,
+// up to the next shell operator or redirection. It returns nil when the example does not run the executable.
+func exampleArgs(example string, name string) ([]string, error) {
+	words, err := shellquote.Split(example)
+	if err != nil {
+		return nil, err
+	}
+
+	var segment []string
+	for _, word := range append(words, "|") {
+		if !isShellOperator(word) && !isRedirection(word) {
+			segment = append(segment, word)
+			continue
+		}
+		if len(segment) > 0 && segment[0] == name {
+			return segment, nil
+		}
+		segment = nil
+	}
+	return nil, nil
+}
+
+func isShellOperator(word string) bool {
+	for _, op := range shellOperators {
+		if word == op {
+			return true
+		}
+	}
+	return false
+}
+
+// isRedirection returns true for shell redirections, placeholders such as "<id>" are arguments
+func isRedirection(word string) bool {
+	if strings.HasPrefix(word, "<") {
+		return !strings.HasSuffix(word, ">")
+	}
+	return strings.HasPrefix(word, ">") || strings.HasPrefix(word, "2>")
+}
+
+// resetFlags restores the default value of the flags set by an example, so they do not leak into the next one
+func resetFlags(cmd *cobra.Command) {
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		if !flag.Changed {
+			return
+		}
+		if value, ok := flag.Value.(pflag.SliceValue); ok {
+			_ = value.Replace(nil)
+		} else {
+			_ = flag.Value.Set(flag.DefValue)
+		}
+		flag.Changed = false
+	})
+}
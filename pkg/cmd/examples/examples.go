@@ -0,0 +1,142 @@
+package examples
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/color"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	IO        *iostreams.IOStreams
+	localizer localize.Localizer
+
+	commandPath  []string
+	scenario     string
+	dryRun       bool
+	outputFormat string
+}
+
+// commandExamples are the examples of a single command
+type commandExamples struct {
+	Command  string            `json:"command"`
+	Examples []cmdutil.Example `json:"examples"`
+}
+
+// NewExamplesCommand creates a command which prints the runnable examples of a command and its subcommands
+func NewExamplesCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		IO:        f.IOStreams,
+		localizer: f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:         "examples [command]",
+		Short:       f.Localizer.MustLocalize("examples.cmd.shortDescription"),
+		Long:        f.Localizer.MustLocalize("examples.cmd.longDescription"),
+		Example:     f.Localizer.MustLocalize("examples.cmd.example"),
+		Annotations: map[string]string{cmdutil.OfflineAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			opts.commandPath = args
+			return runExamples(cmd.Root(), opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, f.Localizer)
+	flags.AddOutput(&opts.outputFormat)
+	flags.StringVar(&opts.scenario, "scenario", "", f.Localizer.MustLocalize("examples.flag.scenario.description"))
+	flags.BoolVar(&opts.dryRun, "dry-run", false, f.Localizer.MustLocalize("examples.flag.dryRun.description"))
+
+	return cmd
+}
+
+func runExamples(root *cobra.Command, opts *options) error {
+	target, rest, err := root.Find(opts.commandPath)
+	if err != nil || len(rest) > 0 {
+		return opts.localizer.MustLocalizeError("examples.error.unknownCommand", localize.NewEntry("Command", strings.Join(opts.commandPath, " ")))
+	}
+
+	var found []commandExamples
+	collectExamples(target, opts.scenario, &found)
+
+	if len(found) == 0 {
+		return opts.localizer.MustLocalizeError("examples.error.noExamples", localize.NewEntry("Command", target.CommandPath()))
+	}
+
+	if opts.dryRun {
+		return runDryRun(root, opts, found)
+	}
+
+	if opts.outputFormat != "" {
+		return dump.Formatted(opts.IO.Out, opts.outputFormat, found)
+	}
+
+	for _, c := range found {
+		fmt.Fprintln(opts.IO.Out, color.Bold(c.Command))
+		for _, example := range c.Examples {
+			fmt.Fprintln(opts.IO.Out)
+			if example.Description != "" {
+				fmt.Fprintf(opts.IO.Out, "  # %v [%v]\n", example.Description, example.Scenario)
+			} else {
+				fmt.Fprintf(opts.IO.Out, "  # [%v]\n", example.Scenario)
+			}
+			fmt.Fprintf(opts.IO.Out, "  $ %v\n", example.Command)
+		}
+		fmt.Fprintln(opts.IO.Out)
+	}
+
+	return nil
+}
+
+// collectExamples adds the examples of the command and its available subcommands, filtered by scenario
+func collectExamples(cmd *cobra.Command, scenario string, found *[]commandExamples) {
+	var examples []cmdutil.Example
+	for _, example := range cmdutil.Examples(cmd) {
+		if scenario == "" || example.Scenario == scenario {
+			examples = append(examples, example)
+		}
+	}
+	if len(examples) > 0 {
+		*found = append(*found, commandExamples{Command: cmd.CommandPath(), Examples: examples})
+	}
+
+	for _, sub := range cmd.Commands() {
+		if sub.IsAvailableCommand() {
+			collectExamples(sub, scenario, found)
+		}
+	}
+}
+
+// runDryRun checks that every example resolves to a command and is accepted by its argument and flag validation
+func runDryRun(root *cobra.Command, opts *options, found []commandExamples) error {
+	var failed int
+	for _, c := range found {
+		for _, example := range c.Examples {
+			skipped, err := dryRun(root, example.Command)
+			switch {
+			case err != nil:
+				failed++
+				fmt.Fprintf(opts.IO.Out, "%v %v: %v\n", icon.ErrorPrefix(), example.Command, err)
+			case !skipped:
+				fmt.Fprintf(opts.IO.Out, "%v %v\n", icon.SuccessPrefix(), example.Command)
+			}
+		}
+	}
+
+	if failed > 0 {
+		return opts.localizer.MustLocalizeError("examples.error.dryRunFailed", localize.NewEntry("Count", failed))
+	}
+	return nil
+}
@@ -2,17 +2,21 @@ package generate
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/kafkautil"
 )
 
 type configValues struct {
-	KafkaHost   string
-	RegistryURL string
+	KafkaHost           string
+	KafkaSASLMechanisms string
+	KafkaTokenURL       string
+	RegistryURL         string
 
 	// Optional
 	Name string
@@ -41,8 +45,17 @@ func BuildConfiguration(svcConfig *servicecontext.ServiceConfig, opts *options)
 			return newErr
 		}
 
+		conn, newErr := opts.Connection()
+		if newErr != nil {
+			return newErr
+		}
+
+		credentialsHint := kafkautil.NewCredentialsHint(conn.API().GetConfig().AuthURL)
+
 		serviceAvailable = true
 		configurations.KafkaHost = kafkaInstance.GetBootstrapServerHost()
+		configurations.KafkaSASLMechanisms = strings.Join(credentialsHint.SASLMechanisms, ",")
+		configurations.KafkaTokenURL = credentialsHint.OAuthTokenURL
 	}
 
 	if svcConfig.ServiceRegistryID != "" {
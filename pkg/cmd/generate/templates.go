@@ -10,6 +10,8 @@ var (
 		## Generated by rhoas cli
 		{{if .KafkaHost}}## Kafka Configuration
 		KAFKA_HOST={{.KafkaHost}}
+		KAFKA_SASL_MECHANISMS={{.KafkaSASLMechanisms}}
+		KAFKA_OAUTH_TOKEN_URL={{.KafkaTokenURL}}
 		{{end}}{{if .RegistryURL}}## Service Registry Configuration
 		SERVICE_REGISTRY_URL={{.RegistryURL}}
 		SERVICE_REGISTRY_CORE_PATH=` + registrycmdutil.REGISTRY_CORE_PATH + `
@@ -20,6 +22,8 @@ var (
 	templateJSON = heredoc.Doc(`
 		{
 			{{if .KafkaHost}}"kafkaHost":"{{.KafkaHost}}",
+			"kafkaSaslMechanisms":"{{.KafkaSASLMechanisms}}",
+			"kafkaOAuthTokenUrl":"{{.KafkaTokenURL}}"{{if .RegistryURL}},{{end}}
 			{{end}}{{if .RegistryURL}}"serviceRegistryUrl":"{{.RegistryURL}}",
 			"serviceRegistryCorePath":"` + registrycmdutil.REGISTRY_CORE_PATH + `",
 			"serviceRegistryCompatPath":"` + registrycmdutil.REGISTRY_COMPAT_PATH + `"{{end}}
@@ -30,6 +34,8 @@ var (
 		## Generated by rhoas cli
 		{{if .KafkaHost}}## Kafka Configuration
 		kafkaHost={{.KafkaHost}} 
+		kafkaSaslMechanisms={{.KafkaSASLMechanisms}}
+		kafkaOAuthTokenUrl={{.KafkaTokenURL}}
 		{{end}}{{if .RegistryURL}}## Service Registry Configuration
 		serviceRegistryUrl={{.RegistryURL}}
 		serviceRegistryCorePath=` + registrycmdutil.REGISTRY_CORE_PATH + `
@@ -44,6 +50,8 @@ var (
 		data:
 		  {{if .KafkaHost}}## Kafka Configuration
 		  kafka_host: {{.KafkaHost}}
+		  kafka_sasl_mechanisms: {{.KafkaSASLMechanisms}}
+		  kafka_oauth_token_url: {{.KafkaTokenURL}}
 		  {{end}}
 		  {{if .RegistryURL}}## Service Registry Configuration
 		  service_registry_url: {{.RegistryURL}}
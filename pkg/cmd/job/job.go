@@ -0,0 +1,28 @@
+package job
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/job/list"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/job/logs"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/job/wait"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+// NewJobCommand creates a new command group for monitoring background jobs
+func NewJobCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "job",
+		Short:   f.Localizer.MustLocalize("job.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("job.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("job.cmd.example"),
+		Args:    cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		list.NewListCommand(f),
+		logs.NewLogsCommand(f),
+		wait.NewWaitCommand(f),
+	)
+
+	return cmd
+}
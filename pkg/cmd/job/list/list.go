@@ -0,0 +1,90 @@
+package list
+
+import (
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/jobs"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	outputFormat string
+
+	f *factory.Factory
+}
+
+type jobRow struct {
+	ID        string `json:"id" header:"ID"`
+	Status    string `json:"status" header:"Status"`
+	StartedAt string `json:"startedAt" header:"Started"`
+	Command   string `json:"command" header:"Command"`
+}
+
+// NewListCommand creates a new command for listing background jobs
+func NewListCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:         "list",
+		Short:       f.Localizer.MustLocalize("job.list.cmd.shortDescription"),
+		Long:        f.Localizer.MustLocalize("job.list.cmd.longDescription"),
+		Example:     f.Localizer.MustLocalize("job.list.cmd.example"),
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{cmdutil.OfflineAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			return runList(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, f.Localizer)
+	flags.AddOutput(&opts.outputFormat)
+	flags.AddPrintSchema([]jobs.Job{})
+
+	return cmd
+}
+
+func runList(opts *options) error {
+	f := opts.f
+
+	store, err := jobs.DefaultStore(f.Config)
+	if err != nil {
+		return err
+	}
+
+	jobList, err := store.List()
+	if err != nil {
+		return err
+	}
+
+	if len(jobList) == 0 && opts.outputFormat == "" {
+		f.Logger.Info(f.Localizer.MustLocalize("job.list.log.info.noJobs"))
+		return nil
+	}
+
+	if opts.outputFormat != "" {
+		return dump.Formatted(f.IOStreams.Out, opts.outputFormat, jobList)
+	}
+
+	rows := make([]jobRow, len(jobList))
+	for i, job := range jobList {
+		rows[i] = jobRow{
+			ID:        job.ID,
+			Status:    string(job.Status),
+			StartedAt: job.StartedAt.Format(time.RFC3339),
+			Command:   job.Command,
+		}
+	}
+	dump.Table(f.IOStreams.Out, rows)
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package logs
+
+import (
+	"errors"
+	"io"
+	"os"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/jobs"
+	"github.com/spf13/cobra"
+)
+
+// followInterval is the time to wait between two reads of the log of a running job
+const followInterval = time.Second
+
+type options struct {
+	id     string
+	follow bool
+
+	f *factory.Factory
+}
+
+// NewLogsCommand creates a new command for printing the output of a background job
+func NewLogsCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:         "logs",
+		Short:       f.Localizer.MustLocalize("job.logs.cmd.shortDescription"),
+		Long:        f.Localizer.MustLocalize("job.logs.cmd.longDescription"),
+		Example:     f.Localizer.MustLocalize("job.logs.cmd.example"),
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{cmdutil.OfflineAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLogs(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, f.Localizer)
+	flags.StringVar(&opts.id, "id", "", f.Localizer.MustLocalize("job.common.flag.id.description"))
+	flags.BoolVarP(&opts.follow, "follow", "f", false, f.Localizer.MustLocalize("job.logs.flag.follow.description"))
+	_ = cmd.MarkFlagRequired("id")
+
+	return cmd
+}
+
+func runLogs(opts *options) error {
+	f := opts.f
+
+	store, err := jobs.DefaultStore(f.Config)
+	if err != nil {
+		return err
+	}
+
+	job, err := store.Get(opts.id)
+	if errors.Is(err, jobs.ErrNotFound) {
+		return f.Localizer.MustLocalizeError("job.common.error.notFound", localize.NewEntry("ID", opts.id))
+	}
+	if err != nil {
+		return err
+	}
+
+	logFile, err := os.Open(store.LogPath(job.ID))
+	if err != nil {
+		return err
+	}
+	defer logFile.Close()
+
+	for {
+		if _, err = io.Copy(f.IOStreams.Out, logFile); err != nil {
+			return err
+		}
+		if !opts.follow || job.Status != jobs.StatusRunning {
+			return nil
+		}
+
+		select {
+		case <-f.Context.Done():
+			return nil
+		case <-time.After(followInterval):
+		}
+
+		if job, err = store.Get(job.ID); err != nil {
+			return err
+		}
+	}
+}
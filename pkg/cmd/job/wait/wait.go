@@ -0,0 +1,96 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/jobs"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/waitutil"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultTimeout = time.Hour
+	pollInterval   = 2 * time.Second
+)
+
+type options struct {
+	id      string
+	timeout time.Duration
+
+	f *factory.Factory
+}
+
+// NewWaitCommand creates a new command which waits until a background job has finished
+func NewWaitCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:         "wait",
+		Short:       f.Localizer.MustLocalize("job.wait.cmd.shortDescription"),
+		Long:        f.Localizer.MustLocalize("job.wait.cmd.longDescription"),
+		Example:     f.Localizer.MustLocalize("job.wait.cmd.example"),
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{cmdutil.OfflineAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.timeout <= 0 {
+				return f.Localizer.MustLocalizeError("waitFor.error.invalidTimeout", localize.NewEntry("Timeout", opts.timeout))
+			}
+
+			return runWait(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, f.Localizer)
+	flags.StringVar(&opts.id, "id", "", f.Localizer.MustLocalize("job.common.flag.id.description"))
+	flags.DurationVar(&opts.timeout, "timeout", defaultTimeout, f.Localizer.MustLocalize("waitFor.flag.timeout.description"))
+	_ = cmd.MarkFlagRequired("id")
+
+	return cmd
+}
+
+func runWait(opts *options) error {
+	f := opts.f
+
+	store, err := jobs.DefaultStore(f.Config)
+	if err != nil {
+		return err
+	}
+
+	if _, err = store.Get(opts.id); errors.Is(err, jobs.ErrNotFound) {
+		return f.Localizer.MustLocalizeError("job.common.error.notFound", localize.NewEntry("ID", opts.id))
+	} else if err != nil {
+		return err
+	}
+
+	fetch := func(ctx context.Context) (interface{}, error) {
+		return store.Get(opts.id)
+	}
+
+	abort := func(resource interface{}) error {
+		job := resource.(*jobs.Job)
+		if job.Status == jobs.StatusFailed {
+			return f.Localizer.MustLocalizeError("job.wait.error.failed",
+				localize.NewEntry("ID", job.ID),
+				localize.NewEntry("Error", job.Error),
+			)
+		}
+		return nil
+	}
+
+	condition := &waitutil.Condition{Field: "status", Value: string(jobs.StatusSucceeded)}
+	_, err = waitutil.UntilWithSpinner(f, opts.id, fetch, condition, waitutil.Options{
+		Interval: pollInterval,
+		Timeout:  opts.timeout,
+		Abort:    abort,
+	})
+
+	return err
+}
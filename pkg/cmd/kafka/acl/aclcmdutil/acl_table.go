@@ -2,8 +2,10 @@ package aclcmdutil
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 
 	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
@@ -16,30 +18,35 @@ type permissionsRow struct {
 	Description string `json:"description,omitempty" header:"description"`
 }
 
+// PrincipalPermissions are the ACL bindings of a single principal grouped by resource
+type PrincipalPermissions struct {
+	Principal string                `json:"principal" yaml:"principal"`
+	Resources []ResourcePermissions `json:"resources" yaml:"resources"`
+}
+
+// ResourcePermissions are the operations allowed and denied to a principal on a resource
+type ResourcePermissions struct {
+	ResourceType string   `json:"resource_type" yaml:"resource_type"`
+	PatternType  string   `json:"pattern_type" yaml:"pattern_type"`
+	ResourceName string   `json:"resource_name" yaml:"resource_name"`
+	Allow        []string `json:"allow" yaml:"allow"`
+	Deny         []string `json:"deny" yaml:"deny"`
+}
+
+type principalRow struct {
+	Principal string `header:"Principal"`
+	Resource  string `header:"Resource"`
+	Allow     string `header:"Allow"`
+	Deny      string `header:"Deny"`
+}
+
 // MapACLsToTableRows converts a list of ACL bindings into a formatted table for printing
 func MapACLsToTableRows(bindings []kafkainstanceclient.AclBinding, localizer localize.Localizer) []permissionsRow {
 	rows := make([]permissionsRow, len(bindings))
 
-	// get the SDK => CLI key mappings
-	permissionMap := GetPermissionTypeMap()
-	reversedPermissionMap := make(map[kafkainstanceclient.AclPermissionType]string)
-	for k, v := range permissionMap {
-		reversedPermissionMap[v] = k
-	}
-
-	// get the SDK => CLI key mappings
-	operationMap := GetOperationMap()
-	reversedOperationMap := make(map[kafkainstanceclient.AclOperation]string)
-	for k, v := range operationMap {
-		reversedOperationMap[v] = k
-	}
-
-	// get the SDK => CLI key mappings
-	resourceTypeMap := GetResourceTypeMap()
-	reversedResourceTypeMap := make(map[kafkainstanceclient.AclResourceType]string)
-	for k, v := range resourceTypeMap {
-		reversedResourceTypeMap[v] = k
-	}
+	reversedPermissionMap := reversePermissionTypeMap()
+	reversedOperationMap := reverseOperationMap()
+	reversedResourceTypeMap := reverseResourceTypeMap()
 
 	for i, p := range bindings {
 		description := formatTablePatternType(p.PatternType, localizer)
@@ -71,3 +78,121 @@ func formatTablePrincipal(principal string, localizer localize.Localizer) string
 
 	return s
 }
+
+// GroupACLsByPrincipal collapses a list of ACL bindings into the permissions of each principal.
+// Principals and resources keep the order of the bindings, operations are sorted by name.
+func GroupACLsByPrincipal(bindings []kafkainstanceclient.AclBinding) []PrincipalPermissions {
+	permissionMap := reversePermissionTypeMap()
+	operationMap := reverseOperationMap()
+	resourceTypeMap := reverseResourceTypeMap()
+	patternTypeMap := reversePatternTypeMap()
+
+	groups := []PrincipalPermissions{}
+	principalIndex := map[string]int{}
+	resourceIndex := map[string]int{}
+
+	for _, b := range bindings {
+		principal := b.GetPrincipal()
+		i, ok := principalIndex[principal]
+		if !ok {
+			i = len(groups)
+			principalIndex[principal] = i
+			groups = append(groups, PrincipalPermissions{
+				Principal: principal,
+				Resources: []ResourcePermissions{},
+			})
+		}
+
+		resourceKey := fmt.Sprintf("%v|%v|%v|%v", principal, b.GetResourceType(), b.GetPatternType(), b.GetResourceName())
+		j, ok := resourceIndex[resourceKey]
+		if !ok {
+			j = len(groups[i].Resources)
+			resourceIndex[resourceKey] = j
+			groups[i].Resources = append(groups[i].Resources, ResourcePermissions{
+				ResourceType: resourceTypeMap[b.GetResourceType()],
+				PatternType:  patternTypeMap[b.GetPatternType()],
+				ResourceName: b.GetResourceName(),
+				Allow:        []string{},
+				Deny:         []string{},
+			})
+		}
+
+		resource := &groups[i].Resources[j]
+		operation := operationMap[b.GetOperation()]
+		if permissionMap[b.GetPermission()] == PermissionDENY {
+			resource.Deny = append(resource.Deny, operation)
+		} else {
+			resource.Allow = append(resource.Allow, operation)
+		}
+	}
+
+	for i := range groups {
+		for j := range groups[i].Resources {
+			sort.Strings(groups[i].Resources[j].Allow)
+			sort.Strings(groups[i].Resources[j].Deny)
+		}
+	}
+
+	return groups
+}
+
+// MapPrincipalPermissionsToTableRows converts the grouped permissions into a permission matrix for printing
+func MapPrincipalPermissionsToTableRows(groups []PrincipalPermissions, localizer localize.Localizer) []principalRow {
+	rows := []principalRow{}
+
+	for _, group := range groups {
+		principal := formatTablePrincipal(group.Principal, localizer)
+		for _, resource := range group.Resources {
+			description := formatTablePatternType(GetMappedPatternTypeValue(resource.PatternType), localizer)
+			rows = append(rows, principalRow{
+				Principal: principal,
+				Resource:  fmt.Sprintf("%s %s \"%s\"", resource.ResourceType, description, resource.ResourceName),
+				Allow:     formatOperations(resource.Allow),
+				Deny:      formatOperations(resource.Deny),
+			})
+		}
+	}
+
+	return rows
+}
+
+func formatOperations(operations []string) string {
+	if len(operations) == 0 {
+		return dump.Placeholder
+	}
+	return strings.Join(operations, ", ")
+}
+
+// the following functions get the SDK => CLI key mappings
+
+func reversePermissionTypeMap() map[kafkainstanceclient.AclPermissionType]string {
+	reversed := make(map[kafkainstanceclient.AclPermissionType]string)
+	for k, v := range GetPermissionTypeMap() {
+		reversed[v] = k
+	}
+	return reversed
+}
+
+func reverseOperationMap() map[kafkainstanceclient.AclOperation]string {
+	reversed := make(map[kafkainstanceclient.AclOperation]string)
+	for k, v := range GetOperationMap() {
+		reversed[v] = k
+	}
+	return reversed
+}
+
+func reverseResourceTypeMap() map[kafkainstanceclient.AclResourceType]string {
+	reversed := make(map[kafkainstanceclient.AclResourceType]string)
+	for k, v := range GetResourceTypeMap() {
+		reversed[v] = k
+	}
+	return reversed
+}
+
+func reversePatternTypeMap() map[kafkainstanceclient.AclPatternType]string {
+	reversed := make(map[kafkainstanceclient.AclPatternType]string)
+	for k, v := range GetPatternTypeMap() {
+		reversed[v] = k
+	}
+	return reversed
+}
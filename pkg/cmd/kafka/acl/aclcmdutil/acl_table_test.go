@@ -0,0 +1,42 @@
+package aclcmdutil
+
+import (
+	"reflect"
+	"testing"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
+)
+
+func TestGroupACLsByPrincipal(t *testing.T) {
+	binding := func(principal string, resourceType kafkainstanceclient.AclResourceType, name string, operation kafkainstanceclient.AclOperation, permission kafkainstanceclient.AclPermissionType) kafkainstanceclient.AclBinding {
+		return *kafkainstanceclient.NewAclBinding(resourceType, name, kafkainstanceclient.ACLPATTERNTYPE_LITERAL, principal, operation, permission)
+	}
+
+	bindings := []kafkainstanceclient.AclBinding{
+		binding("User:alice", kafkainstanceclient.ACLRESOURCETYPE_TOPIC, "orders", kafkainstanceclient.ACLOPERATION_WRITE, kafkainstanceclient.ACLPERMISSIONTYPE_ALLOW),
+		binding("User:alice", kafkainstanceclient.ACLRESOURCETYPE_TOPIC, "orders", kafkainstanceclient.ACLOPERATION_READ, kafkainstanceclient.ACLPERMISSIONTYPE_ALLOW),
+		binding("User:alice", kafkainstanceclient.ACLRESOURCETYPE_TOPIC, "orders", kafkainstanceclient.ACLOPERATION_DELETE, kafkainstanceclient.ACLPERMISSIONTYPE_DENY),
+		binding("User:bob", kafkainstanceclient.ACLRESOURCETYPE_GROUP, "*", kafkainstanceclient.ACLOPERATION_READ, kafkainstanceclient.ACLPERMISSIONTYPE_ALLOW),
+		binding("User:alice", kafkainstanceclient.ACLRESOURCETYPE_CLUSTER, "kafka-cluster", kafkainstanceclient.ACLOPERATION_DESCRIBE, kafkainstanceclient.ACLPERMISSIONTYPE_ALLOW),
+	}
+
+	want := []PrincipalPermissions{
+		{
+			Principal: "User:alice",
+			Resources: []ResourcePermissions{
+				{ResourceType: ResourceTypeTOPIC, PatternType: PatternTypeLITERAL, ResourceName: "orders", Allow: []string{OperationREAD, OperationWRITE}, Deny: []string{OperationDELETE}},
+				{ResourceType: ResourceTypeCLUSTER, PatternType: PatternTypeLITERAL, ResourceName: "kafka-cluster", Allow: []string{OperationDESCRIBE}, Deny: []string{}},
+			},
+		},
+		{
+			Principal: "User:bob",
+			Resources: []ResourcePermissions{
+				{ResourceType: ResourceTypeGROUP, PatternType: PatternTypeLITERAL, ResourceName: "*", Allow: []string{OperationREAD}, Deny: []string{}},
+			},
+		},
+	}
+
+	if got := GroupACLsByPrincipal(bindings); !reflect.DeepEqual(got, want) {
+		t.Errorf("GroupACLsByPrincipal() = %+v, want %+v", got, want)
+	}
+}
@@ -172,3 +172,26 @@ func RegisterGroupCompletionFunc(cmd *cobra.Command, f *factory.Factory) error {
 		return kafkacmdutil.FilterValidConsumerGroupIDs(f, toComplete)
 	})
 }
+
+// FetchAllACLs fetches every page of ACL bindings matching the request.
+// The response of the last request is returned so that errors can be validated with ValidateAPIError.
+func FetchAllACLs(req kafkainstanceclient.ApiGetAclsRequest, pageSize int32) ([]kafkainstanceclient.AclBinding, *http.Response, error) {
+	var bindings []kafkainstanceclient.AclBinding
+
+	for page := int32(1); ; page++ {
+		permissionsData, httpRes, err := req.Page(page).Size(pageSize).Execute()
+		if httpRes != nil {
+			httpRes.Body.Close()
+		}
+		if err != nil {
+			return nil, httpRes, err
+		}
+
+		items := permissionsData.GetItems()
+		bindings = append(bindings, items...)
+
+		if len(items) == 0 || int32(len(bindings)) >= permissionsData.GetTotal() {
+			return bindings, httpRes, nil
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package create
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/aclcmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
+)
+
+// stdinFile is the value of the --file flag which reads the bindings from standard input
+const stdinFile = "-"
+
+// bulkOptions are the options of the bulk mode, where the ACL bindings are read from a file
+type bulkOptions struct {
+	file         string
+	concurrency  int
+	outputFormat string
+}
+
+// bindingLine is an ACL binding and the line of the file it was read from
+type bindingLine struct {
+	line    int
+	binding kafkainstanceclient.AclBinding
+}
+
+// bulkResult is the outcome of creating one ACL binding of the file
+type bulkResult struct {
+	Line         int    `json:"line" yaml:"line"`
+	ResourceType string `json:"resourceType" yaml:"resourceType"`
+	ResourceName string `json:"resourceName" yaml:"resourceName"`
+	PatternType  string `json:"patternType" yaml:"patternType"`
+	Principal    string `json:"principal" yaml:"principal"`
+	Operation    string `json:"operation" yaml:"operation"`
+	Permission   string `json:"permission" yaml:"permission"`
+	Created      bool   `json:"created" yaml:"created"`
+	Error        string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// runBulkCreate creates every ACL binding of the newline-delimited JSON file
+func runBulkCreate(instanceID string, opts *aclcmdutil.CrudOptions, bulk *bulkOptions) error {
+	bindings, err := readBindingsFile(bulk.file, opts.IO.In)
+	if err != nil {
+		return opts.Localizer.MustLocalizeError("kafka.acl.create.error.invalidFile", localize.NewEntry("File", bulk.file), localize.NewEntry("Error", err))
+	}
+	if len(bindings) == 0 {
+		return opts.Localizer.MustLocalizeError("kafka.acl.create.error.emptyFile", localize.NewEntry("File", bulk.file))
+	}
+
+	conn, err := opts.Connection()
+	if err != nil {
+		return err
+	}
+
+	adminAPI, kafkaInstance, err := conn.API().KafkaAdmin(instanceID)
+	if err != nil {
+		return err
+	}
+
+	kafkaName := kafkaInstance.GetName()
+
+	opts.Logger.Info(opts.Localizer.MustLocalize("kafka.acl.create.log.info.creatingBulk",
+		localize.NewEntry("Count", len(bindings)),
+		localize.NewEntry("Name", kafkaName),
+	))
+
+	results := createAll(bindings, bulk.concurrency, func(binding kafkainstanceclient.AclBinding) error {
+		req := adminAPI.AclsApi.CreateAcl(opts.Context).AclBinding(binding)
+		return aclcmdutil.ExecuteACLRuleCreate(req, opts.Localizer, kafkaName)
+	})
+
+	var failed int
+	for _, result := range results {
+		if !result.Created {
+			failed++
+		}
+	}
+
+	if bulk.outputFormat != "" {
+		if err = dump.Formatted(opts.IO.Out, bulk.outputFormat, results); err != nil {
+			return err
+		}
+	} else {
+		for _, result := range results {
+			entries := []*localize.TemplateEntry{
+				localize.NewEntry("Line", result.Line),
+				localize.NewEntry("Principal", result.Principal),
+				localize.NewEntry("Operation", result.Operation),
+				localize.NewEntry("ResourceType", result.ResourceType),
+				localize.NewEntry("ResourceName", result.ResourceName),
+			}
+			if !result.Created {
+				entries = append(entries, localize.NewEntry("Error", result.Error))
+				opts.Logger.Info(icon.ErrorPrefix(), opts.Localizer.MustLocalize("kafka.acl.create.log.info.bulkFailed", entries...))
+				continue
+			}
+			opts.Logger.Info(icon.SuccessPrefix(), opts.Localizer.MustLocalize("kafka.acl.create.log.info.bulkCreated", entries...))
+		}
+	}
+
+	if failed > 0 {
+		return opts.Localizer.MustLocalizeError("kafka.acl.create.error.bulkFailed", localize.NewEntry("Count", failed), localize.NewEntry("Total", len(results)))
+	}
+
+	return nil
+}
+
+// createAll creates the ACL bindings with a pool of workers, the results keep the order of the file
+func createAll(bindings []bindingLine, concurrency int, create func(kafkainstanceclient.AclBinding) error) []bulkResult {
+	results := make([]bulkResult, len(bindings))
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				b := bindings[i].binding
+				results[i] = bulkResult{
+					Line:         bindings[i].line,
+					ResourceType: string(b.GetResourceType()),
+					ResourceName: b.GetResourceName(),
+					PatternType:  string(b.GetPatternType()),
+					Principal:    b.GetPrincipal(),
+					Operation:    string(b.GetOperation()),
+					Permission:   string(b.GetPermission()),
+					Created:      true,
+				}
+				if err := create(b); err != nil {
+					results[i].Created = false
+					results[i].Error = err.Error()
+				}
+			}
+		}()
+	}
+
+	for i := range bindings {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// readBindingsFile reads the ACL bindings from a file, or from stdin when the file is "-"
+func readBindingsFile(file string, stdin io.Reader) ([]bindingLine, error) {
+	if file == stdinFile {
+		return readBindings(stdin)
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return readBindings(f)
+}
+
+// readBindings parses one ACL binding per line, in the format of the bindings listed by "kafka acl list -o json".
+// Blank lines are skipped.
+func readBindings(r io.Reader) ([]bindingLine, error) {
+	var bindings []bindingLine
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		var binding kafkainstanceclient.AclBinding
+		if err := json.Unmarshal(text, &binding); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if err := validateBinding(&binding); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		bindings = append(bindings, bindingLine{line: line, binding: binding})
+	}
+
+	return bindings, scanner.Err()
+}
+
+// validateBinding checks that the required fields of the binding are set
+func validateBinding(binding *kafkainstanceclient.AclBinding) error {
+	switch {
+	case binding.GetResourceType() == "":
+		return errors.New(`"resourceType" is required`)
+	case binding.GetResourceName() == "":
+		return errors.New(`"resourceName" is required`)
+	case binding.GetPatternType() == "":
+		return errors.New(`"patternType" is required`)
+	case binding.GetPrincipal() == "":
+		return errors.New(`"principal" is required`)
+	case binding.GetOperation() == "":
+		return errors.New(`"operation" is required`)
+	case binding.GetPermission() == "":
+		return errors.New(`"permission" is required`)
+	}
+
+	return nil
+}
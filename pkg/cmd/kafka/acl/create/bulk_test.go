@@ -0,0 +1,124 @@
+package create
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory/fakes"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
+)
+
+func Test_readBindings(t *testing.T) {
+	const binding = `{"resourceType":"TOPIC","resourceName":"orders","patternType":"LITERAL","principal":"User:dev","operation":"READ","permission":"ALLOW"}`
+
+	tests := []struct {
+		name      string
+		input     string
+		wantLines []int
+		wantErr   string
+	}{
+		{name: "bindings", input: binding + "\n" + binding + "\n", wantLines: []int{1, 2}},
+		{name: "blank lines are skipped", input: "\n" + binding + "\n\n  \n" + binding, wantLines: []int{2, 5}},
+		{name: "empty input", input: "", wantLines: nil},
+		{name: "invalid JSON", input: binding + "\n{", wantErr: "line 2:"},
+		{name: "invalid operation", input: strings.Replace(binding, "READ", "EAT", 1), wantErr: "line 1:"},
+		{name: "missing principal", input: strings.Replace(binding, `"principal":"User:dev",`, "", 1), wantErr: `line 1: "principal" is required`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readBindings(strings.NewReader(tt.input))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("readBindings() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("readBindings() unexpected error = %v", err)
+			}
+			var lines []int
+			for _, b := range got {
+				lines = append(lines, b.line)
+			}
+			if len(lines) != len(tt.wantLines) {
+				t.Fatalf("readBindings() lines = %v, want %v", lines, tt.wantLines)
+			}
+			for i := range lines {
+				if lines[i] != tt.wantLines[i] {
+					t.Errorf("readBindings() lines = %v, want %v", lines, tt.wantLines)
+				}
+			}
+		})
+	}
+}
+
+func Test_createAll(t *testing.T) {
+	bindings := make([]bindingLine, 10)
+	for i := range bindings {
+		name := "topic"
+		if i%3 == 0 {
+			name = "forbidden"
+		}
+		bindings[i] = bindingLine{line: i + 1, binding: *kafkainstanceclient.NewAclBinding("TOPIC", name, "LITERAL", "User:dev", "READ", "ALLOW")}
+	}
+
+	results := createAll(bindings, 4, func(b kafkainstanceclient.AclBinding) error {
+		if b.GetResourceName() == "forbidden" {
+			return errors.New("forbidden")
+		}
+		return nil
+	})
+
+	for i, result := range results {
+		if result.Line != i+1 {
+			t.Errorf("result %d has line %d, want %d", i, result.Line, i+1)
+		}
+		wantCreated := i%3 != 0
+		if result.Created != wantCreated || (result.Error != "") == wantCreated {
+			t.Errorf("result %d created = %v error = %q, want created = %v", i, result.Created, result.Error, wantCreated)
+		}
+	}
+}
+
+func TestCreateCommand_file(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		stdin   string
+		wantErr string
+	}{
+		{
+			name:    "bindings are read from the input of the command",
+			args:    []string{"--file", "-", "--instance-id", "kafka"},
+			stdin:   "{\n",
+			wantErr: `could not read the ACL bindings from "-": line 1:`,
+		},
+		{
+			name:    "output requires a file",
+			args:    []string{"--topic", "orders", "--operation", "read", "--permission", "allow", "--all-accounts", "-o", "json"},
+			wantErr: "--output can only be used with --file",
+		},
+		{
+			name:    "concurrency requires a file",
+			args:    []string{"--topic", "orders", "--operation", "read", "--permission", "allow", "--all-accounts", "--concurrency", "2"},
+			wantErr: "--concurrency can only be used with --file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := fakes.NewFactory(t)
+			f.In.WriteString(tt.stdin)
+
+			cmd := NewCreateCommand(f.Factory)
+			cmd.SetArgs(tt.args)
+			cmd.SetOut(f.Out)
+			cmd.SetErr(f.ErrOut)
+
+			err := cmd.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Execute() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
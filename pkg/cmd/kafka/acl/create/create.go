@@ -22,6 +22,12 @@ var (
 	prefix         bool
 )
 
+// bindingFlagNames are the flags describing a single ACL binding, which cannot be used with --file
+var bindingFlagNames = []string{
+	"permission", "operation", aclFlagUtil.ClusterFlagName, "prefix", aclFlagUtil.TopicFlagName, aclFlagUtil.GroupFlagName,
+	aclFlagUtil.TransactionalIDFlagName, "user", "service-account", "all-accounts",
+}
+
 type requestParams struct {
 	principal    string
 	resourceName string
@@ -42,6 +48,8 @@ func NewCreateCommand(f *factory.Factory) *cobra.Command {
 		ServiceContext: f.ServiceContext,
 	}
 
+	bulk := &bulkOptions{}
+
 	cmd := &cobra.Command{
 		Use:     "create",
 		Short:   f.Localizer.MustLocalize("kafka.acl.create.cmd.shortDescription"),
@@ -49,6 +57,36 @@ func NewCreateCommand(f *factory.Factory) *cobra.Command {
 		Example: f.Localizer.MustLocalize("kafka.acl.create.cmd.example"),
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			if bulk.file != "" {
+				for _, name := range bindingFlagNames {
+					if cmd.Flags().Changed(name) {
+						return opts.Localizer.MustLocalizeError("kafka.acl.create.error.fileCannotBeCombined", localize.NewEntry("Flag", name))
+					}
+				}
+
+				if bulk.outputFormat != "" && !flagutil.IsValidInput(bulk.outputFormat, flagutil.ValidOutputFormats...) {
+					return flagutil.InvalidValueError("output", bulk.outputFormat, flagutil.ValidOutputFormats...)
+				}
+
+				if opts.InstanceID == "" {
+					kafkaInstance, err := contextutil.GetCurrentKafkaInstance(f)
+					if err != nil {
+						return err
+					}
+
+					opts.InstanceID = kafkaInstance.GetId()
+				}
+
+				return runBulkCreate(opts.InstanceID, opts, bulk)
+			}
+
+			// the output format and concurrency only apply to the results of the bindings of a file
+			for _, name := range []string{"output", "concurrency"} {
+				if cmd.Flags().Changed(name) {
+					return opts.Localizer.MustLocalizeError("kafka.acl.create.error.requiresFile", localize.NewEntry("Flag", name))
+				}
+			}
+
 			if !opts.IO.CanPrompt() && !opts.SkipConfirm {
 				return flagutil.RequiredWhenNonInteractiveError("yes")
 			}
@@ -106,6 +144,9 @@ func NewCreateCommand(f *factory.Factory) *cobra.Command {
 	flags.AddServiceAccount(&serviceAccount)
 	flags.AddAllAccounts(&allAccounts)
 	flags.AddYes(&opts.SkipConfirm)
+	flags.StringVarP(&bulk.file, "file", "f", "", f.Localizer.MustLocalize("kafka.acl.create.flag.file.description"))
+	flags.IntVar(&bulk.concurrency, "concurrency", 4, f.Localizer.MustLocalize("kafka.acl.create.flag.concurrency.description"))
+	flags.AddOutput(&bulk.outputFormat)
 
 	return cmd
 }
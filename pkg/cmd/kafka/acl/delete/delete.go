@@ -2,6 +2,7 @@ package delete
 
 import (
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/internal/build"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/aclcmdutil"
 	aclFlagUtil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
@@ -22,8 +23,13 @@ var (
 	allAccounts     bool
 	prefix          bool
 	patternTypeFlag string
+	all             bool
+	dryRun          bool
 )
 
+// filterFlags select the ACL bindings to delete, they cannot be combined with --all
+var filterFlags = []string{"operation", "permission", "cluster", "topic", "group", "transactional-id", "prefix", "pattern-type"}
+
 type requestParams struct {
 	principal    string
 	resourceName string
@@ -50,10 +56,18 @@ func NewDeleteCommand(f *factory.Factory) *cobra.Command {
 		Example: f.Localizer.MustLocalize("kafka.acl.delete.cmd.example"),
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			if !opts.IO.CanPrompt() && !opts.SkipConfirm {
+			if !opts.IO.CanPrompt() && !opts.SkipConfirm && !dryRun {
 				return flagutil.RequiredWhenNonInteractiveError("yes")
 			}
 
+			if all {
+				for _, flagName := range filterFlags {
+					if cmd.Flags().Changed(flagName) {
+						return opts.Localizer.MustLocalizeError("kafka.acl.delete.error.allWithFilter", localize.NewEntry("Flag", flagName))
+					}
+				}
+			}
+
 			var errorCollection []error
 
 			selectedResourceTypeCount := aclcmdutil.SetACLResources(opts)
@@ -101,6 +115,8 @@ func NewDeleteCommand(f *factory.Factory) *cobra.Command {
 	flags.AddAllAccounts(&allAccounts)
 	flags.AddYes(&opts.SkipConfirm)
 	flags.AddPrefix(&prefix)
+	flags.BoolVar(&all, "all", false, opts.Localizer.MustLocalize("kafka.acl.delete.flag.all.description"))
+	flags.BoolVar(&dryRun, "dry-run", false, opts.Localizer.MustLocalize("kafka.acl.delete.flag.dryRun.description"))
 
 	cmd.Flags().StringVar(
 		&patternTypeFlag,
@@ -152,6 +168,12 @@ func runDelete(instanceID string, opts *aclcmdutil.CrudOptions) error {
 
 	kafkaNameTmplEntry := localize.NewEntry("Name", kafkaInstance.GetName())
 
+	requestParams := getRequestParams(opts)
+
+	if dryRun {
+		return printMatchingACLs(opts, adminAPI.AclsApi.GetAcls(ctx), requestParams, kafkaInstance.GetName())
+	}
+
 	if !opts.SkipConfirm {
 		prompt := &survey.Confirm{
 			Message: opts.Localizer.MustLocalize("kafka.acl.delete.input.confirmDeleteMessage", kafkaNameTmplEntry),
@@ -171,8 +193,6 @@ func runDelete(instanceID string, opts *aclcmdutil.CrudOptions) error {
 	spinnr.SetLocalizedSuffix("kafka.acl.delete.log.info.deletingACLs", kafkaNameTmplEntry)
 	spinnr.Start()
 
-	requestParams := getRequestParams(opts)
-
 	requestDeleteAcls := adminAPI.AclsApi.DeleteAcls(ctx)
 	if requestParams.resourceType != "" {
 		requestDeleteAcls = requestDeleteAcls.ResourceType(requestParams.resourceType)
@@ -231,6 +251,49 @@ func runDelete(instanceID string, opts *aclcmdutil.CrudOptions) error {
 	return nil
 }
 
+// printMatchingACLs prints the ACL bindings which would be deleted, without deleting them
+func printMatchingACLs(opts *aclcmdutil.CrudOptions, req kafkainstanceclient.ApiGetAclsRequest, params *requestParams, instanceName string) error {
+	if params.resourceType != "" {
+		req = req.ResourceType(params.resourceType)
+	}
+	if params.principal != "" {
+		req = req.Principal(params.principal)
+	}
+	if params.resourceName != "" {
+		req = req.ResourceName(params.resourceName)
+	}
+	if params.patternType != "" {
+		req = req.PatternType(params.patternType)
+	}
+	if params.operation != "" {
+		req = req.Operation(params.operation)
+	}
+	if params.permission != "" {
+		req = req.Permission(params.permission)
+	}
+
+	bindings, httpRes, err := aclcmdutil.FetchAllACLs(req, cmdutil.ConvertSizeValueToInt32(build.DefaultPageSize))
+	if err = aclcmdutil.ValidateAPIError(httpRes, opts.Localizer, err, "list", instanceName); err != nil {
+		return err
+	}
+
+	if opts.Output != "" {
+		total := int32(len(bindings))
+		return dump.Formatted(opts.IO.Out, opts.Output, dump.NewList("AclBindingList", 1, total, total, bindings))
+	}
+
+	if len(bindings) == 0 {
+		opts.Logger.Info(icon.InfoPrefix(), opts.Localizer.MustLocalize("kafka.acl.delete.noACLsDeleted"))
+		return nil
+	}
+
+	opts.Logger.Info(opts.Localizer.MustLocalize("kafka.acl.delete.log.info.theFollowingACLSwillBeDeleted", localize.NewEntry("Name", instanceName)))
+	opts.Logger.Info()
+	dump.Table(opts.IO.Out, aclcmdutil.MapACLsToTableRows(bindings, opts.Localizer))
+
+	return nil
+}
+
 func getRequestParams(opts *aclcmdutil.CrudOptions) *requestParams {
 	return &requestParams{
 		resourceType: aclcmdutil.GetMappedResourceTypeFilterValue(opts.ResourceType),
@@ -280,6 +343,13 @@ func validateAndSetOpts(opts *aclcmdutil.CrudOptions) error {
 		opts.PatternType = aclcmdutil.PatternTypePREFIX
 	}
 
+	// the operation is left empty, which matches any operation
+	if all {
+		opts.ResourceType = aclcmdutil.ResourceTypeANY
+		opts.PatternType = aclcmdutil.PatternTypeANY
+		opts.Permission = aclcmdutil.PermissionANY
+	}
+
 	if userID != "" {
 		opts.Principal = userID
 	} else if serviceAccount != "" {
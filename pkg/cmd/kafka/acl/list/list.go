@@ -6,17 +6,26 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/aclcmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/kafkacmdutil"
+	coreflagutil "github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
 
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
 	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
 	"github.com/spf13/cobra"
 )
 
+const groupByPrincipal = "principal"
+
+var validGroupByValues = []string{groupByPrincipal}
+
+var validOutputFormats = append(append([]string{}, coreflagutil.ValidOutputFormats...), dump.CSVFormat)
+
 var (
 	serviceAccount string
 	userID         string
@@ -40,7 +49,8 @@ type options struct {
 	group   string
 	cluster bool
 
-	output string
+	output  string
+	groupBy string
 }
 
 // nolint:funlen
@@ -57,13 +67,22 @@ func NewListACLCommand(f *factory.Factory) *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:     "list",
-		Short:   f.Localizer.MustLocalize("kafka.acl.list.cmd.shortDescription"),
-		Long:    f.Localizer.MustLocalize("kafka.acl.list.cmd.longDescription"),
-		Example: f.Localizer.MustLocalize("kafka.acl.list.cmd.example"),
-		Args:    cobra.NoArgs,
+		Use:         "list",
+		Short:       f.Localizer.MustLocalize("kafka.acl.list.cmd.shortDescription"),
+		Long:        f.Localizer.MustLocalize("kafka.acl.list.cmd.longDescription"),
+		Example:     f.Localizer.MustLocalize("kafka.acl.list.cmd.example"),
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{cmdutil.PagerAnnotation: "true"},
 		RunE: func(cmd *cobra.Command, _ []string) error {
 
+			if opts.output != "" && !coreflagutil.IsValidInput(opts.output, validOutputFormats...) {
+				return coreflagutil.InvalidValueError("output", opts.output, validOutputFormats...)
+			}
+
+			if opts.groupBy != "" && !coreflagutil.IsValidInput(opts.groupBy, validGroupByValues...) {
+				return coreflagutil.InvalidValueError("group-by", opts.groupBy, validGroupByValues...)
+			}
+
 			if opts.page < 1 {
 				return opts.localizer.MustLocalizeError("kafka.common.validation.page.error.invalid.minValue", localize.NewEntry("Page", opts.page))
 			}
@@ -111,7 +130,7 @@ func NewListACLCommand(f *factory.Factory) *cobra.Command {
 	flags := flagutil.NewFlagSet(cmd, f)
 
 	flags.AddInstanceID(&opts.kafkaID)
-	flags.AddOutput(&opts.output)
+	flags.AddOutputFormats(&opts.output, validOutputFormats...)
 	flags.AddPage(&opts.page)
 	flags.AddSize(&opts.size)
 	flags.AddUser(&userID)
@@ -120,6 +139,15 @@ func NewListACLCommand(f *factory.Factory) *cobra.Command {
 	flags.BoolVar(&opts.cluster, "cluster", false, opts.localizer.MustLocalize("kafka.acl.list.flag.cluster.description"))
 	flags.StringVar(&opts.topic, "topic", "", opts.localizer.MustLocalize("kafka.acl.list.flag.topic.description"))
 	flags.StringVar(&opts.group, "group", "", opts.localizer.MustLocalize("kafka.acl.list.flag.group.description"))
+	flags.StringVar(&opts.groupBy, "group-by", "", coreflagutil.FlagDescription(opts.localizer, "kafka.acl.list.flag.groupBy.description", validGroupByValues...))
+	flags.AddPrintSchemaFunc(func() interface{} {
+		if opts.groupBy == groupByPrincipal {
+			return dump.NewList("PrincipalPermissionsList", 0, 0, 0, []aclcmdutil.PrincipalPermissions{})
+		}
+		return dump.NewList("AclBindingList", 0, 0, 0, []kafkainstanceclient.AclBinding{})
+	})
+
+	coreflagutil.EnableStaticFlagCompletion(cmd, "group-by", validGroupByValues)
 
 	_ = cmd.RegisterFlagCompletionFunc("topic", func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return kafkacmdutil.FilterValidTopicNameArgs(f, toComplete)
@@ -145,7 +173,6 @@ func runList(opts *options) (err error) {
 
 	req := api.AclsApi.GetAcls(opts.context)
 
-	req = req.Page(opts.page).Size(opts.size)
 	req = req.Order("asc").OrderKey("principal")
 
 	if opts.principal != "" {
@@ -187,7 +214,11 @@ func runList(opts *options) (err error) {
 		req = req.ResourceName(aclcmdutil.GetResourceName(resourceName))
 	}
 
-	permissionsData, httpRes, err := req.Execute()
+	if opts.groupBy == groupByPrincipal {
+		return runListGroupedByPrincipal(opts, req, kafkaInstance.GetName())
+	}
+
+	permissionsData, httpRes, err := req.Page(opts.page).Size(opts.size).Execute()
 	if httpRes != nil {
 		defer httpRes.Body.Close()
 	}
@@ -208,8 +239,41 @@ func runList(opts *options) (err error) {
 		permissions := permissionsData.GetItems()
 		rows := aclcmdutil.MapACLsToTableRows(permissions, opts.localizer)
 		dump.Table(opts.io.Out, rows)
+	case dump.CSVFormat:
+		rows := aclcmdutil.MapACLsToTableRows(permissionsData.GetItems(), opts.localizer)
+		return dump.CSV(opts.io.Out, rows)
+	default:
+		return dump.Formatted(opts.io.Out, opts.output, kafkacmdutil.NewListDocument(&permissionsData, "AclBindingList", opts.page, opts.size, permissionsData.GetItems()))
+	}
+
+	return nil
+}
+
+// runListGroupedByPrincipal fetches all matching ACL bindings, so that the permissions
+// of a principal are never split across pages, and prints them grouped by principal
+func runListGroupedByPrincipal(opts *options, req kafkainstanceclient.ApiGetAclsRequest, instanceName string) error {
+	bindings, httpRes, err := aclcmdutil.FetchAllACLs(req, opts.size)
+	if err = aclcmdutil.ValidateAPIError(httpRes, opts.localizer, err, "list", instanceName); err != nil {
+		return err
+	}
+
+	if len(bindings) == 0 && opts.output == "" {
+		opts.logger.Info(opts.localizer.MustLocalize("kafka.acl.list.log.info.noACLs", localize.NewEntry("InstanceName", instanceName)))
+
+		return nil
+	}
+
+	groups := aclcmdutil.GroupACLsByPrincipal(bindings)
+
+	switch opts.output {
+	case dump.EmptyFormat:
+		opts.logger.Info("")
+		dump.Table(opts.io.Out, aclcmdutil.MapPrincipalPermissionsToTableRows(groups, opts.localizer))
+	case dump.CSVFormat:
+		return dump.CSV(opts.io.Out, aclcmdutil.MapPrincipalPermissionsToTableRows(groups, opts.localizer))
 	default:
-		return dump.Formatted(opts.io.Out, opts.output, permissionsData)
+		// the groups keep their bare array, which existing scripts read with '.[]'
+		return dump.Formatted(opts.io.Out, opts.output, groups)
 	}
 
 	return nil
@@ -0,0 +1,84 @@
+package adminurl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/kafkautil"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	id string
+
+	IO         *iostreams.IOStreams
+	Connection factory.ConnectionFunc
+	localizer  localize.Localizer
+	Context    context.Context
+}
+
+// NewAdminURLCommand creates a command which prints the admin server URL of a Kafka instance
+func NewAdminURLCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		IO:         f.IOStreams,
+		Connection: f.Connection,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "admin-url",
+		Short:   f.Localizer.MustLocalize("kafka.adminUrl.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("kafka.adminUrl.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("kafka.adminUrl.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if opts.id != "" {
+				return runAdminURL(opts)
+			}
+
+			kafkaInstance, err := contextutil.GetCurrentKafkaInstance(f)
+			if err != nil {
+				return err
+			}
+
+			return printAdminURL(opts, kafkaInstance)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.id, "id", "", f.Localizer.MustLocalize("kafka.adminUrl.flag.id"))
+
+	return cmd
+}
+
+func runAdminURL(opts *options) error {
+	conn, err := opts.Connection()
+	if err != nil {
+		return err
+	}
+
+	kafkaInstance, httpRes, err := kafkautil.GetKafkaByID(opts.Context, conn.API().KafkaMgmt(), opts.id)
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		return err
+	}
+
+	return printAdminURL(opts, kafkaInstance)
+}
+
+func printAdminURL(opts *options, kafkaInstance *kafkamgmtclient.KafkaRequest) error {
+	adminURL, ok := kafkaInstance.GetAdminApiServerUrlOk()
+	if !ok || *adminURL == "" {
+		return opts.localizer.MustLocalizeError("kafka.adminUrl.error.notAvailable", localize.NewEntry("Name", kafkaInstance.GetName()))
+	}
+
+	fmt.Fprintln(opts.IO.Out, *adminURL)
+	return nil
+}
@@ -1,6 +1,7 @@
 package billing
 
 import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/billing/estimate"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/create"
 	kafkaFlagutil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
 
@@ -53,6 +54,8 @@ func NewBillingCommand(f *factory.Factory) *cobra.Command {
 
 	flags.AddOutput(&opts.outputFormat)
 
+	cmd.AddCommand(estimate.NewEstimateCommand(f))
+
 	return cmd
 }
 
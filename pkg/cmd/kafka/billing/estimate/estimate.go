@@ -0,0 +1,188 @@
+package estimate
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/create"
+	kafkaFlagutil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/kafkautil"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+
+	"github.com/spf13/cobra"
+)
+
+const defaultSince = "30d"
+
+// estimateRow is the estimated consumption of a single Kafka instance
+type estimateRow struct {
+	ID                 string  `json:"id" yaml:"id" header:"ID"`
+	Name               string  `json:"name" yaml:"name" header:"Name"`
+	Size               string  `json:"size" yaml:"size" header:"Size"`
+	StreamingUnits     int32   `json:"streaming_units" yaml:"streaming_units" header:"Streaming Units"`
+	Hours              float64 `json:"hours" yaml:"hours" header:"Hours"`
+	StreamingUnitHours float64 `json:"streaming_unit_hours" yaml:"streaming_unit_hours" header:"Streaming Unit Hours"`
+}
+
+// estimate is the estimated consumption of all Kafka instances
+type estimate struct {
+	Since                   time.Time     `json:"since" yaml:"since"`
+	Instances               []estimateRow `json:"instances" yaml:"instances"`
+	TotalStreamingUnitHours float64       `json:"total_streaming_unit_hours" yaml:"total_streaming_unit_hours"`
+}
+
+type options struct {
+	outputFormat string
+	since        string
+
+	f *factory.Factory
+}
+
+// NewEstimateCommand creates a new command for estimating the streaming unit hours consumed by Kafka instances
+func NewEstimateCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "estimate",
+		Short:   f.Localizer.MustLocalize("kafka.billing.estimate.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("kafka.billing.estimate.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("kafka.billing.estimate.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			since, err := parseSince(opts.since)
+			if err != nil || since <= 0 {
+				return f.Localizer.MustLocalizeError("kafka.billing.estimate.error.invalidSince", localize.NewEntry("Since", opts.since))
+			}
+
+			return runEstimate(opts, since)
+		},
+	}
+
+	flags := kafkaFlagutil.NewFlagSet(cmd, f.Localizer)
+
+	flags.AddOutput(&opts.outputFormat)
+	flags.StringVar(&opts.since, "since", defaultSince, f.Localizer.MustLocalize("kafka.billing.estimate.flag.since.description"))
+
+	return cmd
+}
+
+func runEstimate(opts *options, since time.Duration) error {
+	f := opts.f
+
+	conn, err := f.Connection()
+	if err != nil {
+		return err
+	}
+
+	api := conn.API().KafkaMgmt()
+
+	kafkas, err := kafkautil.ListKafkas(f.Context, api, "")
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	result := estimate{
+		Since:     now.Add(-since).UTC(),
+		Instances: make([]estimateRow, 0, len(kafkas)),
+	}
+
+	// instances of the same size share the same size definition
+	sizeCache := map[string]*kafkamgmtclient.SupportedKafkaSize{}
+
+	for i := range kafkas {
+		kafka := kafkas[i]
+
+		var streamingUnits int32
+		if kafka.GetInstanceType() != create.DeveloperType {
+			cacheKey := fmt.Sprintf("%v/%v/%v/%v", kafka.GetCloudProvider(), kafka.GetRegion(), kafka.GetInstanceType(), kafka.GetSizeId())
+			size, ok := sizeCache[cacheKey]
+			if !ok {
+				size, err = kafkautil.GetKafkaSize(f.Context, api, &kafka)
+				if err != nil {
+					f.Logger.Debug("Could not fetch the size of Kafka instance", kafka.GetName(), err)
+				}
+				sizeCache[cacheKey] = size
+			}
+			if size != nil {
+				streamingUnits = size.GetQuotaConsumed()
+			}
+		}
+
+		hours := uptimeHours(kafka.GetCreatedAt(), result.Since, now)
+		row := estimateRow{
+			ID:                 kafka.GetId(),
+			Name:               kafka.GetName(),
+			Size:               kafka.GetSizeId(),
+			StreamingUnits:     streamingUnits,
+			Hours:              hours,
+			StreamingUnitHours: roundHours(float64(streamingUnits) * hours),
+		}
+
+		result.Instances = append(result.Instances, row)
+		result.TotalStreamingUnitHours += row.StreamingUnitHours
+	}
+	result.TotalStreamingUnitHours = roundHours(result.TotalStreamingUnitHours)
+
+	switch opts.outputFormat {
+	case dump.EmptyFormat:
+		if len(result.Instances) == 0 {
+			f.Logger.Info(f.Localizer.MustLocalize("kafka.common.log.info.noKafkaInstances"))
+			return nil
+		}
+		dump.Table(f.IOStreams.Out, result.Instances)
+		f.Logger.Info("")
+		f.Logger.Info(f.Localizer.MustLocalize("kafka.billing.estimate.log.info.total",
+			localize.NewEntry("Total", result.TotalStreamingUnitHours),
+			localize.NewEntry("Since", result.Since.Format(time.RFC3339)),
+		))
+	default:
+		return dump.Formatted(f.IOStreams.Out, opts.outputFormat, result)
+	}
+
+	return nil
+}
+
+// parseSince parses the period of time of the estimate, either as a number of days
+// such as "7d" or as a duration accepted by time.ParseDuration such as "12h"
+func parseSince(value string) (time.Duration, error) {
+	if strings.HasSuffix(value, "d") {
+		n, err := strconv.Atoi(strings.TrimSuffix(value, "d"))
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	return time.ParseDuration(value)
+}
+
+// uptimeHours returns the number of hours an instance created at createdAt
+// has been running within the window between since and now
+func uptimeHours(createdAt time.Time, since time.Time, now time.Time) float64 {
+	start := since
+	if createdAt.After(since) {
+		start = createdAt
+	}
+	if !start.Before(now) {
+		return 0
+	}
+	return roundHours(now.Sub(start).Hours())
+}
+
+func roundHours(hours float64) float64 {
+	return math.Round(hours*100) / 100
+}
@@ -0,0 +1,329 @@
+package checkconnection
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	kafkaflagutil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/kafkautil"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultTimeout = 10 * time.Second
+	defaultPort    = 443
+	plaintextPort  = 9092
+
+	envClientID     = "RHOAS_SERVICE_ACCOUNT_CLIENT_ID"
+	envClientSecret = "RHOAS_SERVICE_ACCOUNT_CLIENT_SECRET"
+
+	statusPassed  = "passed"
+	statusFailed  = "failed"
+	statusSkipped = "skipped"
+
+	stepDNS  = "dns"
+	stepTCP  = "tcp"
+	stepTLS  = "tls"
+	stepSASL = "sasl"
+)
+
+type options struct {
+	id           string
+	name         string
+	ports        []int
+	clientID     string
+	clientSecret string
+	timeout      time.Duration
+	outputFormat string
+
+	f *factory.Factory
+}
+
+// check is the result of a single connectivity check
+type check struct {
+	Step    string `json:"step" yaml:"step" header:"Step"`
+	Target  string `json:"target" yaml:"target" header:"Target"`
+	Status  string `json:"status" yaml:"status" header:"Status"`
+	Details string `json:"details,omitempty" yaml:"details,omitempty" header:"Details"`
+}
+
+// diagnosis is the result of all connectivity checks against a Kafka instance
+type diagnosis struct {
+	BootstrapServer string  `json:"bootstrap_server" yaml:"bootstrap_server"`
+	Checks          []check `json:"checks" yaml:"checks"`
+	Passed          bool    `json:"passed" yaml:"passed"`
+}
+
+// NewCheckConnectionCommand creates a new command which validates that a client
+// can connect and authenticate to a Kafka instance
+func NewCheckConnectionCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "check-connection",
+		Short:   f.Localizer.MustLocalize("kafka.checkConnection.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("kafka.checkConnection.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("kafka.checkConnection.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			if opts.name != "" && opts.id != "" {
+				return f.Localizer.MustLocalizeError("service.error.idAndNameCannotBeUsed")
+			}
+
+			if opts.timeout <= 0 {
+				return flagutil.InvalidValueError("timeout", opts.timeout)
+			}
+
+			for _, port := range opts.ports {
+				if port < 1 || port > 65535 {
+					return flagutil.InvalidValueError("port", port)
+				}
+			}
+
+			if opts.clientID == "" {
+				opts.clientID = os.Getenv(envClientID)
+			}
+			if opts.clientSecret == "" {
+				opts.clientSecret = os.Getenv(envClientSecret)
+			}
+			if (opts.clientID == "") != (opts.clientSecret == "") {
+				return f.Localizer.MustLocalizeError("kafka.checkConnection.error.incompleteCredentials")
+			}
+
+			if opts.id == "" && opts.name == "" {
+				kafkaInstance, err := contextutil.GetCurrentKafkaInstance(f)
+				if err != nil {
+					return err
+				}
+
+				opts.id = kafkaInstance.GetId()
+			}
+
+			return runCheckConnection(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, f.Localizer)
+
+	flags.StringVar(&opts.id, "id", "", f.Localizer.MustLocalize("kafka.checkConnection.flag.id"))
+	flags.StringVar(&opts.name, "name", "", f.Localizer.MustLocalize("kafka.checkConnection.flag.name"))
+	flags.IntSliceVar(&opts.ports, "port", []int{defaultPort, plaintextPort}, f.Localizer.MustLocalize("kafka.checkConnection.flag.port"))
+	flags.StringVar(&opts.clientID, "client-id", "", f.Localizer.MustLocalize("kafka.checkConnection.flag.clientID"))
+	flags.AddSecret(&opts.clientSecret, "client-secret", "", f.IOStreams.In, f.Localizer.MustLocalize("kafka.checkConnection.flag.clientSecret"))
+	flags.DurationVar(&opts.timeout, "timeout", defaultTimeout, f.Localizer.MustLocalize("kafka.checkConnection.flag.timeout"))
+	flags.AddOutput(&opts.outputFormat)
+
+	if err := kafkaflagutil.RegisterNameFlagCompletionFunc(cmd, f); err != nil {
+		f.Logger.Debug(f.Localizer.MustLocalize("kafka.common.error.load.completions.name.flag"), err)
+	}
+
+	return cmd
+}
+
+func runCheckConnection(opts *options) error {
+	f := opts.f
+
+	conn, err := f.Connection()
+	if err != nil {
+		return err
+	}
+
+	api := conn.API().KafkaMgmt()
+
+	var kafkaInstance *kafkamgmtclient.KafkaRequest
+	var httpRes *http.Response
+	if opts.name != "" {
+		kafkaInstance, httpRes, err = kafkautil.GetKafkaByName(f.Context, api, opts.name)
+	} else {
+		kafkaInstance, httpRes, err = kafkautil.GetKafkaByID(f.Context, api, opts.id)
+	}
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		return err
+	}
+
+	bootstrapServer, ok := kafkaInstance.GetBootstrapServerHostOk()
+	if !ok || *bootstrapServer == "" {
+		return f.Localizer.MustLocalizeError("kafka.checkConnection.error.noBootstrapServer", localize.NewEntry("Name", kafkaInstance.GetName()))
+	}
+
+	result := diagnose(f.Context, opts, *bootstrapServer)
+
+	if opts.outputFormat != dump.EmptyFormat {
+		if err = dump.Formatted(f.IOStreams.Out, opts.outputFormat, result); err != nil {
+			return err
+		}
+	} else {
+		dump.Table(f.IOStreams.Out, result.Checks)
+		f.Logger.Info("")
+		for _, c := range result.Checks {
+			if c.Status == statusFailed {
+				f.Logger.Info(f.Localizer.MustLocalize("kafka.checkConnection.hint."+c.Step, localize.NewEntry("Target", c.Target)))
+			}
+		}
+	}
+
+	if !result.Passed {
+		return f.Localizer.MustLocalizeError("kafka.checkConnection.error.failed", localize.NewEntry("Name", kafkaInstance.GetName()))
+	}
+
+	if opts.outputFormat == dump.EmptyFormat {
+		f.Logger.Info(f.Localizer.MustLocalize("kafka.checkConnection.log.info.passed", localize.NewEntry("Name", kafkaInstance.GetName())))
+	}
+
+	return nil
+}
+
+// diagnose runs the connectivity checks against the bootstrap server in order,
+// skipping the checks which depend on a failed one
+// nolint:funlen
+func diagnose(ctx context.Context, opts *options, bootstrapServer string) *diagnosis {
+	result := &diagnosis{
+		BootstrapServer: bootstrapServer,
+		Passed:          true,
+	}
+	add := func(c check) {
+		if c.Status == statusFailed {
+			result.Passed = false
+		}
+		result.Checks = append(result.Checks, c)
+	}
+
+	host, bootstrapPort := splitHostPort(bootstrapServer)
+	ports := opts.ports
+	if !containsPort(ports, bootstrapPort) {
+		ports = append([]int{bootstrapPort}, ports...)
+	}
+
+	dnsCtx, cancel := context.WithTimeout(ctx, opts.timeout)
+	addrs, err := net.DefaultResolver.LookupHost(dnsCtx, host)
+	cancel()
+	if err != nil {
+		add(check{Step: stepDNS, Target: host, Status: statusFailed, Details: err.Error()})
+	} else {
+		add(check{Step: stepDNS, Target: host, Status: statusPassed, Details: strings.Join(addrs, ", ")})
+	}
+
+	var saslConn *tls.Conn
+	for _, port := range ports {
+		target := net.JoinHostPort(host, strconv.Itoa(port))
+		if err != nil {
+			add(check{Step: stepTCP, Target: target, Status: statusSkipped})
+			add(check{Step: stepTLS, Target: target, Status: statusSkipped})
+			continue
+		}
+
+		start := time.Now()
+		dialer := &net.Dialer{Timeout: opts.timeout}
+		tcpConn, dialErr := dialer.DialContext(ctx, "tcp", target)
+		if dialErr != nil {
+			add(check{Step: stepTCP, Target: target, Status: statusFailed, Details: dialErr.Error()})
+			add(check{Step: stepTLS, Target: target, Status: statusSkipped})
+			continue
+		}
+		add(check{Step: stepTCP, Target: target, Status: statusPassed, Details: fmt.Sprintf("connected to %v in %v", tcpConn.RemoteAddr(), time.Since(start).Round(time.Millisecond))})
+
+		_ = tcpConn.SetDeadline(time.Now().Add(opts.timeout))
+		tlsConn := tls.Client(tcpConn, &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12})
+		if tlsErr := tlsConn.Handshake(); tlsErr != nil {
+			add(check{Step: stepTLS, Target: target, Status: statusFailed, Details: tlsErr.Error()})
+			tcpConn.Close()
+			continue
+		}
+		add(check{Step: stepTLS, Target: target, Status: statusPassed, Details: describeTLS(tlsConn.ConnectionState())})
+
+		if port == bootstrapPort && saslConn == nil {
+			saslConn = tlsConn
+		} else {
+			tlsConn.Close()
+		}
+	}
+
+	bootstrapTarget := net.JoinHostPort(host, strconv.Itoa(bootstrapPort))
+	switch {
+	case opts.clientID == "":
+		add(check{Step: stepSASL, Target: bootstrapTarget, Status: statusSkipped, Details: "no service account credentials provided"})
+	case saslConn == nil:
+		add(check{Step: stepSASL, Target: bootstrapTarget, Status: statusSkipped})
+	default:
+		defer saslConn.Close()
+		_ = saslConn.SetDeadline(time.Now().Add(opts.timeout))
+		if saslErr := authenticatePlain(saslConn, opts.clientID, opts.clientSecret); saslErr != nil {
+			add(check{Step: stepSASL, Target: bootstrapTarget, Status: statusFailed, Details: saslErr.Error()})
+		} else {
+			add(check{Step: stepSASL, Target: bootstrapTarget, Status: statusPassed, Details: "authenticated as " + opts.clientID})
+		}
+	}
+
+	return result
+}
+
+// splitHostPort splits the bootstrap server into its host and port,
+// defaulting to the TLS port when none is set
+func splitHostPort(bootstrapServer string) (string, int) {
+	host, portStr, err := net.SplitHostPort(bootstrapServer)
+	if err != nil {
+		return bootstrapServer, defaultPort
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return host, defaultPort
+	}
+	return host, port
+}
+
+func containsPort(ports []int, port int) bool {
+	for _, p := range ports {
+		if p == port {
+			return true
+		}
+	}
+	return false
+}
+
+func describeTLS(state tls.ConnectionState) string {
+	version := tlsVersionName(state.Version)
+	if len(state.PeerCertificates) == 0 {
+		return version
+	}
+	cert := state.PeerCertificates[0]
+	return fmt.Sprintf("%v, certificate for %v issued by %v, expires %v",
+		version, cert.Subject.CommonName, cert.Issuer.CommonName, cert.NotAfter.Format("2006-01-02"))
+}
+
+func tlsVersionName(version uint16) string {
+	switch version {
+	case tls.VersionTLS10:
+		return "TLS 1.0"
+	case tls.VersionTLS11:
+		return "TLS 1.1"
+	case tls.VersionTLS12:
+		return "TLS 1.2"
+	case tls.VersionTLS13:
+		return "TLS 1.3"
+	default:
+		return fmt.Sprintf("TLS 0x%04X", version)
+	}
+}
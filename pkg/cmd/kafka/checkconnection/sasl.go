@@ -0,0 +1,152 @@
+package checkconnection
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// Kafka protocol API keys and versions used for the SASL/PLAIN exchange
+const (
+	saslHandshakeKey        int16 = 17
+	saslHandshakeVersion    int16 = 1
+	saslAuthenticateKey     int16 = 36
+	saslAuthenticateVersion int16 = 0
+
+	plainMechanism = "PLAIN"
+	clientID       = "rhoas-check-connection"
+)
+
+// kafkaError is an error code returned by the Kafka broker
+type kafkaError struct {
+	Code    int16
+	Message string
+}
+
+func (e *kafkaError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("broker returned error code %v: %v", e.Code, e.Message)
+	}
+	return fmt.Sprintf("broker returned error code %v", e.Code)
+}
+
+// authenticatePlain performs a SASL/PLAIN authentication against a Kafka broker
+// using the raw Kafka protocol over the given connection
+func authenticatePlain(conn io.ReadWriter, username string, password string) error {
+	var body bytes.Buffer
+	writeString(&body, plainMechanism)
+	if err := writeRequest(conn, saslHandshakeKey, saslHandshakeVersion, 1, body.Bytes()); err != nil {
+		return err
+	}
+
+	res, err := readResponse(conn, 1)
+	if err != nil {
+		return err
+	}
+	r := bytes.NewReader(res)
+	var errorCode int16
+	if err = binary.Read(r, binary.BigEndian, &errorCode); err != nil {
+		return err
+	}
+	if errorCode != 0 {
+		var mechanisms []string
+		var count int32
+		if binary.Read(r, binary.BigEndian, &count) == nil {
+			for i := int32(0); i < count; i++ {
+				mechanism, readErr := readString(r)
+				if readErr != nil {
+					break
+				}
+				mechanisms = append(mechanisms, mechanism)
+			}
+		}
+		return &kafkaError{Code: errorCode, Message: fmt.Sprintf("mechanism %v not supported, broker supports %v", plainMechanism, mechanisms)}
+	}
+
+	body.Reset()
+	writeBytes(&body, []byte("\x00"+username+"\x00"+password))
+	if err = writeRequest(conn, saslAuthenticateKey, saslAuthenticateVersion, 2, body.Bytes()); err != nil {
+		return err
+	}
+
+	res, err = readResponse(conn, 2)
+	if err != nil {
+		return err
+	}
+	r = bytes.NewReader(res)
+	if err = binary.Read(r, binary.BigEndian, &errorCode); err != nil {
+		return err
+	}
+	if errorCode != 0 {
+		message, _ := readString(r)
+		return &kafkaError{Code: errorCode, Message: message}
+	}
+
+	return nil
+}
+
+// writeRequest writes a size delimited request with a v1 request header
+func writeRequest(w io.Writer, apiKey int16, apiVersion int16, correlationID int32, body []byte) error {
+	var header bytes.Buffer
+	_ = binary.Write(&header, binary.BigEndian, apiKey)
+	_ = binary.Write(&header, binary.BigEndian, apiVersion)
+	_ = binary.Write(&header, binary.BigEndian, correlationID)
+	writeString(&header, clientID)
+
+	var req bytes.Buffer
+	_ = binary.Write(&req, binary.BigEndian, int32(header.Len()+len(body)))
+	req.Write(header.Bytes())
+	req.Write(body)
+
+	_, err := w.Write(req.Bytes())
+	return err
+}
+
+// readResponse reads a size delimited response and returns its body
+func readResponse(r io.Reader, correlationID int32) ([]byte, error) {
+	var size int32
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		return nil, err
+	}
+	if size < 4 {
+		return nil, fmt.Errorf("invalid response size %v", size)
+	}
+
+	res := make([]byte, size)
+	if _, err := io.ReadFull(r, res); err != nil {
+		return nil, err
+	}
+
+	if got := int32(binary.BigEndian.Uint32(res[:4])); got != correlationID {
+		return nil, fmt.Errorf("unexpected correlation ID %v, expected %v", got, correlationID)
+	}
+
+	return res[4:], nil
+}
+
+func writeString(w *bytes.Buffer, s string) {
+	_ = binary.Write(w, binary.BigEndian, int16(len(s)))
+	w.WriteString(s)
+}
+
+func writeBytes(w *bytes.Buffer, b []byte) {
+	_ = binary.Write(w, binary.BigEndian, int32(len(b)))
+	w.Write(b)
+}
+
+func readString(r io.Reader) (string, error) {
+	var length int16
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return "", err
+	}
+	if length < 0 {
+		return "", nil
+	}
+
+	b := make([]byte, length)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
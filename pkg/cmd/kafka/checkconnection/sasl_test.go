@@ -0,0 +1,89 @@
+package checkconnection
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"testing"
+)
+
+// fakeBroker answers the SASL handshake and authenticate requests on the given connection
+func fakeBroker(t *testing.T, conn net.Conn, handshakeError int16, authError int16) {
+	defer conn.Close()
+
+	for _, errorCode := range []int16{handshakeError, authError} {
+		var size int32
+		if err := binary.Read(conn, binary.BigEndian, &size); err != nil {
+			return
+		}
+		req := make([]byte, size)
+		if _, err := io.ReadFull(conn, req); err != nil {
+			t.Error(err)
+			return
+		}
+		correlationID := int32(binary.BigEndian.Uint32(req[4:8]))
+
+		var res bytes.Buffer
+		_ = binary.Write(&res, binary.BigEndian, correlationID)
+		_ = binary.Write(&res, binary.BigEndian, errorCode)
+		if correlationID == 1 {
+			_ = binary.Write(&res, binary.BigEndian, int32(1))
+			writeString(&res, "OAUTHBEARER")
+		} else {
+			writeString(&res, "Authentication failed")
+			writeBytes(&res, nil)
+		}
+
+		_ = binary.Write(conn, binary.BigEndian, int32(res.Len()))
+		_, _ = conn.Write(res.Bytes())
+
+		if errorCode != 0 {
+			return
+		}
+	}
+}
+
+func Test_authenticatePlain(t *testing.T) {
+	tests := []struct {
+		name           string
+		handshakeError int16
+		authError      int16
+		wantCode       int16
+	}{
+		{
+			name: "authenticated",
+		},
+		{
+			name:           "mechanism not supported",
+			handshakeError: 33,
+			wantCode:       33,
+		},
+		{
+			name:      "invalid credentials",
+			authError: 58,
+			wantCode:  58,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, server := net.Pipe()
+			defer client.Close()
+			go fakeBroker(t, server, tt.handshakeError, tt.authError)
+
+			err := authenticatePlain(client, "client-id", "client-secret")
+			if tt.wantCode == 0 {
+				if err != nil {
+					t.Errorf("authenticatePlain() unexpected error = %v", err)
+				}
+				return
+			}
+
+			var kafkaErr *kafkaError
+			if !errors.As(err, &kafkaErr) || kafkaErr.Code != tt.wantCode {
+				t.Errorf("authenticatePlain() error = %v, want error code %v", err, tt.wantCode)
+			}
+		})
+	}
+}
@@ -85,6 +85,7 @@ func NewDescribeConsumerGroupCommand(f *factory.Factory) *cobra.Command {
 	flags.AddOutput(&opts.outputFormat)
 	flags.StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("kafka.consumerGroup.common.flag.id.description", localize.NewEntry("Action", "view")))
 	_ = cmd.MarkFlagRequired("id")
+	flags.AddPrintSchema(kafkainstanceclient.ConsumerGroup{})
 
 	// flag based completions for ID
 	_ = cmd.RegisterFlagCompletionFunc("id", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -141,7 +142,15 @@ func runCmd(opts *options) error {
 
 	switch opts.outputFormat {
 	case dump.EmptyFormat:
-		printConsumerGroupDetails(stdout, &consumerGroupData, opts.localizer)
+		body, err := io.ReadAll(httpRes.Body)
+		if err != nil {
+			return err
+		}
+		members, err := mapConsumerGroupMembers(body)
+		if err != nil {
+			return err
+		}
+		printConsumerGroupDetails(stdout, &consumerGroupData, members, opts.localizer)
 	default:
 		return dump.Formatted(stdout, opts.outputFormat, &consumerGroupData)
 	}
@@ -179,7 +188,7 @@ func mapConsumerGroupDescribeToTableFormat(consumers []kafkainstanceclient.Consu
 }
 
 // print the consumer group details
-func printConsumerGroupDetails(w io.Writer, consumerGroupData *kafkainstanceclient.ConsumerGroup, localizer localize.Localizer) {
+func printConsumerGroupDetails(w io.Writer, consumerGroupData *kafkainstanceclient.ConsumerGroup, members []memberRow, localizer localize.Localizer) {
 	fmt.Fprintln(w, "")
 	consumers := consumerGroupData.GetConsumers()
 	metrics := consumerGroupData.GetMetrics()
@@ -194,4 +203,13 @@ func printConsumerGroupDetails(w io.Writer, consumerGroupData *kafkainstanceclie
 
 	rows := mapConsumerGroupDescribeToTableFormat(consumers)
 	dump.Table(w, rows)
+
+	if len(members) == 0 {
+		return
+	}
+
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, color.Bold(localizer.MustLocalize("kafka.consumerGroup.describe.output.members")))
+	fmt.Fprintln(w, "")
+	dump.Table(w, members)
 }
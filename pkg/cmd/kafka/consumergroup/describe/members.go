@@ -0,0 +1,84 @@
+package describe
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+)
+
+type memberRow struct {
+	MemberID   string `json:"memberId" header:"Consumer ID"`
+	ClientID   string `json:"clientId,omitempty" header:"Client ID"`
+	Host       string `json:"host,omitempty" header:"Host"`
+	Partitions string `json:"partitions" header:"Assigned partitions"`
+}
+
+// memberConsumer is a consumer of the group as returned by the Kafka Admin API.
+// Newer versions of the API include the client ID and host of the member,
+// which are not available in the generated client model.
+type memberConsumer struct {
+	Topic     string `json:"topic"`
+	Partition int32  `json:"partition"`
+	MemberID  string `json:"memberId"`
+	ClientID  string `json:"clientId"`
+	Host      string `json:"host"`
+}
+
+// mapConsumerGroupMembers groups the partition assignments of a consumer group response by member.
+// Unassigned partitions are not included.
+func mapConsumerGroupMembers(body []byte) ([]memberRow, error) {
+	var group struct {
+		Consumers []memberConsumer `json:"consumers"`
+	}
+	if err := json.Unmarshal(body, &group); err != nil {
+		return nil, err
+	}
+
+	members := map[string]*memberConsumer{}
+	assignments := map[string][]memberConsumer{}
+	for i, consumer := range group.Consumers {
+		if consumer.MemberID == "" {
+			continue
+		}
+		if _, ok := members[consumer.MemberID]; !ok {
+			members[consumer.MemberID] = &group.Consumers[i]
+		}
+		assignments[consumer.MemberID] = append(assignments[consumer.MemberID], consumer)
+	}
+
+	rows := make([]memberRow, 0, len(members))
+	for memberID, member := range members {
+		assigned := assignments[memberID]
+		sort.Slice(assigned, func(i, j int) bool {
+			if assigned[i].Topic != assigned[j].Topic {
+				return assigned[i].Topic < assigned[j].Topic
+			}
+			return assigned[i].Partition < assigned[j].Partition
+		})
+
+		partitions := make([]string, len(assigned))
+		for i, a := range assigned {
+			partitions[i] = fmt.Sprintf("%v/%v", a.Topic, a.Partition)
+		}
+
+		rows = append(rows, memberRow{
+			MemberID:   memberID,
+			ClientID:   dump.OrPlaceholder(member.ClientID),
+			Host:       dump.OrPlaceholder(member.Host),
+			Partitions: strings.Join(partitions, ", "),
+		})
+	}
+
+	// sort members by client ID so duplicated clients are listed together
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].ClientID != rows[j].ClientID {
+			return rows[i].ClientID < rows[j].ClientID
+		}
+		return rows[i].MemberID < rows[j].MemberID
+	})
+
+	return rows, nil
+}
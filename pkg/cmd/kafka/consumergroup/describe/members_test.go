@@ -0,0 +1,51 @@
+package describe
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMapConsumerGroupMembers(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want []memberRow
+	}{
+		{
+			name: "groups partitions by member",
+			body: `{"consumers":[
+				{"topic":"orders","partition":1,"memberId":"consumer-b","clientId":"billing","host":"/10.0.0.2"},
+				{"topic":"orders","partition":0,"memberId":"consumer-a","clientId":"shipping","host":"/10.0.0.1"},
+				{"topic":"orders","partition":2,"memberId":"consumer-b","clientId":"billing","host":"/10.0.0.2"},
+				{"topic":"orders","partition":3}
+			]}`,
+			want: []memberRow{
+				{MemberID: "consumer-b", ClientID: "billing", Host: "/10.0.0.2", Partitions: "orders/1, orders/2"},
+				{MemberID: "consumer-a", ClientID: "shipping", Host: "/10.0.0.1", Partitions: "orders/0"},
+			},
+		},
+		{
+			name: "client details not returned by the API",
+			body: `{"consumers":[{"topic":"orders","partition":0,"memberId":"consumer-a"}]}`,
+			want: []memberRow{
+				{MemberID: "consumer-a", ClientID: "-", Host: "-", Partitions: "orders/0"},
+			},
+		},
+		{
+			name: "no members",
+			body: `{"consumers":[]}`,
+			want: []memberRow{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mapConsumerGroupMembers([]byte(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mapConsumerGroupMembers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package list
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	kafkaflagutil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
@@ -37,13 +38,23 @@ type options struct {
 	search  string
 	page    int32
 	size    int32
+
+	inactiveOnly bool
 }
 
+// Values of the "Last consumed" column
+const (
+	consumedNothing  = "nothing"
+	consumedCaughtUp = "caught up"
+	consumedBehind   = "%d messages behind"
+)
+
 type consumerGroupRow struct {
 	ConsumerGroupID   string                                 `json:"groupId,omitempty" header:"Consumer group ID"`
 	ActiveMembers     int32                                  `json:"active_members,omitempty" header:"Active members"`
 	PartitionsWithLag int32                                  `json:"lag,omitempty" header:"Partitions with lag"`
 	State             kafkainstanceclient.ConsumerGroupState `json:"state,omitempty" header:"State"`
+	LastConsumed      string                                 `json:"last_consumed,omitempty" header:"Last consumed"`
 }
 
 // NewListConsumerGroupCommand creates a new command to list consumer groups
@@ -58,11 +69,12 @@ func NewListConsumerGroupCommand(f *factory.Factory) *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:     "list",
-		Short:   opts.localizer.MustLocalize("kafka.consumerGroup.list.cmd.shortDescription"),
-		Long:    opts.localizer.MustLocalize("kafka.consumerGroup.list.cmd.longDescription"),
-		Example: opts.localizer.MustLocalize("kafka.consumerGroup.list.cmd.example"),
-		Args:    cobra.NoArgs,
+		Use:         "list",
+		Short:       opts.localizer.MustLocalize("kafka.consumerGroup.list.cmd.shortDescription"),
+		Long:        opts.localizer.MustLocalize("kafka.consumerGroup.list.cmd.longDescription"),
+		Example:     opts.localizer.MustLocalize("kafka.consumerGroup.list.cmd.example"),
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{cmdutil.PagerAnnotation: "true"},
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if opts.output != "" && !flagutil.IsValidInput(opts.output, flagutil.ValidOutputFormats...) {
 				return flagutil.InvalidValueError("output", opts.output, flagutil.ValidOutputFormats...)
@@ -99,6 +111,8 @@ func NewListConsumerGroupCommand(f *factory.Factory) *cobra.Command {
 	flags.StringVar(&opts.search, "search", "", opts.localizer.MustLocalize("kafka.consumerGroup.list.flag.search"))
 	flags.Int32VarP(&opts.page, "page", "", cmdutil.ConvertPageValueToInt32(build.DefaultPageNumber), opts.localizer.MustLocalize("kafka.consumerGroup.list.flag.page"))
 	flags.Int32VarP(&opts.size, "size", "", cmdutil.ConvertSizeValueToInt32(build.DefaultPageSize), opts.localizer.MustLocalize("kafka.consumerGroup.list.flag.size"))
+	flags.BoolVar(&opts.inactiveOnly, "inactive-only", false, opts.localizer.MustLocalize("kafka.consumerGroup.list.flag.inactiveOnly"))
+	flags.AddPrintSchema(dump.NewList("ConsumerGroupList", 0, 0, 0, []kafkainstanceclient.ConsumerGroup{}))
 
 	_ = cmd.RegisterFlagCompletionFunc("topic", func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return kafkacmdutil.FilterValidTopicNameArgs(f, toComplete)
@@ -159,7 +173,13 @@ func runList(opts *options) (err error) {
 		}
 	}
 
-	if !checkForConsumerGroups(int(consumerGroupData.GetTotal()), opts, kafkaInstance.GetName()) {
+	count := int(consumerGroupData.GetTotal())
+	if opts.inactiveOnly {
+		consumerGroupData.SetItems(filterInactive(consumerGroupData.GetItems()))
+		count = len(consumerGroupData.GetItems())
+	}
+
+	if !checkForConsumerGroups(count, opts, kafkaInstance.GetName()) {
 		return nil
 	}
 
@@ -170,7 +190,7 @@ func runList(opts *options) (err error) {
 		rows := mapConsumerGroupResultsToTableFormat(consumerGroups)
 		dump.Table(opts.IO.Out, rows)
 	default:
-		return dump.Formatted(opts.IO.Out, opts.output, consumerGroupData)
+		return dump.Formatted(opts.IO.Out, opts.output, kafkacmdutil.NewListDocument(&consumerGroupData, "ConsumerGroupList", opts.page, opts.size, consumerGroupData.GetItems()))
 	}
 
 	return nil
@@ -186,6 +206,7 @@ func mapConsumerGroupResultsToTableFormat(consumerGroups []kafkainstanceclient.C
 			ActiveMembers:     metrics.GetActiveConsumers(),
 			PartitionsWithLag: metrics.GetLaggingPartitions(),
 			State:             t.GetState(),
+			LastConsumed:      lastConsumed(t.GetConsumers()),
 		}
 		rows[i] = row
 	}
@@ -193,6 +214,45 @@ func mapConsumerGroupResultsToTableFormat(consumerGroups []kafkainstanceclient.C
 	return rows
 }
 
+// filterInactive keeps the consumer groups which have no active members
+func filterInactive(consumerGroups []kafkainstanceclient.ConsumerGroup) []kafkainstanceclient.ConsumerGroup {
+	inactive := []kafkainstanceclient.ConsumerGroup{}
+	for _, group := range consumerGroups {
+		metrics := group.GetMetrics()
+		if metrics.GetActiveConsumers() == 0 {
+			inactive = append(inactive, group)
+		}
+	}
+	return inactive
+}
+
+// lastConsumed estimates how far a group has consumed by comparing its committed offsets with the log end offsets.
+// A group which is caught up on every partition consumed the last messages produced,
+// while a group which is behind stopped consuming before them.
+func lastConsumed(consumers []kafkainstanceclient.Consumer) string {
+	var committed bool
+	var behind int64
+	for _, consumer := range consumers {
+		if consumer.GetOffset() < 0 {
+			continue
+		}
+		committed = true
+
+		if logEnd, ok := consumer.GetLogEndOffsetOk(); ok && *logEnd > consumer.GetOffset() {
+			behind += *logEnd - consumer.GetOffset()
+		}
+	}
+
+	switch {
+	case !committed:
+		return consumedNothing
+	case behind == 0:
+		return consumedCaughtUp
+	default:
+		return fmt.Sprintf(consumedBehind, behind)
+	}
+}
+
 // checks if there are any consumer groups available
 // prints to stderr if not
 func checkForConsumerGroups(count int, opts *options, kafkaName string) (hasCount bool) {
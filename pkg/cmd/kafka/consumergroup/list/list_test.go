@@ -0,0 +1,33 @@
+package list
+
+import (
+	"testing"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
+)
+
+func Test_lastConsumed(t *testing.T) {
+	consumer := func(offset int64, logEnd int64) kafkainstanceclient.Consumer {
+		c := kafkainstanceclient.Consumer{Offset: offset}
+		c.SetLogEndOffset(logEnd)
+		return c
+	}
+
+	tests := []struct {
+		name      string
+		consumers []kafkainstanceclient.Consumer
+		want      string
+	}{
+		{name: "no consumers", consumers: nil, want: consumedNothing},
+		{name: "no committed offsets", consumers: []kafkainstanceclient.Consumer{consumer(-1, 10)}, want: consumedNothing},
+		{name: "caught up", consumers: []kafkainstanceclient.Consumer{consumer(10, 10), consumer(5, 5)}, want: consumedCaughtUp},
+		{name: "behind", consumers: []kafkainstanceclient.Consumer{consumer(8, 10), consumer(2, 5), consumer(-1, 7)}, want: "5 messages behind"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := lastConsumed(tt.consumers); got != tt.want {
+				t.Errorf("lastConsumed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/httputil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/color"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
@@ -24,6 +25,7 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/hooks"
 
 	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
 	kafkamgmtv1errors "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/error"
@@ -400,7 +402,7 @@ func runCreate(opts *options) error {
 		for svcstatus.IsInstanceCreating(response.GetStatus()) {
 			time.Sleep(cmdutil.DefaultPollTime)
 
-			response, httpRes, err = api.KafkaMgmt().GetKafkaById(f.Context, response.GetId()).Execute()
+			response, httpRes, err = api.KafkaMgmt().GetKafkaById(httputil.WithoutCache(f.Context), response.GetId()).Execute()
 			if err != nil {
 				return err
 			}
@@ -409,7 +411,7 @@ func runCreate(opts *options) error {
 
 			s.SetLocalizedSuffix("kafka.create.log.info.creationInProgress",
 				localize.NewEntry("Name", response.GetName()),
-				localize.NewEntry("Status", color.Info(response.GetStatus())),
+				localize.NewEntry("Status", color.Status(response.GetStatus())),
 			)
 
 		}
@@ -427,6 +429,13 @@ func runCreate(opts *options) error {
 		f.Logger.Info(f.Localizer.MustLocalize("kafka.create.info.successAsync", nameTemplateEntry))
 	}
 
+	hooks.NewRunner(f).Run(hooks.PostCreate, &hooks.Resource{
+		Type: hooks.KafkaResource,
+		ID:   response.GetId(),
+		Name: response.GetName(),
+		Data: response,
+	})
+
 	return nil
 }
 
@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	kafkaflagutil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/confirm"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
 	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
 
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
@@ -13,10 +15,11 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/hooks"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/kafkautil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/servicespec"
 	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
 
-	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
 )
 
@@ -24,24 +27,29 @@ type options struct {
 	id          string
 	name        string
 	skipConfirm bool
+	force       bool
 
 	IO             *iostreams.IOStreams
+	Config         config.IConfig
 	Connection     factory.ConnectionFunc
 	Logger         logging.Logger
 	localizer      localize.Localizer
 	Context        context.Context
 	ServiceContext servicecontext.IContext
+	hooks          *hooks.Runner
 }
 
 // NewDeleteCommand command for deleting kafkas.
 func NewDeleteCommand(f *factory.Factory) *cobra.Command {
 	opts := &options{
 		Connection:     f.Connection,
+		Config:         f.Config,
 		Logger:         f.Logger,
 		IO:             f.IOStreams,
 		localizer:      f.Localizer,
 		Context:        f.Context,
 		ServiceContext: f.ServiceContext,
+		hooks:          hooks.NewRunner(f),
 	}
 
 	cmd := &cobra.Command{
@@ -51,8 +59,8 @@ func NewDeleteCommand(f *factory.Factory) *cobra.Command {
 		Example: opts.localizer.MustLocalize("kafka.delete.cmd.example"),
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if !opts.IO.CanPrompt() && !opts.skipConfirm {
-				return flagutil.RequiredWhenNonInteractiveError("yes")
+			if err := confirm.ValidateNonInteractive(opts.IO, opts.localizer, opts.skipConfirm); err != nil {
+				return err
 			}
 
 			if opts.name != "" && opts.id != "" {
@@ -79,6 +87,7 @@ func NewDeleteCommand(f *factory.Factory) *cobra.Command {
 	flags.AddYes(&opts.skipConfirm)
 	flags.StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("kafka.delete.flag.id"))
 	flags.StringVar(&opts.name, "name", "", opts.localizer.MustLocalize("kafka.delete.flag.name"))
+	flags.BoolVar(&opts.force, "force", false, opts.localizer.MustLocalize("kafka.delete.flag.force"))
 
 	if err := kafkaflagutil.RegisterNameFlagCompletionFunc(cmd, f); err != nil {
 		opts.Logger.Debug(opts.localizer.MustLocalize("kafka.common.error.load.completions.name.flag"), err)
@@ -120,20 +129,22 @@ func runDelete(opts *options) error {
 
 	kafkaName := response.GetName()
 
-	if !opts.skipConfirm {
-		promptConfirmName := &survey.Input{
-			Message: opts.localizer.MustLocalize("kafka.delete.input.confirmName.message", localize.NewEntry("Name", kafkaName)),
-		}
+	cfg, err := opts.Config.Load()
+	if err != nil {
+		return err
+	}
 
-		var confirmedKafkaName string
-		err = survey.AskOne(promptConfirmName, &confirmedKafkaName)
-		if err != nil {
-			return err
+	if cfg.IsProtected(servicespec.KafkaServiceName, response.GetId()) {
+		if !opts.force {
+			return opts.localizer.MustLocalizeError("kafka.delete.error.protected", localize.NewEntry("Name", kafkaName))
 		}
+		opts.Logger.Info(opts.localizer.MustLocalize("kafka.delete.log.info.deletingProtected", localize.NewEntry("Name", kafkaName)))
+	}
 
-		if confirmedKafkaName != kafkaName {
-			opts.Logger.Info(opts.localizer.MustLocalize("kafka.delete.log.info.incorrectNameConfirmation"))
-			return nil
+	if !opts.skipConfirm {
+		message := opts.localizer.MustLocalize("kafka.delete.input.confirmName.message", localize.NewEntry("Name", kafkaName))
+		if err = confirm.Name(opts.localizer, message, kafkaName); err != nil {
+			return err
 		}
 	}
 
@@ -149,6 +160,19 @@ func runDelete(opts *options) error {
 
 	opts.Logger.Info(opts.localizer.MustLocalize("kafka.delete.log.info.deleting", localize.NewEntry("Name", kafkaName)))
 
+	if cfg.Unprotect(servicespec.KafkaServiceName, response.GetId()) {
+		if err = opts.Config.Save(cfg); err != nil {
+			return err
+		}
+	}
+
+	opts.hooks.Run(hooks.PostDelete, &hooks.Resource{
+		Type: hooks.KafkaResource,
+		ID:   response.GetId(),
+		Name: kafkaName,
+		Data: response,
+	})
+
 	currentKafka := currCtx.KafkaID
 	// this is not the current instance, our work here is done
 	if currentKafka != response.GetId() {
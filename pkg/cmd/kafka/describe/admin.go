@@ -0,0 +1,33 @@
+package describe
+
+import (
+	"fmt"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/kafkacmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+)
+
+// runAdminDescribe describes a Kafka instance of any organization using the fleet manager admin API
+func runAdminDescribe(opts *options) error {
+	conn, err := opts.Connection()
+	if err != nil {
+		return err
+	}
+
+	kafkaInstance, httpRes, err := conn.API().FleetAdmin().KafkaAPI().GetKafkaByID(opts.Context, opts.id)
+	if err != nil {
+		return kafkacmdutil.AdminAPIError(opts.localizer, httpRes, err)
+	}
+
+	if opts.bootstrapServer {
+		if kafkaInstance.BootstrapServerHost != "" {
+			fmt.Fprintln(opts.IO.Out, kafkaInstance.BootstrapServerHost)
+			return nil
+		}
+		opts.Logger.Info(opts.localizer.MustLocalize("kafka.describe.bootstrapserver.not.available", localize.NewEntry("Name", kafkaInstance.Name)))
+		return nil
+	}
+
+	return dump.Formatted(opts.IO.Out, opts.outputFormat, kafkaInstance)
+}
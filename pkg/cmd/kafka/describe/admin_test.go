@@ -0,0 +1,85 @@
+package describe
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/fleetadmin"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory/fakes"
+)
+
+func newAdminServer(t *testing.T, f *fakes.Factory, kafka fleetadmin.Kafka, status int) {
+	mux := http.NewServeMux()
+	f.WithServer(t, mux)
+
+	mux.HandleFunc("/api/kafkas_mgmt/v1/admin/kafkas/"+kafka.ID, func(w http.ResponseWriter, r *http.Request) {
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(kafka)
+	})
+}
+
+func TestDescribeCommand_admin(t *testing.T) {
+	kafka := fleetadmin.Kafka{ID: "1", Name: "orders", OrganisationID: "org-1", Status: "ready", BootstrapServerHost: "orders.kafka:443"}
+
+	tests := []struct {
+		name    string
+		args    []string
+		status  int
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "describe",
+			args:   []string{"--admin", "--id", "1", "-o", "json"},
+			status: http.StatusOK,
+			want:   `"organisation_id": "org-1"`,
+		},
+		{
+			name:   "bootstrap server",
+			args:   []string{"--admin", "--id", "1", "--bootstrap-server"},
+			status: http.StatusOK,
+			want:   "orders.kafka:443\n",
+		},
+		{
+			name:    "name is rejected",
+			args:    []string{"--admin", "--name", "orders"},
+			status:  http.StatusOK,
+			wantErr: "the --admin flag can not be used with --name",
+		},
+		{
+			name:    "admin role required",
+			args:    []string{"--admin", "--id", "1"},
+			status:  http.StatusUnauthorized,
+			wantErr: "the --admin flag requires a token with the fleet manager admin role",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := fakes.NewFactory(t)
+			newAdminServer(t, f, kafka, tt.status)
+
+			cmd := NewDescribeCommand(f.Factory)
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Execute() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if got := f.Out.String(); !strings.Contains(got, tt.want) {
+				t.Errorf("output = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
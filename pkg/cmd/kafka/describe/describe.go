@@ -2,10 +2,14 @@ package describe
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/redhat-developer/app-services-cli/pkg/api/fleetadmin"
 	kafkaflagutil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/kafkacmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
@@ -24,6 +28,8 @@ type options struct {
 	name            string
 	bootstrapServer bool
 	outputFormat    string
+	admin           bool
+	credentialsHint bool
 
 	IO             *iostreams.IOStreams
 	Connection     factory.ConnectionFunc
@@ -61,8 +67,12 @@ func NewDescribeCommand(f *factory.Factory) *cobra.Command {
 				return opts.localizer.MustLocalizeError("service.error.idAndNameCannotBeUsed")
 			}
 
+			if opts.admin && opts.name != "" {
+				return opts.localizer.MustLocalizeError("kafka.describe.error.adminRequiresID")
+			}
+
 			if opts.id != "" || opts.name != "" {
-				return runDescribe(opts)
+				return describe(opts)
 			}
 
 			kafkaInstance, err := contextutil.GetCurrentKafkaInstance(f)
@@ -72,7 +82,7 @@ func NewDescribeCommand(f *factory.Factory) *cobra.Command {
 
 			opts.id = kafkaInstance.GetId()
 
-			return runDescribe(opts)
+			return describe(opts)
 		},
 	}
 
@@ -82,6 +92,14 @@ func NewDescribeCommand(f *factory.Factory) *cobra.Command {
 	flags.StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("kafka.describe.flag.id"))
 	flags.StringVar(&opts.name, "name", "", opts.localizer.MustLocalize("kafka.describe.flag.name"))
 	flags.BoolVar(&opts.bootstrapServer, "bootstrap-server", false, opts.localizer.MustLocalize("kafka.describe.flag.bootstrapserver"))
+	flags.BoolVar(&opts.admin, "admin", false, opts.localizer.MustLocalize("kafka.describe.flag.admin"))
+	flags.BoolVar(&opts.credentialsHint, "credentials-hint", false, opts.localizer.MustLocalize("kafka.describe.flag.credentialsHint"))
+	flags.AddPrintSchemaFunc(func() interface{} {
+		if opts.admin {
+			return fleetadmin.Kafka{}
+		}
+		return kafkaDescription{}
+	})
 
 	if err := kafkaflagutil.RegisterNameFlagCompletionFunc(cmd, f); err != nil {
 		opts.Logger.Debug(opts.localizer.MustLocalize("kafka.common.error.load.completions.name.flag"), err)
@@ -91,6 +109,13 @@ func NewDescribeCommand(f *factory.Factory) *cobra.Command {
 	return cmd
 }
 
+func describe(opts *options) error {
+	if opts.admin {
+		return runAdminDescribe(opts)
+	}
+	return runDescribe(opts)
+}
+
 func runDescribe(opts *options) error {
 	conn, err := opts.Connection()
 	if err != nil {
@@ -128,5 +153,51 @@ func runDescribe(opts *options) error {
 		return nil
 	}
 
-	return dump.Formatted(opts.IO.Out, opts.outputFormat, kafkaInstance)
+	if msg := kafkacmdutil.SuspendedMessage(opts.localizer, kafkaInstance, time.Now()); msg != "" {
+		opts.Logger.Info(msg)
+	}
+
+	description := &kafkaDescription{KafkaRequest: *kafkaInstance}
+
+	limits, err := kafkautil.GetKafkaInstanceLimits(opts.Context, api.KafkaMgmt(), kafkaInstance)
+	if err != nil {
+		opts.Logger.Debug("Could not fetch the limits of the Kafka instance size:", err)
+	}
+	description.Limits = limits
+
+	if opts.credentialsHint {
+		description.CredentialsHint = kafkautil.NewCredentialsHint(api.GetConfig().AuthURL)
+	}
+
+	return dump.Formatted(opts.IO.Out, opts.outputFormat, description)
+}
+
+// kafkaDescription is the Kafka instance enriched with the limits of its size
+// and, when requested, the information needed to authenticate clients
+type kafkaDescription struct {
+	kafkamgmtclient.KafkaRequest `yaml:",inline"`
+	Limits                       *kafkautil.InstanceLimits  `json:"limits,omitempty" yaml:"limits,omitempty"`
+	CredentialsHint              *kafkautil.CredentialsHint `json:"credentials_hint,omitempty" yaml:"credentials_hint,omitempty"`
+}
+
+// MarshalJSON keeps the JSON representation of the Kafka instance
+// and adds the instance limits and the credentials hint to it
+func (d kafkaDescription) MarshalJSON() ([]byte, error) {
+	data, err := d.KafkaRequest.MarshalJSON()
+	if err != nil || (d.Limits == nil && d.CredentialsHint == nil) {
+		return data, err
+	}
+
+	fields := map[string]interface{}{}
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	if d.Limits != nil {
+		fields["limits"] = d.Limits
+	}
+	if d.CredentialsHint != nil {
+		fields["credentials_hint"] = d.CredentialsHint
+	}
+
+	return json.Marshal(fields)
 }
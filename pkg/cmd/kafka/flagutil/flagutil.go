@@ -4,6 +4,7 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/searchutil"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
@@ -50,8 +51,7 @@ func RegisterNameFlagCompletionFunc(cmd *cobra.Command, f *factory.Factory) erro
 
 		req := conn.API().KafkaMgmt().GetKafkas(f.Context)
 		if toComplete != "" {
-			searchQ := "name like " + toComplete + "%"
-			req = req.Search(searchQ)
+			req = req.Search(searchutil.HasPrefix("name", toComplete))
 		}
 		kafkas, httpRes, err := req.Execute()
 		if err != nil {
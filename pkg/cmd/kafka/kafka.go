@@ -5,16 +5,22 @@ package kafka
 import (
 	"github.com/redhat-developer/app-services-cli/internal/doc"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/adminurl"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/billing"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/checkconnection"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/consumergroup"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/create"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/delete"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/describe"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/list"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/metrics"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/protect"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/providers"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/sizeestimator"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/update"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/use"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/waitfor"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
 	"github.com/spf13/cobra"
 )
@@ -42,6 +48,12 @@ func NewKafkaCommand(f *factory.Factory) *cobra.Command {
 		acl.NewAclCommand(f),
 		billing.NewBillingCommand(f),
 		providers.NewProviderCommand(f),
+		waitfor.NewWaitForCommand(f),
+		checkconnection.NewCheckConnectionCommand(f),
+		metrics.NewMetricsCommand(f),
+		protect.NewProtectCommand(f),
+		adminurl.NewAdminURLCommand(f),
+		sizeestimator.NewSizeEstimatorCommand(f),
 	)
 
 	return cmd
@@ -0,0 +1,22 @@
+package kafkacmdutil
+
+import (
+	"net/http"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+)
+
+// AdminAPIError returns the error to display when a request to the fleet manager admin API failed.
+// Requests rejected by the API are reported as missing the admin role, as regular tokens can not use it.
+func AdminAPIError(localizer localize.Localizer, httpRes *http.Response, err error) error {
+	if httpRes == nil {
+		return err
+	}
+
+	switch httpRes.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return localizer.MustLocalizeError("kafka.common.error.adminRoleRequired")
+	default:
+		return err
+	}
+}
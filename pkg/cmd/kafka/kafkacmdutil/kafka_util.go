@@ -6,11 +6,13 @@ import (
 	"net/http"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/redhat-developer/app-services-cli/pkg/core/errors"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/kafkautil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/svcstatus"
 	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
 )
 
@@ -75,6 +77,29 @@ func TransformKafkaRequest(kafka *kafkamgmtclient.KafkaRequest) *kafkamgmtclient
 	return kafka
 }
 
+// SuspendedMessage returns a message explaining why the Kafka instance is suspended,
+// or an empty string if the instance is not suspended.
+// The Kafka management API has no endpoint to resume an instance, so the message does not suggest a command.
+func SuspendedMessage(localizer localize.Localizer, kafka *kafkamgmtclient.KafkaRequest, now time.Time) string {
+	status := kafka.GetStatus()
+	if !svcstatus.IsInstanceSuspended(status) {
+		return ""
+	}
+
+	nameEntry := localize.NewEntry("Name", kafka.GetName())
+	statusEntry := localize.NewEntry("Status", status)
+
+	if expiresAt, ok := kafka.GetExpiresAtOk(); ok && expiresAt != nil && !expiresAt.After(now) {
+		return localizer.MustLocalize("kafka.common.log.info.suspended.expired", nameEntry, statusEntry, localize.NewEntry("ExpiresAt", expiresAt.Format(time.RFC3339)))
+	}
+
+	if reason := kafka.GetFailedReason(); reason != "" {
+		return localizer.MustLocalize("kafka.common.log.info.suspended.reason", nameEntry, statusEntry, localize.NewEntry("Reason", reason))
+	}
+
+	return localizer.MustLocalize("kafka.common.log.info.suspended.noReason", nameEntry, statusEntry)
+}
+
 // ValidateSearchInput validates the text provided to filter the Kafka instances
 func (v *Validator) ValidateSearchInput(val interface{}) error {
 	search, ok := val.(string)
@@ -2,6 +2,7 @@ package kafkacmdutil
 
 import (
 	"testing"
+	"time"
 
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize/goi18n"
 
@@ -166,6 +167,56 @@ func TestTransformRequest(t *testing.T) {
 	}
 }
 
+func TestSuspendedMessage(t *testing.T) {
+	now := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	name := "my-kafka"
+	ready := "ready"
+	suspended := "suspended"
+	reason := "terms not accepted"
+
+	expired := kafkamgmtclient.KafkaRequest{Name: &name, Status: &suspended}
+	expired.SetExpiresAt(now.Add(-time.Hour))
+
+	notExpired := kafkamgmtclient.KafkaRequest{Name: &name, Status: &suspended}
+	notExpired.SetExpiresAt(now.Add(time.Hour))
+
+	tests := []struct {
+		name  string
+		kafka kafkamgmtclient.KafkaRequest
+		want  string
+	}{
+		{
+			name:  "should be empty when instance is not suspended",
+			kafka: kafkamgmtclient.KafkaRequest{Name: &name, Status: &ready},
+			want:  "",
+		},
+		{
+			name:  "should show expiry when suspended instance has expired",
+			kafka: expired,
+			want:  `Kafka instance "my-kafka" is suspended because it expired on 2022-06-01T11:00:00Z. Clients cannot connect to the instance while it is suspended.`,
+		},
+		{
+			name:  "should show reason when suspended instance has a reason",
+			kafka: kafkamgmtclient.KafkaRequest{Name: &name, Status: &suspended, FailedReason: &reason},
+			want:  `Kafka instance "my-kafka" is suspended: terms not accepted. Clients cannot connect to the instance while it is suspended.`,
+		},
+		{
+			name:  "should show generic message when suspended instance has not expired",
+			kafka: notExpired,
+			want:  `Kafka instance "my-kafka" is suspended. Clients cannot connect to the instance while it is suspended.`,
+		},
+	}
+	for _, tt := range tests {
+		// nolint
+		t.Run(tt.name, func(t *testing.T) {
+			got := SuspendedMessage(validator.Localizer, &tt.kafka, now)
+			if got != tt.want {
+				t.Errorf("SuspendedMessage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestTransformKafkaRequestListItems(t *testing.T) {
 	hostWithSSLPort := "my-kafka-url:443"
 	hostWithNoPort := "my-kafka-url"
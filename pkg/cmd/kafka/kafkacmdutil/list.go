@@ -0,0 +1,27 @@
+package kafkacmdutil
+
+import "github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+
+// pagedList is implemented by the list models of the Kafka instance API
+type pagedList interface {
+	GetKindOk() (*string, bool)
+	GetPageOk() (*int32, bool)
+	GetSizeOk() (*int32, bool)
+	GetTotal() int32
+}
+
+// NewListDocument wraps a page returned by the Kafka instance API in a dump.List.
+// The Kafka instance API may leave out the kind, page and size of a list,
+// so the given kind and the requested page and size are used in their place.
+func NewListDocument(list pagedList, kind string, page int32, size int32, items interface{}) *dump.List {
+	if v, ok := list.GetKindOk(); ok && *v != "" {
+		kind = *v
+	}
+	if v, ok := list.GetPageOk(); ok {
+		page = *v
+	}
+	if v, ok := list.GetSizeOk(); ok {
+		size = *v
+	}
+	return dump.NewList(kind, page, size, list.GetTotal(), items)
+}
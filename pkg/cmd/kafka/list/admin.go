@@ -0,0 +1,120 @@
+package list
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/fleetadmin"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/kafkacmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+)
+
+// kafkaAdminRow is the details of a Kafka instance of any organization printed with the --admin flag
+type kafkaAdminRow struct {
+	ID             string `header:"ID"`
+	Name           string `header:"Name"`
+	Owner          string `header:"Owner"`
+	OrganisationID string `header:"Org ID"`
+	Status         string `header:"Status"`
+	CloudProvider  string `header:"Cloud Provider"`
+	Region         string `header:"Region"`
+	Reason         string `header:"Reason"`
+}
+
+// runAdminList lists the Kafka instances of all organizations using the fleet manager admin API
+func runAdminList(opts *options) error {
+	conn, err := opts.Connection()
+	if err != nil {
+		return err
+	}
+
+	queryParams := []fleetadmin.QueryParam{
+		fleetadmin.WithQueryParam("page", strconv.Itoa(opts.page)),
+		fleetadmin.WithQueryParam("size", strconv.Itoa(opts.limit)),
+	}
+	if opts.search != "" {
+		query := buildAdminQuery(opts.search)
+		opts.Logger.Debug(opts.localizer.MustLocalize("kafka.list.log.debug.filteringKafkaList", localize.NewEntry("Search", query)))
+		queryParams = append(queryParams, fleetadmin.WithQueryParam("search", query))
+	}
+
+	response, httpRes, err := conn.API().FleetAdmin().KafkaAPI().GetKafkas(opts.Context, queryParams...)
+	if err != nil {
+		return kafkacmdutil.AdminAPIError(opts.localizer, httpRes, err)
+	}
+
+	if len(response.Items) == 0 && (opts.outputFormat == dump.EmptyFormat || opts.outputFormat == wideFormat) {
+		opts.Logger.Info(opts.localizer.MustLocalize("kafka.common.log.info.noKafkaInstances"))
+		return nil
+	}
+
+	switch {
+	case opts.groupBy == groupByCluster && (opts.outputFormat == dump.EmptyFormat || opts.outputFormat == wideFormat):
+		for _, group := range groupByClusterID(response.Items) {
+			fmt.Fprintln(opts.IO.Out, opts.localizer.MustLocalizePlural("kafka.list.log.info.clusterSection", len(group.kafkas),
+				localize.NewEntry("ClusterID", dump.OrPlaceholder(group.clusterID)),
+				localize.NewEntry("Count", len(group.kafkas)),
+			))
+			dump.Table(opts.IO.Out, mapAdminItemsToRows(group.kafkas))
+			fmt.Fprintln(opts.IO.Out)
+		}
+	case opts.outputFormat == dump.EmptyFormat || opts.outputFormat == wideFormat:
+		dump.Table(opts.IO.Out, mapAdminItemsToRows(response.Items))
+	default:
+		return dump.Formatted(opts.IO.Out, opts.outputFormat, response)
+	}
+	return nil
+}
+
+// clusterGroup is the Kafka instances placed on one data plane cluster
+type clusterGroup struct {
+	clusterID string
+	kafkas    []fleetadmin.Kafka
+}
+
+// groupByClusterID groups the Kafka instances by cluster, sorted by cluster ID.
+// The instances which are not placed on a cluster yet come last.
+func groupByClusterID(kafkas []fleetadmin.Kafka) []clusterGroup {
+	var groups []clusterGroup
+	index := map[string]int{}
+	for _, k := range kafkas {
+		i, ok := index[k.ClusterID]
+		if !ok {
+			i = len(groups)
+			index[k.ClusterID] = i
+			groups = append(groups, clusterGroup{clusterID: k.ClusterID})
+		}
+		groups[i].kafkas = append(groups[i].kafkas, k)
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		a, b := groups[i].clusterID, groups[j].clusterID
+		if a == "" || b == "" {
+			return b == "" && a != ""
+		}
+		return a < b
+	})
+
+	return groups
+}
+
+func mapAdminItemsToRows(kafkas []fleetadmin.Kafka) []kafkaAdminRow {
+	rows := make([]kafkaAdminRow, len(kafkas))
+
+	for i, k := range kafkas {
+		rows[i] = kafkaAdminRow{
+			ID:             dump.OrPlaceholder(k.ID),
+			Name:           dump.OrPlaceholder(k.Name),
+			Owner:          dump.OrPlaceholder(k.Owner),
+			OrganisationID: dump.OrPlaceholder(k.OrganisationID),
+			Status:         dump.OrPlaceholder(k.Status),
+			CloudProvider:  dump.OrPlaceholder(k.CloudProvider),
+			Region:         dump.OrPlaceholder(k.Region),
+			Reason:         dump.OrPlaceholder(k.FailedReason),
+		}
+	}
+
+	return rows
+}
@@ -0,0 +1,147 @@
+package list
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/fleetadmin"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory/fakes"
+)
+
+func Test_groupByClusterID(t *testing.T) {
+	kafkas := []fleetadmin.Kafka{
+		{ID: "1", ClusterID: "cluster-b"},
+		{ID: "2"},
+		{ID: "3", ClusterID: "cluster-a"},
+		{ID: "4", ClusterID: "cluster-b"},
+	}
+
+	got := map[string][]string{}
+	var order []string
+	for _, group := range groupByClusterID(kafkas) {
+		order = append(order, group.clusterID)
+		for _, k := range group.kafkas {
+			got[group.clusterID] = append(got[group.clusterID], k.ID)
+		}
+	}
+
+	wantOrder := []string{"cluster-a", "cluster-b", ""}
+	if !reflect.DeepEqual(order, wantOrder) {
+		t.Errorf("groupByClusterID() order = %q, want %q", order, wantOrder)
+	}
+	want := map[string][]string{"cluster-a": {"3"}, "cluster-b": {"1", "4"}, "": {"2"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("groupByClusterID() = %v, want %v", got, want)
+	}
+}
+
+func Test_mapAdminItemsToRows(t *testing.T) {
+	kafkas := []fleetadmin.Kafka{
+		{
+			ID: "1", Name: "orders", Owner: "alice", OrganisationID: "org-1", Status: "suspended",
+			CloudProvider: "aws", Region: "us-east-1", FailedReason: "expired",
+		},
+		{ID: "2", Name: "payments", OrganisationID: "org-2", Status: "ready"},
+	}
+
+	want := []kafkaAdminRow{
+		{
+			ID: "1", Name: "orders", Owner: "alice", OrganisationID: "org-1", Status: "suspended",
+			CloudProvider: "aws", Region: "us-east-1", Reason: "expired",
+		},
+		{ID: "2", Name: "payments", Owner: "-", OrganisationID: "org-2", Status: "ready", CloudProvider: "-", Region: "-", Reason: "-"},
+	}
+	if got := mapAdminItemsToRows(kafkas); !reflect.DeepEqual(got, want) {
+		t.Errorf("mapAdminItemsToRows() = %+v, want %+v", got, want)
+	}
+}
+
+func TestListCommand_admin(t *testing.T) {
+	f := fakes.NewFactory(t)
+
+	mux := http.NewServeMux()
+	f.WithServer(t, mux)
+
+	var query string
+	mux.HandleFunc("/api/kafkas_mgmt/v1/admin/kafkas", func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query().Get("search")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(fleetadmin.KafkaList{
+			Kind: "KafkaList", Page: 1, Size: 1, Total: 1,
+			Items: []fleetadmin.Kafka{{ID: "1", Name: "orders", OrganisationID: "org-1", Status: "ready"}},
+		})
+	})
+
+	cmd := NewListCommand(f.Factory)
+	cmd.SetArgs([]string{"--admin", "--search", "org-1", "-o", "json"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	if !strings.Contains(query, "organisation_id like '%org-1%'") {
+		t.Errorf("search = %q, want a filter on the organization ID", query)
+	}
+
+	var got fleetadmin.KafkaList
+	if err := json.Unmarshal(f.Out.Bytes(), &got); err != nil {
+		t.Fatalf("invalid output %q: %v", f.Out.String(), err)
+	}
+	if len(got.Items) != 1 || got.Items[0].OrganisationID != "org-1" {
+		t.Errorf("output = %+v, want the instances returned by the admin API", got)
+	}
+}
+
+func TestListCommand_adminRoleRequired(t *testing.T) {
+	f := fakes.NewFactory(t)
+
+	mux := http.NewServeMux()
+	f.WithServer(t, mux)
+
+	mux.HandleFunc("/api/kafkas_mgmt/v1/admin/kafkas", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	cmd := NewListCommand(f.Factory)
+	cmd.SetArgs([]string{"--admin"})
+	err := cmd.Execute()
+
+	want := f.Localizer.MustLocalize("kafka.common.error.adminRoleRequired")
+	if err == nil || err.Error() != want {
+		t.Errorf("Execute() error = %v, want %v", err, want)
+	}
+}
+
+func TestListCommand_groupByValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "group by without admin",
+			args:    []string{"--group-by", "cluster"},
+			wantErr: "the --group-by flag can only be used with the --admin flag",
+		},
+		{
+			name:    "invalid group by",
+			args:    []string{"--admin", "--group-by", "region"},
+			wantErr: `invalid value "region" for --group-by`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := fakes.NewFactory(t)
+
+			cmd := NewListCommand(f.Factory)
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Execute() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/redhat-developer/app-services-cli/pkg/api/fleetadmin"
 	kafkaFlagutil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/kafkacmdutil"
 
@@ -18,6 +20,7 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/searchutil"
 
 	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
 
@@ -36,11 +39,37 @@ type kafkaRow struct {
 	Region        string `json:"region" header:"Region"`
 }
 
+// kafkaWideRow is the details of a Kafka instance printed with the wide output format
+type kafkaWideRow struct {
+	ID               string `header:"ID"`
+	Name             string `header:"Name"`
+	Owner            string `header:"Owner"`
+	Status           string `header:"Status"`
+	CloudProvider    string `header:"Cloud Provider"`
+	Region           string `header:"Region"`
+	InstanceType     string `header:"Instance Type"`
+	Version          string `header:"Version"`
+	Reauthentication string `header:"Reauthentication"`
+	BootstrapServer  string `header:"Bootstrap Server"`
+}
+
+// wideFormat prints the table with the connection details of each instance
+const wideFormat = "wide"
+
+var validOutputFormats = append(append([]string{}, flagutil.ValidOutputFormats...), wideFormat)
+
+// groupByCluster prints one table per data plane cluster
+const groupByCluster = "cluster"
+
+var validGroupBy = []string{groupByCluster}
+
 type options struct {
 	outputFormat string
 	page         int
 	limit        int
 	search       string
+	admin        bool
+	groupBy      string
 
 	IO             *iostreams.IOStreams
 	Connection     factory.ConnectionFunc
@@ -65,14 +94,15 @@ func NewListCommand(f *factory.Factory) *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:     "list",
-		Short:   opts.localizer.MustLocalize("kafka.list.cmd.shortDescription"),
-		Long:    opts.localizer.MustLocalize("kafka.list.cmd.longDescription"),
-		Example: opts.localizer.MustLocalize("kafka.list.cmd.example"),
-		Args:    cobra.NoArgs,
+		Use:         "list",
+		Short:       opts.localizer.MustLocalize("kafka.list.cmd.shortDescription"),
+		Long:        opts.localizer.MustLocalize("kafka.list.cmd.longDescription"),
+		Example:     opts.localizer.MustLocalize("kafka.list.cmd.example"),
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{cmdutil.PagerAnnotation: "true"},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
-				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, validOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, validOutputFormats...)
 			}
 
 			validator := &kafkacmdutil.Validator{
@@ -83,16 +113,39 @@ func NewListCommand(f *factory.Factory) *cobra.Command {
 				return err
 			}
 
+			if opts.groupBy != "" {
+				if !flagutil.IsValidInput(opts.groupBy, validGroupBy...) {
+					return flagutil.InvalidValueError("group-by", opts.groupBy, validGroupBy...)
+				}
+				if !opts.admin {
+					return opts.localizer.MustLocalizeError("kafka.list.error.groupByRequiresAdmin")
+				}
+			}
+
+			if opts.admin {
+				return runAdminList(opts)
+			}
+
 			return runList(opts)
 		},
 	}
 
 	flags := kafkaFlagutil.NewFlagSet(cmd, opts.localizer)
 
-	flags.AddOutput(&opts.outputFormat)
+	flags.AddOutputFormats(&opts.outputFormat, validOutputFormats...)
 	flags.IntVar(&opts.page, "page", int(cmdutil.ConvertPageValueToInt32(build.DefaultPageNumber)), opts.localizer.MustLocalize("kafka.list.flag.page"))
 	flags.IntVar(&opts.limit, "limit", 100, opts.localizer.MustLocalize("kafka.list.flag.limit"))
 	flags.StringVar(&opts.search, "search", "", opts.localizer.MustLocalize("kafka.list.flag.search"))
+	flags.BoolVar(&opts.admin, "admin", false, opts.localizer.MustLocalize("kafka.list.flag.admin"))
+	flags.StringVar(&opts.groupBy, "group-by", "", opts.localizer.MustLocalize("kafka.list.flag.groupBy"))
+	flags.AddPrintSchemaFunc(func() interface{} {
+		if opts.admin {
+			return fleetadmin.KafkaList{}
+		}
+		return kafkamgmtclient.KafkaRequestList{}
+	})
+
+	flagutil.EnableStaticFlagCompletion(cmd, "group-by", validGroupBy)
 
 	return cmd
 }
@@ -120,14 +173,13 @@ func runList(opts *options) error {
 		return err
 	}
 
-	if response.Size == 0 && opts.outputFormat == "" {
+	if response.Size == 0 && (opts.outputFormat == dump.EmptyFormat || opts.outputFormat == wideFormat) {
 		opts.Logger.Info(opts.localizer.MustLocalize("kafka.common.log.info.noKafkaInstances"))
 		return nil
 	}
 
 	switch opts.outputFormat {
-	case dump.EmptyFormat:
-		var rows []kafkaRow
+	case dump.EmptyFormat, wideFormat:
 		svcContext, err := opts.ServiceContext.Load()
 		if err != nil {
 			return err
@@ -138,13 +190,26 @@ func runList(opts *options) error {
 			return err
 		}
 
-		if currCtx.KafkaID != "" {
-			rows = mapResponseItemsToRows(response.GetItems(), currCtx.KafkaID)
+		selectedID := currCtx.KafkaID
+		if selectedID == "" {
+			selectedID = "-"
+		}
+
+		if opts.outputFormat == wideFormat {
+			dump.Table(opts.IO.Out, mapResponseItemsToWideRows(response.GetItems(), selectedID))
 		} else {
-			rows = mapResponseItemsToRows(response.GetItems(), "-")
+			dump.Table(opts.IO.Out, mapResponseItemsToRows(response.GetItems(), selectedID))
 		}
-		dump.Table(opts.IO.Out, rows)
 		opts.Logger.Info("")
+		printSummary(opts, &response)
+
+		now := time.Now()
+		for _, kafka := range response.GetItems() {
+			kafka := kafka
+			if msg := kafkacmdutil.SuspendedMessage(opts.localizer, &kafka, now); msg != "" {
+				opts.Logger.Info(msg)
+			}
+		}
 	default:
 		return dump.Formatted(opts.IO.Out, opts.outputFormat, response)
 	}
@@ -156,17 +221,17 @@ func mapResponseItemsToRows(kafkas []kafkamgmtclient.KafkaRequest, selectedId st
 
 	for i := range kafkas {
 		k := kafkas[i]
-		name := k.GetName()
+		name := dump.OrPlaceholder(k.GetName())
 		if k.GetId() == selectedId {
 			name = fmt.Sprintf("%s %s", name, icon.Emoji("✔", "(current)"))
 		}
 		row := kafkaRow{
-			ID:            k.GetId(),
+			ID:            dump.OrPlaceholder(k.GetId()),
 			Name:          name,
-			Owner:         k.GetOwner(),
-			Status:        k.GetStatus(),
-			CloudProvider: k.GetCloudProvider(),
-			Region:        k.GetRegion(),
+			Owner:         dump.OrPlaceholder(k.GetOwner()),
+			Status:        dump.OrPlaceholder(k.GetStatus()),
+			CloudProvider: dump.OrPlaceholder(k.GetCloudProvider()),
+			Region:        dump.OrPlaceholder(k.GetRegion()),
 		}
 
 		rows[i] = row
@@ -175,11 +240,46 @@ func mapResponseItemsToRows(kafkas []kafkamgmtclient.KafkaRequest, selectedId st
 	return rows
 }
 
+func mapResponseItemsToWideRows(kafkas []kafkamgmtclient.KafkaRequest, selectedId string) []kafkaWideRow {
+	rows := make([]kafkaWideRow, len(kafkas))
+
+	for i, row := range mapResponseItemsToRows(kafkas, selectedId) {
+		k := kafkas[i]
+		rows[i] = kafkaWideRow{
+			ID:               row.ID,
+			Name:             row.Name,
+			Owner:            row.Owner,
+			Status:           row.Status,
+			CloudProvider:    row.CloudProvider,
+			Region:           row.Region,
+			InstanceType:     dump.OrPlaceholder(k.GetInstanceType()),
+			Version:          dump.OrPlaceholder(k.GetVersion()),
+			Reauthentication: strconv.FormatBool(k.GetReauthenticationEnabled()),
+			BootstrapServer:  dump.OrPlaceholder(k.GetBootstrapServerHost()),
+		}
+	}
+
+	return rows
+}
+
 func buildQuery(search string) string {
-	queryString := fmt.Sprintf(
-		"name like %%%[1]v%% or owner like %%%[1]v%% or cloud_provider like %%%[1]v%% or region like %%%[1]v%% or status like %%%[1]v%%",
-		search,
-	)
+	return searchutil.NewSearchQuery(search).
+		Filter("name").
+		Filter("owner").
+		Filter("cloud_provider").
+		Filter("region").
+		Filter("status").
+		Build()
+}
 
-	return queryString
+// buildAdminQuery also filters the instances of all organizations by organization ID
+func buildAdminQuery(search string) string {
+	return searchutil.NewSearchQuery(search).
+		Filter("name").
+		Filter("owner").
+		Filter("organisation_id").
+		Filter("cloud_provider").
+		Filter("region").
+		Filter("status").
+		Build()
 }
@@ -0,0 +1,66 @@
+package list
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+// statusCount is the number of Kafka instances with a status
+type statusCount struct {
+	Status string
+	Count  int
+}
+
+// countByStatus counts the Kafka instances by status, the most frequent status first
+func countByStatus(kafkas []kafkamgmtclient.KafkaRequest) []statusCount {
+	counts := map[string]int{}
+	for _, k := range kafkas {
+		counts[k.GetStatus()]++
+	}
+
+	statuses := make([]statusCount, 0, len(counts))
+	for status, count := range counts {
+		statuses = append(statuses, statusCount{Status: status, Count: count})
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		if statuses[i].Count != statuses[j].Count {
+			return statuses[i].Count > statuses[j].Count
+		}
+		return statuses[i].Status < statuses[j].Status
+	})
+
+	return statuses
+}
+
+// printSummary prints the number of listed instances by status, and the page of the list
+// when there are more instances than those listed
+func printSummary(opts *options, response *kafkamgmtclient.KafkaRequestList) {
+	items := response.GetItems()
+
+	statuses := countByStatus(items)
+	parts := make([]string, len(statuses))
+	for i, s := range statuses {
+		parts[i] = fmt.Sprintf("%d %s", s.Count, s.Status)
+	}
+
+	opts.Logger.Info(opts.localizer.MustLocalizePlural("kafka.list.log.info.summary", len(items),
+		localize.NewEntry("Count", len(items)),
+		localize.NewEntry("Statuses", strings.Join(parts, ", ")),
+	))
+
+	total := int(response.GetTotal())
+	if total <= len(items) || opts.limit <= 0 {
+		return
+	}
+
+	pages := (total + opts.limit - 1) / opts.limit
+	opts.Logger.Info(opts.localizer.MustLocalize("kafka.list.log.info.page",
+		localize.NewEntry("Page", response.GetPage()),
+		localize.NewEntry("Pages", pages),
+		localize.NewEntry("Total", total),
+	))
+}
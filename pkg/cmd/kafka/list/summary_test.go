@@ -0,0 +1,26 @@
+package list
+
+import (
+	"reflect"
+	"testing"
+
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+func TestCountByStatus(t *testing.T) {
+	var kafkas []kafkamgmtclient.KafkaRequest
+	for _, status := range []string{"ready", "failed", "ready", "accepted", "ready"} {
+		k := kafkamgmtclient.KafkaRequest{}
+		k.SetStatus(status)
+		kafkas = append(kafkas, k)
+	}
+
+	want := []statusCount{
+		{Status: "ready", Count: 3},
+		{Status: "accepted", Count: 1},
+		{Status: "failed", Count: 1},
+	}
+	if got := countByStatus(kafkas); !reflect.DeepEqual(got, want) {
+		t.Errorf("countByStatus() = %v, want %v", got, want)
+	}
+}
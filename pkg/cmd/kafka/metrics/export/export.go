@@ -0,0 +1,207 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	kafkaflagutil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/kafkautil"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	openMetricsFormat = "openmetrics"
+	prometheusFormat  = "prometheus"
+
+	prometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+	defaultJob  = "rhoas"
+	pushTimeout = 30 * time.Second
+)
+
+var validFormats = []string{openMetricsFormat, prometheusFormat}
+
+type options struct {
+	id          string
+	name        string
+	format      string
+	outputFile  string
+	pushGateway string
+	job         string
+
+	f *factory.Factory
+}
+
+// NewExportCommand creates a new command which exports the metrics of a Kafka instance
+// to a file or to a Prometheus Pushgateway
+func NewExportCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "export",
+		Short:   f.Localizer.MustLocalize("kafka.metrics.export.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("kafka.metrics.export.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("kafka.metrics.export.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !flagutil.IsValidInput(opts.format, validFormats...) {
+				return flagutil.InvalidValueError("format", opts.format, validFormats...)
+			}
+
+			if opts.name != "" && opts.id != "" {
+				return f.Localizer.MustLocalizeError("service.error.idAndNameCannotBeUsed")
+			}
+
+			if opts.outputFile != "" && opts.pushGateway != "" {
+				return f.Localizer.MustLocalizeError("kafka.metrics.export.error.outputAndPushGateway")
+			}
+
+			if opts.pushGateway != "" {
+				if cmd.Flags().Changed("format") && opts.format != prometheusFormat {
+					return f.Localizer.MustLocalizeError("kafka.metrics.export.error.pushGatewayFormat")
+				}
+				if u, err := url.Parse(opts.pushGateway); err != nil || u.Scheme == "" || u.Host == "" {
+					return flagutil.InvalidValueError("push-gateway", opts.pushGateway)
+				}
+				if opts.job == "" {
+					return flagutil.InvalidValueError("job", opts.job)
+				}
+			}
+
+			if opts.id == "" && opts.name == "" {
+				kafkaInstance, err := contextutil.GetCurrentKafkaInstance(f)
+				if err != nil {
+					return err
+				}
+
+				opts.id = kafkaInstance.GetId()
+			}
+
+			return runExport(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, f.Localizer)
+
+	flags.StringVar(&opts.id, "id", "", f.Localizer.MustLocalize("kafka.metrics.export.flag.id"))
+	flags.StringVar(&opts.name, "name", "", f.Localizer.MustLocalize("kafka.metrics.export.flag.name"))
+	flags.StringVar(&opts.format, "format", openMetricsFormat, f.Localizer.MustLocalize("kafka.metrics.export.flag.format", localize.NewEntry("Formats", strings.Join(validFormats, ", "))))
+	flags.StringVar(&opts.outputFile, "output", "", f.Localizer.MustLocalize("kafka.metrics.export.flag.output"))
+	flags.StringVar(&opts.pushGateway, "push-gateway", "", f.Localizer.MustLocalize("kafka.metrics.export.flag.pushGateway"))
+	flags.StringVar(&opts.job, "job", defaultJob, f.Localizer.MustLocalize("kafka.metrics.export.flag.job"))
+
+	flagutil.EnableStaticFlagCompletion(cmd, "format", validFormats)
+
+	if err := kafkaflagutil.RegisterNameFlagCompletionFunc(cmd, f); err != nil {
+		f.Logger.Debug(f.Localizer.MustLocalize("kafka.common.error.load.completions.name.flag"), err)
+	}
+
+	return cmd
+}
+
+func runExport(opts *options) error {
+	f := opts.f
+
+	conn, err := f.Connection()
+	if err != nil {
+		return err
+	}
+
+	api := conn.API().KafkaMgmt()
+
+	var kafkaInstance *kafkamgmtclient.KafkaRequest
+	var httpRes *http.Response
+	if opts.name != "" {
+		kafkaInstance, httpRes, err = kafkautil.GetKafkaByName(f.Context, api, opts.name)
+	} else {
+		kafkaInstance, httpRes, err = kafkautil.GetKafkaByID(f.Context, api, opts.id)
+	}
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		return err
+	}
+
+	metrics, httpRes, err := api.FederateMetrics(f.Context, kafkaInstance.GetId()).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		return err
+	}
+
+	if opts.pushGateway != "" {
+		if err = push(opts, kafkaInstance.GetId(), toPushFormat(metrics)); err != nil {
+			return err
+		}
+		f.Logger.Info(f.Localizer.MustLocalize("kafka.metrics.export.log.info.pushed",
+			localize.NewEntry("Name", kafkaInstance.GetName()),
+			localize.NewEntry("URL", opts.pushGateway),
+		))
+		return nil
+	}
+
+	if opts.format == openMetricsFormat {
+		metrics = toOpenMetrics(metrics)
+	}
+
+	if opts.outputFile == "" {
+		_, err = io.WriteString(f.IOStreams.Out, metrics)
+		return err
+	}
+
+	if err = os.WriteFile(opts.outputFile, []byte(metrics), 0o600); err != nil {
+		return err
+	}
+
+	f.Logger.Info(f.Localizer.MustLocalize("kafka.metrics.export.log.info.written",
+		localize.NewEntry("Name", kafkaInstance.GetName()),
+		localize.NewEntry("File", opts.outputFile),
+	))
+
+	return nil
+}
+
+// push replaces the metrics of the Kafka instance grouping key in the Pushgateway
+func push(opts *options, kafkaID string, metrics string) error {
+	pushURL := fmt.Sprintf("%v/metrics/job/%v/kafka_instance/%v",
+		strings.TrimSuffix(opts.pushGateway, "/"), url.PathEscape(opts.job), url.PathEscape(kafkaID))
+
+	req, err := http.NewRequestWithContext(opts.f.Context, http.MethodPut, pushURL, bytes.NewBufferString(metrics))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", prometheusContentType)
+
+	client := &http.Client{Timeout: pushTimeout}
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusMultipleChoices {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return opts.f.Localizer.MustLocalizeError("kafka.metrics.export.error.pushFailed",
+			localize.NewEntry("Status", res.Status),
+			localize.NewEntry("Message", strings.TrimSpace(string(body))),
+		)
+	}
+
+	return nil
+}
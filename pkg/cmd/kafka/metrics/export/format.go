@@ -0,0 +1,131 @@
+package export
+
+import (
+	"bufio"
+	"strconv"
+	"strings"
+)
+
+// sample is a single line of the Prometheus text exposition format
+type sample struct {
+	series    string
+	value     string
+	timestamp string
+}
+
+// parseSample parses a sample line of the form `name{labels} value [timestamp]`
+func parseSample(line string) (sample, bool) {
+	end := strings.IndexAny(line, "{ \t")
+	if end <= 0 {
+		return sample{}, false
+	}
+
+	if line[end] == '{' {
+		inQuotes := false
+		escaped := false
+		closed := -1
+		for i := end + 1; i < len(line); i++ {
+			c := line[i]
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inQuotes = !inQuotes
+			case c == '}' && !inQuotes:
+				closed = i
+			}
+			if closed >= 0 {
+				break
+			}
+		}
+		if closed < 0 {
+			return sample{}, false
+		}
+		end = closed + 1
+	}
+
+	fields := strings.Fields(line[end:])
+	if len(fields) == 0 || len(fields) > 2 {
+		return sample{}, false
+	}
+
+	s := sample{series: line[:end], value: fields[0]}
+	if len(fields) == 2 {
+		s.timestamp = fields[1]
+	}
+	return s, true
+}
+
+// toPushFormat converts metrics in the Prometheus text format to the format
+// accepted by the Prometheus Pushgateway, which rejects samples with timestamps
+func toPushFormat(metrics string) string {
+	var b strings.Builder
+	scanner := bufio.NewScanner(strings.NewReader(metrics))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if s, ok := parseSample(line); ok && s.timestamp != "" {
+			line = s.series + " " + s.value
+		}
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// toOpenMetrics converts metrics in the Prometheus text format to the OpenMetrics text format.
+// Timestamps are converted from milliseconds to seconds, counters which do not follow the
+// "_total" naming convention are exposed as unknown and the exposition is terminated by "# EOF".
+func toOpenMetrics(metrics string) string {
+	lines := strings.Split(strings.TrimRight(metrics, "\n"), "\n")
+
+	// counters whose samples are not suffixed with _total cannot be exposed as OpenMetrics counters
+	counters := map[string]bool{}
+	for _, line := range lines {
+		if fields := strings.Fields(line); len(fields) == 4 && fields[0] == "#" && fields[1] == "TYPE" && fields[3] == "counter" {
+			counters[fields[2]] = strings.HasSuffix(fields[2], "_total")
+		}
+	}
+
+	var b strings.Builder
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "#") {
+			fields := strings.Fields(line)
+			if len(fields) < 3 || (fields[1] != "TYPE" && fields[1] != "HELP") {
+				continue
+			}
+			name := fields[2]
+			if valid, isCounter := counters[name]; isCounter && valid {
+				line = strings.Replace(line, name, strings.TrimSuffix(name, "_total"), 1)
+			} else if isCounter && fields[1] == "TYPE" {
+				line = "# TYPE " + name + " unknown"
+			}
+			b.WriteString(line)
+			b.WriteByte('\n')
+			continue
+		}
+
+		s, ok := parseSample(line)
+		if !ok {
+			continue
+		}
+		b.WriteString(s.series)
+		b.WriteByte(' ')
+		b.WriteString(s.value)
+		if s.timestamp != "" {
+			if ms, err := strconv.ParseInt(s.timestamp, 10, 64); err == nil {
+				b.WriteByte(' ')
+				b.WriteString(strconv.FormatFloat(float64(ms)/1000, 'f', 3, 64))
+			}
+		}
+		b.WriteByte('\n')
+	}
+	b.WriteString("# EOF\n")
+
+	return b.String()
+}
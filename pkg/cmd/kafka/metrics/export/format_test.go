@@ -0,0 +1,46 @@
+package export
+
+import (
+	"testing"
+)
+
+const federatedMetrics = `# HELP kafka_server_brokertopicmetrics_bytes_in_total Bytes in
+# TYPE kafka_server_brokertopicmetrics_bytes_in_total counter
+kafka_server_brokertopicmetrics_bytes_in_total{topic="orders"} 1024 1650000000123
+# HELP kafka_log_log_size Log size
+# TYPE kafka_log_log_size gauge
+kafka_log_log_size{topic="a b",partition="0"} 42 1650000000123
+# TYPE kafka_controller_offline_count counter
+kafka_controller_offline_count 0
+`
+
+func Test_toOpenMetrics(t *testing.T) {
+	want := `# HELP kafka_server_brokertopicmetrics_bytes_in Bytes in
+# TYPE kafka_server_brokertopicmetrics_bytes_in counter
+kafka_server_brokertopicmetrics_bytes_in_total{topic="orders"} 1024 1650000000.123
+# HELP kafka_log_log_size Log size
+# TYPE kafka_log_log_size gauge
+kafka_log_log_size{topic="a b",partition="0"} 42 1650000000.123
+# TYPE kafka_controller_offline_count unknown
+kafka_controller_offline_count 0
+# EOF
+`
+	if got := toOpenMetrics(federatedMetrics); got != want {
+		t.Errorf("toOpenMetrics() = %v, want %v", got, want)
+	}
+}
+
+func Test_toPushFormat(t *testing.T) {
+	want := `# HELP kafka_server_brokertopicmetrics_bytes_in_total Bytes in
+# TYPE kafka_server_brokertopicmetrics_bytes_in_total counter
+kafka_server_brokertopicmetrics_bytes_in_total{topic="orders"} 1024
+# HELP kafka_log_log_size Log size
+# TYPE kafka_log_log_size gauge
+kafka_log_log_size{topic="a b",partition="0"} 42
+# TYPE kafka_controller_offline_count counter
+kafka_controller_offline_count 0
+`
+	if got := toPushFormat(federatedMetrics); got != want {
+		t.Errorf("toPushFormat() = %v, want %v", got, want)
+	}
+}
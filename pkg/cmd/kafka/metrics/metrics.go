@@ -0,0 +1,24 @@
+package metrics
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/metrics/export"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+// NewMetricsCommand creates a new command sub-group for Kafka instance metrics
+func NewMetricsCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "metrics",
+		Short:   f.Localizer.MustLocalize("kafka.metrics.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("kafka.metrics.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("kafka.metrics.cmd.example"),
+		Args:    cobra.ExactArgs(1),
+	}
+
+	cmd.AddCommand(
+		export.NewExportCommand(f),
+	)
+
+	return cmd
+}
@@ -0,0 +1,122 @@
+package protect
+
+import (
+	"net/http"
+
+	kafkaflagutil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/kafkautil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/servicespec"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	id     string
+	name   string
+	remove bool
+
+	f *factory.Factory
+}
+
+// NewProtectCommand creates a new command which protects a Kafka instance from accidental deletion
+func NewProtectCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "protect",
+		Short:   f.Localizer.MustLocalize("kafka.protect.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("kafka.protect.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("kafka.protect.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.name != "" && opts.id != "" {
+				return f.Localizer.MustLocalizeError("service.error.idAndNameCannotBeUsed")
+			}
+
+			if opts.id == "" && opts.name == "" {
+				kafkaInstance, err := contextutil.GetCurrentKafkaInstance(f)
+				if err != nil {
+					return err
+				}
+
+				opts.id = kafkaInstance.GetId()
+			}
+
+			return runProtect(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, f.Localizer)
+
+	flags.StringVar(&opts.id, "id", "", f.Localizer.MustLocalize("kafka.protect.flag.id"))
+	flags.StringVar(&opts.name, "name", "", f.Localizer.MustLocalize("kafka.protect.flag.name"))
+	flags.BoolVar(&opts.remove, "remove", false, f.Localizer.MustLocalize("kafka.protect.flag.remove"))
+
+	if err := kafkaflagutil.RegisterNameFlagCompletionFunc(cmd, f); err != nil {
+		f.Logger.Debug(f.Localizer.MustLocalize("kafka.common.error.load.completions.name.flag"), err)
+	}
+
+	return cmd
+}
+
+func runProtect(opts *options) error {
+	f := opts.f
+
+	conn, err := f.Connection()
+	if err != nil {
+		return err
+	}
+
+	api := conn.API().KafkaMgmt()
+
+	var kafkaInstance *kafkamgmtclient.KafkaRequest
+	var httpRes *http.Response
+	if opts.name != "" {
+		kafkaInstance, httpRes, err = kafkautil.GetKafkaByName(f.Context, api, opts.name)
+	} else {
+		kafkaInstance, httpRes, err = kafkautil.GetKafkaByID(f.Context, api, opts.id)
+	}
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		return err
+	}
+
+	cfg, err := f.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	nameEntry := localize.NewEntry("Name", kafkaInstance.GetName())
+
+	if opts.remove {
+		if !cfg.Unprotect(servicespec.KafkaServiceName, kafkaInstance.GetId()) {
+			f.Logger.Info(f.Localizer.MustLocalize("kafka.protect.log.info.notProtected", nameEntry))
+			return nil
+		}
+		if err = f.Config.Save(cfg); err != nil {
+			return err
+		}
+		f.Logger.Info(f.Localizer.MustLocalize("kafka.protect.log.info.unprotected", nameEntry))
+		return nil
+	}
+
+	if !cfg.Protect(servicespec.KafkaServiceName, kafkaInstance.GetId()) {
+		f.Logger.Info(f.Localizer.MustLocalize("kafka.protect.log.info.alreadyProtected", nameEntry))
+		return nil
+	}
+	if err = f.Config.Save(cfg); err != nil {
+		return err
+	}
+	f.Logger.Info(f.Localizer.MustLocalize("kafka.protect.log.info.protected", nameEntry))
+
+	return nil
+}
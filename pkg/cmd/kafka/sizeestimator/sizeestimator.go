@@ -0,0 +1,247 @@
+package sizeestimator
+
+import (
+	"math"
+	"sort"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/create"
+	kafkaFlagutil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/kafkautil"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultProvider    = "aws"
+	defaultRegion      = "us-east-1"
+	defaultRetentionMs = 7 * 24 * 60 * 60 * 1000
+
+	// unlimitedRetentionBytes is the value of --retention-bytes which does not limit the size of partitions
+	unlimitedRetentionBytes = -1
+
+	megabyte = 1024 * 1024
+	gigabyte = 1024 * megabyte
+)
+
+// requirements is the expected load of the Kafka instance
+type requirements struct {
+	IngressThroughputPerSec int64 `json:"ingress_throughput_per_sec_bytes" yaml:"ingress_throughput_per_sec_bytes"`
+	EgressThroughputPerSec  int64 `json:"egress_throughput_per_sec_bytes" yaml:"egress_throughput_per_sec_bytes"`
+	Partitions              int32 `json:"partitions" yaml:"partitions"`
+	DataRetentionSize       int64 `json:"data_retention_size_bytes" yaml:"data_retention_size_bytes"`
+}
+
+// sizeFit is a size of the instance type and whether it fits the requirements
+type sizeFit struct {
+	StreamingUnits int32                     `json:"streaming_units" yaml:"streaming_units"`
+	Limits         *kafkautil.InstanceLimits `json:"limits" yaml:"limits"`
+	Fits           bool                      `json:"fits" yaml:"fits"`
+}
+
+// sizeEstimate is the recommended size for the requirements
+type sizeEstimate struct {
+	Provider        string       `json:"cloud_provider" yaml:"cloud_provider"`
+	Region          string       `json:"region" yaml:"region"`
+	InstanceType    string       `json:"instance_type" yaml:"instance_type"`
+	Requirements    requirements `json:"requirements" yaml:"requirements"`
+	RecommendedSize string       `json:"recommended_size,omitempty" yaml:"recommended_size,omitempty"`
+	Sizes           []sizeFit    `json:"sizes" yaml:"sizes"`
+}
+
+// sizeRow is a size printed to the table
+type sizeRow struct {
+	Size           string  `header:"Size"`
+	StreamingUnits int32   `header:"Streaming Units"`
+	Ingress        float64 `header:"Ingress (MB/s)"`
+	Egress         float64 `header:"Egress (MB/s)"`
+	Partitions     int32   `header:"Max Partitions"`
+	Storage        float64 `header:"Storage (GB)"`
+	Fits           bool    `header:"Fits"`
+}
+
+type options struct {
+	ingress        float64
+	egress         float64
+	partitions     int32
+	retentionMs    int64
+	retentionBytes int64
+	provider       string
+	region         string
+	instanceType   string
+	outputFormat   string
+
+	f *factory.Factory
+}
+
+// NewSizeEstimatorCommand creates a new command to recommend the size of a Kafka instance for an expected load
+func NewSizeEstimatorCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "size-estimator",
+		Short:   f.Localizer.MustLocalize("kafka.sizeEstimator.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("kafka.sizeEstimator.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("kafka.sizeEstimator.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			if opts.ingress < 0 || opts.egress < 0 || opts.partitions < 0 || opts.retentionMs < 0 || opts.retentionBytes < unlimitedRetentionBytes {
+				return f.Localizer.MustLocalizeError("kafka.sizeEstimator.error.negativeValue")
+			}
+
+			return runEstimate(opts)
+		},
+	}
+
+	flags := kafkaFlagutil.NewFlagSet(cmd, f.Localizer)
+
+	flags.Float64Var(&opts.ingress, "ingress", 0, f.Localizer.MustLocalize("kafka.sizeEstimator.flag.ingress.description"))
+	flags.Float64Var(&opts.egress, "egress", 0, f.Localizer.MustLocalize("kafka.sizeEstimator.flag.egress.description"))
+	flags.Int32Var(&opts.partitions, "partitions", 0, f.Localizer.MustLocalize("kafka.sizeEstimator.flag.partitions.description"))
+	flags.Int64Var(&opts.retentionMs, "retention-ms", defaultRetentionMs, f.Localizer.MustLocalize("kafka.sizeEstimator.flag.retentionMs.description"))
+	flags.Int64Var(&opts.retentionBytes, "retention-bytes", unlimitedRetentionBytes, f.Localizer.MustLocalize("kafka.sizeEstimator.flag.retentionBytes.description"))
+	flags.StringVar(&opts.provider, create.FlagProvider, defaultProvider, f.Localizer.MustLocalize("kafka.sizeEstimator.flag.provider.description"))
+	flags.StringVar(&opts.region, create.FlagRegion, defaultRegion, f.Localizer.MustLocalize("kafka.sizeEstimator.flag.region.description"))
+	flags.StringVar(&opts.instanceType, "instance-type", create.StandardType, f.Localizer.MustLocalize("kafka.sizeEstimator.flag.instanceType.description"))
+	flags.AddOutput(&opts.outputFormat)
+
+	_ = cmd.RegisterFlagCompletionFunc(create.FlagProvider, func(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return create.GetCloudProviderCompletionValues(f)
+	})
+
+	_ = cmd.RegisterFlagCompletionFunc(create.FlagRegion, func(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return create.GetCloudProviderRegionCompletionValues(f, opts.provider)
+	})
+
+	return cmd
+}
+
+func runEstimate(opts *options) error {
+	f := opts.f
+
+	instanceTypes, err := create.FetchInstanceTypes(f, opts.provider, opts.region)
+	if err != nil {
+		return err
+	}
+
+	var sizes []kafkamgmtclient.SupportedKafkaSize
+	for _, instanceType := range instanceTypes {
+		if instanceType.GetId() == opts.instanceType {
+			sizes = instanceType.GetSizes()
+		}
+	}
+	if len(sizes) == 0 {
+		return f.Localizer.MustLocalizeError("kafka.sizeEstimator.error.noSizes",
+			localize.NewEntry("InstanceType", opts.instanceType),
+			localize.NewEntry("Provider", opts.provider),
+			localize.NewEntry("Region", opts.region),
+		)
+	}
+
+	req := requirements{
+		IngressThroughputPerSec: int64(math.Ceil(opts.ingress * megabyte)),
+		EgressThroughputPerSec:  int64(math.Ceil(opts.egress * megabyte)),
+		Partitions:              opts.partitions,
+	}
+	req.DataRetentionSize = retentionSize(req.IngressThroughputPerSec, opts.retentionMs, opts.retentionBytes, opts.partitions)
+
+	result := estimate(req, sizes)
+	result.Provider = opts.provider
+	result.Region = opts.region
+	result.InstanceType = opts.instanceType
+
+	if opts.outputFormat != dump.EmptyFormat {
+		return dump.Formatted(f.IOStreams.Out, opts.outputFormat, result)
+	}
+
+	rows := make([]sizeRow, len(result.Sizes))
+	for i, size := range result.Sizes {
+		rows[i] = sizeRow{
+			Size:           size.Limits.SizeID,
+			StreamingUnits: size.StreamingUnits,
+			Ingress:        float64(size.Limits.IngressThroughputPerSec) / megabyte,
+			Egress:         float64(size.Limits.EgressThroughputPerSec) / megabyte,
+			Partitions:     size.Limits.MaxPartitions,
+			Storage:        float64(size.Limits.MaxDataRetentionSize) / gigabyte,
+			Fits:           size.Fits,
+		}
+	}
+	dump.Table(f.IOStreams.Out, rows)
+	f.Logger.Info("")
+
+	storage := localize.NewEntry("Storage", math.Ceil(float64(req.DataRetentionSize)/gigabyte))
+	if result.RecommendedSize == "" {
+		f.Logger.Info(icon.InfoPrefix(), f.Localizer.MustLocalize("kafka.sizeEstimator.log.info.noSizeFits", storage))
+		return nil
+	}
+
+	f.Logger.Info(icon.SuccessPrefix(), f.Localizer.MustLocalize("kafka.sizeEstimator.log.info.recommended",
+		localize.NewEntry("Size", result.RecommendedSize),
+		storage,
+		localize.NewEntry("Provider", opts.provider),
+		localize.NewEntry("Region", opts.region),
+	))
+
+	return nil
+}
+
+// retentionSize estimates the storage used by the messages produced at the ingress throughput
+// during the retention time, limited by the retention size of each partition when it is set
+func retentionSize(ingress int64, retentionMs int64, retentionBytes int64, partitions int32) int64 {
+	size := int64(math.Ceil(float64(ingress) * float64(retentionMs) / 1000))
+	if retentionBytes != unlimitedRetentionBytes && partitions > 0 {
+		if limit := retentionBytes * int64(partitions); limit < size {
+			size = limit
+		}
+	}
+	return size
+}
+
+// estimate checks which sizes fit the requirements and recommends the one
+// consuming the fewest streaming units
+func estimate(req requirements, sizes []kafkamgmtclient.SupportedKafkaSize) *sizeEstimate {
+	result := &sizeEstimate{
+		Requirements: req,
+		Sizes:        make([]sizeFit, len(sizes)),
+	}
+
+	for i := range sizes {
+		limits := kafkautil.NewInstanceLimits(&sizes[i])
+		result.Sizes[i] = sizeFit{
+			StreamingUnits: sizes[i].GetQuotaConsumed(),
+			Limits:         limits,
+			Fits:           fits(req, limits),
+		}
+	}
+
+	sort.SliceStable(result.Sizes, func(i, j int) bool {
+		return result.Sizes[i].StreamingUnits < result.Sizes[j].StreamingUnits
+	})
+
+	for _, size := range result.Sizes {
+		if size.Fits {
+			result.RecommendedSize = size.Limits.SizeID
+			break
+		}
+	}
+
+	return result
+}
+
+func fits(req requirements, limits *kafkautil.InstanceLimits) bool {
+	return req.IngressThroughputPerSec <= limits.IngressThroughputPerSec &&
+		req.EgressThroughputPerSec <= limits.EgressThroughputPerSec &&
+		req.Partitions <= limits.MaxPartitions &&
+		req.DataRetentionSize <= limits.MaxDataRetentionSize
+}
@@ -0,0 +1,71 @@
+package sizeestimator
+
+import (
+	"testing"
+
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+func Test_retentionSize(t *testing.T) {
+	tests := []struct {
+		name           string
+		ingress        int64
+		retentionMs    int64
+		retentionBytes int64
+		partitions     int32
+		want           int64
+	}{
+		{name: "retention time", ingress: 1000, retentionMs: 60000, retentionBytes: unlimitedRetentionBytes, partitions: 3, want: 60000},
+		{name: "limited by the retention size", ingress: 1000, retentionMs: 60000, retentionBytes: 100, partitions: 3, want: 300},
+		{name: "retention size above the retention time", ingress: 1000, retentionMs: 60000, retentionBytes: 100000, partitions: 3, want: 60000},
+		{name: "no ingress", ingress: 0, retentionMs: 60000, retentionBytes: unlimitedRetentionBytes, partitions: 3, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retentionSize(tt.ingress, tt.retentionMs, tt.retentionBytes, tt.partitions); got != tt.want {
+				t.Errorf("retentionSize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_estimate(t *testing.T) {
+	newSize := func(id string, streamingUnits int32, throughput int64, partitions int32, storage int64) kafkamgmtclient.SupportedKafkaSize {
+		size := kafkamgmtclient.SupportedKafkaSize{}
+		size.SetId(id)
+		size.SetQuotaConsumed(streamingUnits)
+		size.SetIngressThroughputPerSec(kafkamgmtclient.SupportedKafkaSizeBytesValueItem{Bytes: &throughput})
+		size.SetEgressThroughputPerSec(kafkamgmtclient.SupportedKafkaSizeBytesValueItem{Bytes: &throughput})
+		size.SetMaxPartitions(partitions)
+		size.SetMaxDataRetentionSize(kafkamgmtclient.SupportedKafkaSizeBytesValueItem{Bytes: &storage})
+		return size
+	}
+	// the API does not return the sizes in order
+	sizes := []kafkamgmtclient.SupportedKafkaSize{
+		newSize("x2", 2, 100, 3000, 2000),
+		newSize("x1", 1, 50, 1500, 1000),
+	}
+
+	tests := []struct {
+		name string
+		req  requirements
+		want string
+	}{
+		{name: "smallest size fits", req: requirements{IngressThroughputPerSec: 10, Partitions: 100}, want: "x1"},
+		{name: "throughput needs a larger size", req: requirements{EgressThroughputPerSec: 80}, want: "x2"},
+		{name: "partitions need a larger size", req: requirements{Partitions: 2000}, want: "x2"},
+		{name: "storage needs a larger size", req: requirements{DataRetentionSize: 1500}, want: "x2"},
+		{name: "no size fits", req: requirements{IngressThroughputPerSec: 200}, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := estimate(tt.req, sizes)
+			if got.RecommendedSize != tt.want {
+				t.Errorf("estimate() recommended size = %q, want %q", got.RecommendedSize, tt.want)
+			}
+			if got.Sizes[0].Limits.SizeID != "x1" {
+				t.Errorf("estimate() sizes are not sorted by streaming units")
+			}
+		})
+	}
+}
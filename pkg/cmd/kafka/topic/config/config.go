@@ -0,0 +1,24 @@
+package config
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/config/diff"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+// NewConfigCommand creates a new command group for the configuration of Kafka topics
+func NewConfigCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "config",
+		Short:   f.Localizer.MustLocalize("kafka.topic.config.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("kafka.topic.config.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("kafka.topic.config.cmd.example"),
+		Args:    cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		diff.NewDiffCommand(f),
+	)
+
+	return cmd
+}
@@ -0,0 +1,299 @@
+package diff
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	kafkaflagutil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/kafkacmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	name         string
+	kafkaID      string
+	otherName    string
+	otherKafkaID string
+	otherContext string
+	outputFormat string
+
+	IO             *iostreams.IOStreams
+	Connection     factory.ConnectionFunc
+	Logger         logging.Logger
+	localizer      localize.Localizer
+	Context        context.Context
+	ServiceContext servicecontext.IContext
+}
+
+// configDifference is a configuration key whose value differs between the two topics.
+// A nil value means that the key is not set for the topic.
+type configDifference struct {
+	Key        string  `json:"key" yaml:"key"`
+	Value      *string `json:"value" yaml:"value"`
+	OtherValue *string `json:"otherValue" yaml:"otherValue"`
+}
+
+// configDifferenceRow is a configDifference printed in a table
+type configDifferenceRow struct {
+	Key        string `header:"Key"`
+	Value      string `header:"Value"`
+	OtherValue string `header:"Other value"`
+}
+
+// NewDiffCommand creates a new command to compare the configuration of two topics
+func NewDiffCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		IO:             f.IOStreams,
+		Connection:     f.Connection,
+		Logger:         f.Logger,
+		localizer:      f.Localizer,
+		Context:        f.Context,
+		ServiceContext: f.ServiceContext,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "diff",
+		Short:   f.Localizer.MustLocalize("kafka.topic.config.diff.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("kafka.topic.config.diff.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("kafka.topic.config.diff.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			if opts.otherContext != "" && opts.otherKafkaID != "" {
+				return opts.localizer.MustLocalizeError("kafka.topic.config.diff.error.otherContextAndInstance")
+			}
+
+			if opts.kafkaID == "" {
+				kafkaInstance, err := contextutil.GetCurrentKafkaInstance(f)
+				if err != nil {
+					return err
+				}
+
+				opts.kafkaID = kafkaInstance.GetId()
+			}
+
+			if opts.otherContext != "" {
+				otherKafkaID, err := kafkaIDOfContext(opts, opts.otherContext)
+				if err != nil {
+					return err
+				}
+				opts.otherKafkaID = otherKafkaID
+			}
+
+			if opts.otherName == "" {
+				opts.otherName = opts.name
+			}
+			if opts.otherKafkaID == "" {
+				opts.otherKafkaID = opts.kafkaID
+			}
+
+			if opts.otherName == opts.name && opts.otherKafkaID == opts.kafkaID {
+				return opts.localizer.MustLocalizeError("kafka.topic.config.diff.error.sameTopic")
+			}
+
+			return runDiff(opts)
+		},
+	}
+
+	flags := kafkaflagutil.NewFlagSet(cmd, f.Localizer)
+
+	flags.StringVar(&opts.name, "name", "", f.Localizer.MustLocalize("kafka.topic.common.flag.name.description"))
+	_ = cmd.MarkFlagRequired("name")
+	flags.AddInstanceID(&opts.kafkaID)
+	flags.StringVar(&opts.otherName, "other-name", "", f.Localizer.MustLocalize("kafka.topic.config.diff.flag.otherName.description"))
+	flags.StringVar(&opts.otherKafkaID, "other-instance-id", "", f.Localizer.MustLocalize("kafka.topic.config.diff.flag.otherInstanceID.description"))
+	flags.StringVar(&opts.otherContext, "other-context", "", f.Localizer.MustLocalize("kafka.topic.config.diff.flag.otherContext.description"))
+	flags.AddOutput(&opts.outputFormat)
+	flags.AddPrintSchema([]configDifference{})
+
+	_ = cmd.RegisterFlagCompletionFunc("name", func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return kafkacmdutil.FilterValidTopicNameArgs(f, toComplete)
+	})
+	_ = cmd.RegisterFlagCompletionFunc("other-context", func(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		svcContext, err := f.ServiceContext.Load()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		names := make([]string, 0, len(svcContext.Contexts))
+		for name := range svcContext.Contexts {
+			names = append(names, name)
+		}
+		return names, cobra.ShellCompDirectiveNoFileComp
+	})
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+func runDiff(opts *options) error {
+	conn, err := opts.Connection()
+	if err != nil {
+		return err
+	}
+
+	topic, instanceName, err := fetchTopic(opts, conn, opts.kafkaID, opts.name)
+	if err != nil {
+		return err
+	}
+
+	otherTopic, otherInstanceName, err := fetchTopic(opts, conn, opts.otherKafkaID, opts.otherName)
+	if err != nil {
+		return err
+	}
+
+	differences := diffConfigs(topic.GetConfig(), otherTopic.GetConfig())
+
+	if opts.outputFormat != dump.EmptyFormat {
+		return dump.Formatted(opts.IO.Out, opts.outputFormat, differences)
+	}
+
+	comparedEntries := []*localize.TemplateEntry{
+		localize.NewEntry("TopicName", opts.name),
+		localize.NewEntry("InstanceName", instanceName),
+		localize.NewEntry("OtherTopicName", opts.otherName),
+		localize.NewEntry("OtherInstanceName", otherInstanceName),
+	}
+
+	if len(differences) == 0 {
+		opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.config.diff.log.info.noDifferences", comparedEntries...))
+		return nil
+	}
+
+	opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.config.diff.log.info.comparing", comparedEntries...))
+	opts.Logger.Info("")
+
+	rows := make([]configDifferenceRow, len(differences))
+	for i, d := range differences {
+		rows[i] = configDifferenceRow{
+			Key:        d.Key,
+			Value:      valueOrPlaceholder(d.Value),
+			OtherValue: valueOrPlaceholder(d.OtherValue),
+		}
+	}
+	dump.Table(opts.IO.Out, rows)
+
+	return nil
+}
+
+// fetchTopic fetches a topic and the name of the Kafka instance it belongs to
+func fetchTopic(opts *options, conn connection.Connection, kafkaID string, name string) (*kafkainstanceclient.Topic, string, error) {
+	api, kafkaInstance, err := conn.API().KafkaAdmin(kafkaID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	topic, httpRes, err := api.TopicsApi.GetTopic(opts.Context, name).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+
+	if err != nil {
+		if httpRes == nil {
+			return nil, "", err
+		}
+
+		operationTmplPair := localize.NewEntry("Operation", "describe")
+
+		switch httpRes.StatusCode {
+		case http.StatusNotFound:
+			return nil, "", opts.localizer.MustLocalizeError("kafka.topic.common.error.notFoundError", localize.NewEntry("TopicName", name), localize.NewEntry("InstanceName", kafkaInstance.GetName()))
+		case http.StatusUnauthorized:
+			return nil, "", opts.localizer.MustLocalizeError("kafka.topic.common.error.unauthorized", operationTmplPair)
+		case http.StatusForbidden:
+			return nil, "", opts.localizer.MustLocalizeError("kafka.topic.common.error.forbidden", operationTmplPair)
+		case http.StatusInternalServerError:
+			return nil, "", opts.localizer.MustLocalizeError("kafka.topic.common.error.internalServerError")
+		case http.StatusServiceUnavailable:
+			return nil, "", opts.localizer.MustLocalizeError("kafka.topic.common.error.unableToConnectToKafka", localize.NewEntry("Name", kafkaInstance.GetName()))
+		default:
+			return nil, "", err
+		}
+	}
+
+	return &topic, kafkaInstance.GetName(), nil
+}
+
+// kafkaIDOfContext returns the ID of the Kafka instance set in the given service context
+func kafkaIDOfContext(opts *options, contextName string) (string, error) {
+	svcContext, err := opts.ServiceContext.Load()
+	if err != nil {
+		return "", err
+	}
+
+	svcConfig, err := contextutil.GetContext(svcContext, opts.localizer, contextName)
+	if err != nil {
+		return "", err
+	}
+
+	if svcConfig.KafkaID == "" {
+		return "", opts.localizer.MustLocalizeError("kafka.topic.config.diff.error.noKafkaInContext", localize.NewEntry("Name", contextName))
+	}
+
+	return svcConfig.KafkaID, nil
+}
+
+// diffConfigs returns the configuration keys whose values differ, sorted by key
+func diffConfigs(entries []kafkainstanceclient.ConfigEntry, otherEntries []kafkainstanceclient.ConfigEntry) []configDifference {
+	values := configMap(entries)
+	otherValues := configMap(otherEntries)
+
+	keys := make([]string, 0, len(values)+len(otherValues))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	for key := range otherValues {
+		if _, ok := values[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	differences := []configDifference{}
+	for _, key := range keys {
+		value, ok := values[key]
+		otherValue, otherOk := otherValues[key]
+		if ok && otherOk && value == otherValue {
+			continue
+		}
+
+		difference := configDifference{Key: key}
+		if ok {
+			difference.Value = &value
+		}
+		if otherOk {
+			difference.OtherValue = &otherValue
+		}
+		differences = append(differences, difference)
+	}
+
+	return differences
+}
+
+func configMap(entries []kafkainstanceclient.ConfigEntry) map[string]string {
+	values := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		values[entry.Key] = entry.Value
+	}
+	return values
+}
+
+func valueOrPlaceholder(value *string) string {
+	if value == nil {
+		return dump.Placeholder
+	}
+	return *value
+}
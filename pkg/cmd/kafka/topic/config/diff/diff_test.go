@@ -0,0 +1,51 @@
+package diff
+
+import (
+	"reflect"
+	"testing"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
+)
+
+func Test_diffConfigs(t *testing.T) {
+	value := func(v string) *string { return &v }
+
+	tests := []struct {
+		name         string
+		entries      []kafkainstanceclient.ConfigEntry
+		otherEntries []kafkainstanceclient.ConfigEntry
+		want         []configDifference
+	}{
+		{
+			name:         "same configuration",
+			entries:      []kafkainstanceclient.ConfigEntry{{Key: "retention.ms", Value: "604800000"}},
+			otherEntries: []kafkainstanceclient.ConfigEntry{{Key: "retention.ms", Value: "604800000"}},
+			want:         []configDifference{},
+		},
+		{
+			name: "different values and missing keys",
+			entries: []kafkainstanceclient.ConfigEntry{
+				{Key: "retention.ms", Value: "604800000"},
+				{Key: "cleanup.policy", Value: "delete"},
+				{Key: "segment.bytes", Value: "1073741824"},
+			},
+			otherEntries: []kafkainstanceclient.ConfigEntry{
+				{Key: "segment.bytes", Value: "1073741824"},
+				{Key: "cleanup.policy", Value: "compact"},
+				{Key: "min.compaction.lag.ms", Value: "0"},
+			},
+			want: []configDifference{
+				{Key: "cleanup.policy", Value: value("delete"), OtherValue: value("compact")},
+				{Key: "min.compaction.lag.ms", OtherValue: value("0")},
+				{Key: "retention.ms", Value: value("604800000")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := diffConfigs(tt.entries, tt.otherEntries); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffConfigs() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
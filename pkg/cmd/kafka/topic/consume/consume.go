@@ -164,10 +164,14 @@ func consumeAndWait(opts *options, api *kafkainstanceclient.APIClient, kafkaInst
 
 	var max_offset int64
 	first_consume := true
-	for true {
+	for opts.f.Context.Err() == nil {
 
 		records, err := consume(opts, api, kafkaInstance)
 		if err != nil {
+			// consuming stops when the command is interrupted or times out
+			if opts.f.Context.Err() != nil {
+				return nil
+			}
 			return err
 		}
 
@@ -186,7 +190,10 @@ func consumeAndWait(opts *options, api *kafkainstanceclient.APIClient, kafkaInst
 			opts.offset = fmt.Sprint(max_offset)
 		}
 
-		time.Sleep(1 * time.Second)
+		select {
+		case <-opts.f.Context.Done():
+		case <-time.After(1 * time.Second):
+		}
 	}
 
 	return nil
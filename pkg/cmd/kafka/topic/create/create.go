@@ -3,11 +3,13 @@ package create
 import (
 	"context"
 	"net/http"
+	"sort"
 	"strconv"
 
 	kafkaflagutil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/topiccmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
@@ -15,7 +17,9 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/kafkautil"
 	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
 
 	"github.com/AlecAivazis/survey/v2"
 
@@ -37,8 +41,15 @@ type options struct {
 	outputFormat   string
 	cleanupPolicy  string
 	interactive    bool
+	skipPolicy     bool
+	like           string
+	// setFlags are the topic settings given with flags, they take precedence over the settings copied from the --like topic
+	setFlags map[string]bool
+	// likeConfig is the config of the topic, when it is copied from the --like topic
+	likeConfig map[string]string
 
 	IO             *iostreams.IOStreams
+	Config         config.IConfig
 	Connection     factory.ConnectionFunc
 	Logger         logging.Logger
 	localizer      localize.Localizer
@@ -52,6 +63,7 @@ func NewCreateTopicCommand(f *factory.Factory) *cobra.Command {
 		Connection:     f.Connection,
 		Logger:         f.Logger,
 		IO:             f.IOStreams,
+		Config:         f.Config,
 		localizer:      f.Localizer,
 		Context:        f.Context,
 		ServiceContext: f.ServiceContext,
@@ -89,6 +101,11 @@ func NewCreateTopicCommand(f *factory.Factory) *cobra.Command {
 				}
 			}
 
+			opts.setFlags = map[string]bool{}
+			for _, name := range []string{"partitions", "retention-ms", "retention-bytes", "cleanup-policy"} {
+				opts.setFlags[name] = cmd.Flags().Changed(name)
+			}
+
 			if opts.kafkaID == "" {
 
 				kafkaInstance, err := contextutil.GetCurrentKafkaInstance(f)
@@ -110,6 +127,8 @@ func NewCreateTopicCommand(f *factory.Factory) *cobra.Command {
 	flags.IntVar(&opts.retentionMs, "retention-ms", defaultRetentionPeriodMS, opts.localizer.MustLocalize("kafka.topic.common.input.retentionMs.description"))
 	flags.IntVar(&opts.retentionBytes, "retention-bytes", defaultRetentionSize, opts.localizer.MustLocalize("kafka.topic.common.input.retentionBytes.description"))
 	flags.StringVar(&opts.cleanupPolicy, "cleanup-policy", defaultCleanupPolicy, opts.localizer.MustLocalize("kafka.topic.common.input.cleanupPolicy.description"))
+	flags.BoolVar(&opts.skipPolicy, "skip-policy", false, opts.localizer.MustLocalize("kafka.topic.create.flag.skipPolicy.description"))
+	flags.StringVar(&opts.like, "like", "", opts.localizer.MustLocalize("kafka.topic.create.flag.like.description"))
 	flags.AddOutput(&opts.outputFormat)
 	flags.AddInstanceID(&opts.kafkaID)
 
@@ -134,11 +153,31 @@ func runCmd(opts *options) error {
 		return err
 	}
 
+	if !opts.skipPolicy {
+		cfg, err := opts.Config.Load()
+		if err != nil {
+			return err
+		}
+		if err = topiccmdutil.ValidateNamePolicy(opts.Context, cfg, conn.API().GetConfig().HTTPClient, opts.localizer, opts.topicName); err != nil {
+			return err
+		}
+	}
+
 	api, kafkaInstance, err := conn.API().KafkaAdmin(opts.kafkaID)
 	if err != nil {
 		return err
 	}
 
+	if opts.like != "" {
+		if err = copyLikeTopic(opts, api, kafkaInstance); err != nil {
+			return err
+		}
+	}
+
+	if err = validateInstanceLimits(opts, conn.API().KafkaMgmt(), api, kafkaInstance); err != nil {
+		return err
+	}
+
 	createTopicReq := api.TopicsApi.CreateTopic(opts.Context)
 
 	topicInput := kafkainstanceclient.NewTopicInput{
@@ -208,6 +247,11 @@ func runInteractivePrompt(opts *options) (err error) {
 		return err
 	}
 
+	// the other settings are copied from the --like topic
+	if opts.like != "" {
+		return nil
+	}
+
 	partitionsPrompt := &survey.Input{
 		Message: opts.localizer.MustLocalize("kafka.topic.create.input.partitions.message"),
 		Help:    opts.localizer.MustLocalize("kafka.topic.common.input.partitions.description"),
@@ -257,6 +301,14 @@ func runInteractivePrompt(opts *options) (err error) {
 }
 
 func createConfigEntries(opts *options) *[]kafkainstanceclient.ConfigEntry {
+	if opts.likeConfig != nil {
+		entries := make([]kafkainstanceclient.ConfigEntry, 0, len(opts.likeConfig))
+		for _, key := range sortedKeys(opts.likeConfig) {
+			entries = append(entries, *kafkainstanceclient.NewConfigEntry(key, opts.likeConfig[key]))
+		}
+		return &entries
+	}
+
 	retentionMsStr := strconv.Itoa(opts.retentionMs)
 	retentionBytesStr := strconv.Itoa(opts.retentionBytes)
 	cleanupPolicyStr := opts.cleanupPolicy
@@ -268,6 +320,103 @@ func createConfigEntries(opts *options) *[]kafkainstanceclient.ConfigEntry {
 	return topiccmdutil.CreateConfigEntries(configEntryMap)
 }
 
+// copyLikeTopic copies the number of partitions and the config of the --like topic to the options
+func copyLikeTopic(opts *options, api *kafkainstanceclient.APIClient, kafkaInstance *kafkamgmtclient.KafkaRequest) error {
+	source, httpRes, err := api.TopicsApi.GetTopic(opts.Context, opts.like).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+
+	if err != nil {
+		if httpRes == nil {
+			return err
+		}
+
+		operationTmplPair := localize.NewEntry("Operation", "describe")
+		switch httpRes.StatusCode {
+		case http.StatusNotFound:
+			return opts.localizer.MustLocalizeError("kafka.topic.common.error.notFoundError", localize.NewEntry("TopicName", opts.like), localize.NewEntry("InstanceName", kafkaInstance.GetName()))
+		case http.StatusUnauthorized:
+			return opts.localizer.MustLocalizeError("kafka.topic.common.error.unauthorized", operationTmplPair)
+		case http.StatusForbidden:
+			return opts.localizer.MustLocalizeError("kafka.topic.common.error.forbidden", operationTmplPair)
+		default:
+			return err
+		}
+	}
+
+	if !opts.setFlags["partitions"] {
+		opts.partitions = int32(len(source.GetPartitions()))
+	}
+
+	overrides := map[string]string{}
+	if opts.setFlags["retention-ms"] {
+		overrides[topiccmdutil.RetentionMsKey] = strconv.Itoa(opts.retentionMs)
+	}
+	if opts.setFlags["retention-bytes"] {
+		overrides[topiccmdutil.RetentionSizeKey] = strconv.Itoa(opts.retentionBytes)
+	}
+	if opts.setFlags["cleanup-policy"] {
+		overrides[topiccmdutil.CleanupPolicy] = opts.cleanupPolicy
+	}
+	opts.likeConfig = likeConfig(source.GetConfig(), overrides)
+
+	// the copied retention is checked against the limits of the instance
+	if retentionMs, err := strconv.Atoi(opts.likeConfig[topiccmdutil.RetentionMsKey]); err == nil {
+		opts.retentionMs = retentionMs
+	}
+	if retentionBytes, err := strconv.Atoi(opts.likeConfig[topiccmdutil.RetentionSizeKey]); err == nil {
+		opts.retentionBytes = retentionBytes
+	}
+
+	opts.Logger.Debug("Copying the settings of topic", opts.like, "with", opts.partitions, "partitions and config", opts.likeConfig)
+
+	return nil
+}
+
+// likeConfig merges the config of the source topic with the values set for the new topic
+func likeConfig(source []kafkainstanceclient.ConfigEntry, overrides map[string]string) map[string]string {
+	config := make(map[string]string, len(source)+len(overrides))
+	for _, entry := range source {
+		config[entry.Key] = entry.Value
+	}
+	for key, value := range overrides {
+		config[key] = value
+	}
+	return config
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// validateInstanceLimits checks the requested topic settings against the limits of the instance size,
+// so that users get an actionable error instead of a rejected request
+func validateInstanceLimits(opts *options, mgmtAPI kafkamgmtclient.DefaultApi, api *kafkainstanceclient.APIClient, kafkaInstance *kafkamgmtclient.KafkaRequest) error {
+	limits, err := kafkautil.GetKafkaInstanceLimits(opts.Context, mgmtAPI, kafkaInstance)
+	if err != nil || limits == nil {
+		opts.Logger.Debug("Skipping validation against the instance limits, limits are not available:", err)
+		return nil
+	}
+
+	topics, err := topiccmdutil.FetchAllTopics(opts.Context, api)
+	if err != nil {
+		opts.Logger.Debug("Skipping validation against the instance limits, topics are not available:", err)
+		return nil
+	}
+
+	validator := topiccmdutil.Validator{
+		Localizer: opts.localizer,
+	}
+
+	return validator.ValidateInstanceLimits(limits, topiccmdutil.CountPartitions(topics), int(opts.partitions), opts.retentionMs, opts.retentionBytes)
+}
+
 func validateProperties(opts *options) (err error) {
 	validator := topiccmdutil.Validator{
 		Localizer: opts.localizer,
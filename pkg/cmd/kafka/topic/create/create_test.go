@@ -0,0 +1,39 @@
+package create
+
+import (
+	"reflect"
+	"testing"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
+)
+
+func TestLikeConfig(t *testing.T) {
+	source := []kafkainstanceclient.ConfigEntry{
+		*kafkainstanceclient.NewConfigEntry("retention.ms", "604800000"),
+		*kafkainstanceclient.NewConfigEntry("cleanup.policy", "compact"),
+		*kafkainstanceclient.NewConfigEntry("max.message.bytes", "1048588"),
+	}
+
+	tests := []struct {
+		name      string
+		overrides map[string]string
+		want      map[string]string
+	}{
+		{
+			name: "copies the source config",
+			want: map[string]string{"retention.ms": "604800000", "cleanup.policy": "compact", "max.message.bytes": "1048588"},
+		},
+		{
+			name:      "set values take precedence",
+			overrides: map[string]string{"retention.ms": "86400000", "retention.bytes": "1000"},
+			want:      map[string]string{"retention.ms": "86400000", "retention.bytes": "1000", "cleanup.policy": "compact", "max.message.bytes": "1048588"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := likeConfig(source, tt.overrides); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("likeConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
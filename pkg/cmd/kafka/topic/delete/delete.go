@@ -2,19 +2,26 @@ package delete
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
 	kafkaflagutil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
 
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/kafkacmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/topiccmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/checkpoint"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
 
-	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/confirm"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
 	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
 	"github.com/spf13/cobra"
 )
 
@@ -22,8 +29,11 @@ type options struct {
 	topicName string
 	kafkaID   string
 	force     bool
+	dryRun    bool
+	resume    bool
 
 	IO             *iostreams.IOStreams
+	Config         config.IConfig
 	Connection     factory.ConnectionFunc
 	Logger         logging.Logger
 	localizer      localize.Localizer
@@ -37,20 +47,40 @@ func NewDeleteTopicCommand(f *factory.Factory) *cobra.Command {
 		Connection:     f.Connection,
 		Logger:         f.Logger,
 		IO:             f.IOStreams,
+		Config:         f.Config,
 		localizer:      f.Localizer,
 		Context:        f.Context,
 		ServiceContext: f.ServiceContext,
 	}
 
 	cmd := &cobra.Command{
-		Use:     "delete",
+		Use:     "delete [name|pattern]",
 		Short:   opts.localizer.MustLocalize("kafka.topic.delete.cmd.shortDescription"),
 		Long:    opts.localizer.MustLocalize("kafka.topic.delete.cmd.longDescription"),
 		Example: opts.localizer.MustLocalize("kafka.topic.delete.cmd.example"),
-		Args:    cobra.NoArgs,
+		Args:    cobra.MaximumNArgs(1),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return kafkacmdutil.FilterValidTopicNameArgs(f, toComplete)
+		},
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
-			if !opts.IO.CanPrompt() && !opts.force {
-				return opts.localizer.MustLocalizeError("flag.error.requiredWhenNonInteractive", localize.NewEntry("Flag", "yes"))
+			if len(args) > 0 {
+				if opts.topicName != "" {
+					return opts.localizer.MustLocalizeError("kafka.topic.delete.error.nameAndArgument")
+				}
+				opts.topicName = args[0]
+			}
+
+			if opts.topicName == "" {
+				return opts.localizer.MustLocalizeError("kafka.topic.delete.error.nameRequired")
+			}
+
+			if !opts.dryRun {
+				if err := confirm.ValidateNonInteractive(opts.IO, opts.localizer, opts.force); err != nil {
+					return err
+				}
 			}
 
 			if opts.kafkaID == "" {
@@ -69,14 +99,14 @@ func NewDeleteTopicCommand(f *factory.Factory) *cobra.Command {
 
 	flags := kafkaflagutil.NewFlagSet(cmd, opts.localizer)
 
-	flags.StringVar(&opts.topicName, "name", "", opts.localizer.MustLocalize("kafka.topic.common.flag.name.description"))
-
-	_ = cmd.MarkFlagRequired("name")
+	flags.StringVar(&opts.topicName, "name", "", opts.localizer.MustLocalize("kafka.topic.delete.flag.name.description"))
 
 	_ = cmd.RegisterFlagCompletionFunc("name", func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return kafkacmdutil.FilterValidTopicNameArgs(f, toComplete)
 	})
+	flags.BoolVar(&opts.dryRun, "dry-run", false, opts.localizer.MustLocalize("kafka.topic.delete.flag.dryRun.description"))
 	flags.AddYes(&opts.force)
+	flags.AddResume(&opts.resume)
 	flags.AddInstanceID(&opts.kafkaID)
 
 	return cmd
@@ -94,65 +124,136 @@ func runCmd(opts *options) error {
 		return err
 	}
 
-	// perform delete topic API request
-	_, httpRes, err := api.TopicsApi.GetTopic(opts.Context, opts.topicName).Execute()
-	if httpRes != nil {
-		defer httpRes.Body.Close()
-	}
-
-	topicNameTmplPair := localize.NewEntry("TopicName", opts.topicName)
 	kafkaNameTmplPair := localize.NewEntry("InstanceName", kafkaInstance.GetName())
-	if err != nil {
-		if httpRes == nil {
+
+	var topicNames []string
+	if topiccmdutil.IsTopicPattern(opts.topicName) {
+		topics, err := topiccmdutil.FetchAllTopics(opts.Context, api)
+		if err != nil {
 			return err
 		}
-		if httpRes.StatusCode == http.StatusNotFound {
-			return opts.localizer.MustLocalizeError("kafka.topic.common.error.topicNotFoundError", topicNameTmplPair, kafkaNameTmplPair)
+
+		topicNames, err = topiccmdutil.MatchTopicNames(topics, opts.topicName)
+		if err != nil {
+			return err
+		}
+
+		if len(topicNames) == 0 {
+			return opts.localizer.MustLocalizeError("kafka.topic.delete.error.noMatchingTopics", localize.NewEntry("Pattern", opts.topicName), kafkaNameTmplPair)
+		}
+	} else {
+		_, httpRes, err := api.TopicsApi.GetTopic(opts.Context, opts.topicName).Execute()
+		if httpRes != nil {
+			defer httpRes.Body.Close()
 		}
+
+		if err != nil {
+			if httpRes == nil {
+				return err
+			}
+			if httpRes.StatusCode == http.StatusNotFound {
+				return opts.localizer.MustLocalizeError("kafka.topic.common.error.topicNotFoundError", localize.NewEntry("TopicName", opts.topicName), kafkaNameTmplPair)
+			}
+		}
+
+		topicNames = []string{opts.topicName}
+	}
+
+	// preview the topics which are deleted
+	opts.Logger.Info(opts.localizer.MustLocalizePlural("kafka.topic.delete.log.info.preview", len(topicNames),
+		localize.NewEntry("Count", len(topicNames)), kafkaNameTmplPair))
+	for _, name := range topicNames {
+		fmt.Fprintln(opts.IO.Out, name)
+	}
+
+	if opts.dryRun {
+		opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.delete.log.info.dryRun"))
+		return nil
 	}
 
 	if !opts.force {
-		promptConfirmName := &survey.Input{
-			Message: opts.localizer.MustLocalize("kafka.topic.delete.input.name.message", localize.NewEntry("TopicName", opts.topicName)),
+		message := opts.localizer.MustLocalize("kafka.topic.delete.input.name.message", localize.NewEntry("TopicName", opts.topicName))
+		if len(topicNames) > 1 || opts.topicName != topicNames[0] {
+			message = opts.localizer.MustLocalize("kafka.topic.delete.input.pattern.message", localize.NewEntry("Pattern", opts.topicName), localize.NewEntry("Count", len(topicNames)))
 		}
-		var userConfirmedName string
-		if err = survey.AskOne(promptConfirmName, &userConfirmedName); err != nil {
+		if err = confirm.Name(opts.localizer, message, opts.topicName); err != nil {
 			return err
 		}
+	}
 
-		if userConfirmedName != opts.topicName {
-			return opts.localizer.MustLocalizeError("kafka.topic.delete.error.mismatchedNameConfirmation", localize.NewEntry("ConfirmedName", userConfirmedName), localize.NewEntry("ActualName", opts.topicName))
+	if len(topicNames) == 1 {
+		if err = deleteTopic(opts, api, kafkaInstance.GetName(), topicNames[0]); err != nil {
+			return err
 		}
+		opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.delete.log.info.topicDeleted", localize.NewEntry("TopicName", topicNames[0]), kafkaNameTmplPair))
+		return nil
 	}
 
-	// perform delete topic API request
-	httpRes, err = api.TopicsApi.DeleteTopic(opts.Context, opts.topicName).Execute()
-	if httpRes != nil {
-		defer httpRes.Body.Close()
+	store, err := checkpoint.DefaultStore(opts.Config)
+	if err != nil {
+		return err
 	}
+	progress, err := store.Open(strings.Join([]string{"kafka topic delete", opts.kafkaID, opts.topicName}, " "), opts.resume)
 	if err != nil {
-		if httpRes == nil {
-			return err
-		}
+		return err
+	}
+	if skipped := len(progress.Completed); skipped > 0 {
+		opts.Logger.Info(opts.localizer.MustLocalizePlural("common.checkpoint.log.info.resuming", skipped, localize.NewEntry("Count", skipped)))
+	}
 
-		operationTmplPair := localize.NewEntry("Operation", "delete")
-		switch httpRes.StatusCode {
-		case http.StatusNotFound:
-			return opts.localizer.MustLocalizeError("kafka.topic.common.error.notFoundError", topicNameTmplPair, kafkaNameTmplPair)
-		case http.StatusUnauthorized:
-			return opts.localizer.MustLocalizeError("kafka.topic.common.error.unauthorized", operationTmplPair)
-		case http.StatusForbidden:
-			return opts.localizer.MustLocalizeError("kafka.topic.common.error.forbidden", operationTmplPair)
-		case http.StatusInternalServerError:
-			return opts.localizer.MustLocalizeError("kafka.topic.common.error.internalServerError")
-		case http.StatusServiceUnavailable:
-			return opts.localizer.MustLocalizeError("kafka.topic.common.error.unableToConnectToKafka", localize.NewEntry("Name", kafkaInstance.GetName()))
-		default:
+	// delete all matching topics, reporting the failures at the end
+	var failed int
+	for _, name := range topicNames {
+		if progress.IsCompleted(name) {
+			continue
+		}
+		if err = deleteTopic(opts, api, kafkaInstance.GetName(), name); err != nil {
+			failed++
+			opts.Logger.Info(icon.ErrorPrefix(), opts.localizer.MustLocalize("kafka.topic.delete.log.info.topicFailed", localize.NewEntry("TopicName", name), localize.NewEntry("Error", err)))
+			continue
+		}
+		opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("kafka.topic.delete.log.info.topicDeleted", localize.NewEntry("TopicName", name), kafkaNameTmplPair))
+		if err = progress.Complete(name); err != nil {
 			return err
 		}
 	}
 
-	opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.delete.log.info.topicDeleted", topicNameTmplPair, kafkaNameTmplPair))
+	if failed > 0 {
+		opts.Logger.Info(opts.localizer.MustLocalize("common.checkpoint.log.info.resumeHint"))
+		return opts.localizer.MustLocalizeError("kafka.topic.delete.error.someFailed", localize.NewEntry("Failed", failed), localize.NewEntry("Count", len(topicNames)))
+	}
+
+	return progress.Remove()
+}
 
-	return nil
+// deleteTopic deletes a single topic and translates the API errors
+func deleteTopic(opts *options, api *kafkainstanceclient.APIClient, instanceName string, topicName string) error {
+	httpRes, err := api.TopicsApi.DeleteTopic(opts.Context, topicName).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err == nil {
+		return nil
+	}
+	if httpRes == nil {
+		return err
+	}
+
+	topicNameTmplPair := localize.NewEntry("TopicName", topicName)
+	kafkaNameTmplPair := localize.NewEntry("InstanceName", instanceName)
+	operationTmplPair := localize.NewEntry("Operation", "delete")
+	switch httpRes.StatusCode {
+	case http.StatusNotFound:
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.notFoundError", topicNameTmplPair, kafkaNameTmplPair)
+	case http.StatusUnauthorized:
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.unauthorized", operationTmplPair)
+	case http.StatusForbidden:
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.forbidden", operationTmplPair)
+	case http.StatusInternalServerError:
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.internalServerError")
+	case http.StatusServiceUnavailable:
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.unableToConnectToKafka", localize.NewEntry("Name", instanceName))
+	default:
+		return err
+	}
 }
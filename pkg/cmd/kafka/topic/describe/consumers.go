@@ -0,0 +1,78 @@
+package describe
+
+import (
+	"context"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
+)
+
+// consumerGroupsPageSize is the number of consumer groups requested per page
+const consumerGroupsPageSize = 100
+
+// topicConsumerGroup summarizes how a consumer group consumes the topic
+type topicConsumerGroup struct {
+	GroupID           string `json:"groupId" yaml:"groupId"`
+	State             string `json:"state" yaml:"state"`
+	ActiveMembers     int    `json:"activeMembers" yaml:"activeMembers"`
+	Partitions        int    `json:"partitions" yaml:"partitions"`
+	PartitionsWithLag int    `json:"partitionsWithLag" yaml:"partitionsWithLag"`
+	TotalLag          int64  `json:"totalLag" yaml:"totalLag"`
+	MaxLag            int64  `json:"maxLag" yaml:"maxLag"`
+}
+
+// fetchTopicConsumerGroups fetches every consumer group which has consumed the topic
+func fetchTopicConsumerGroups(ctx context.Context, api *kafkainstanceclient.APIClient, topic string) ([]kafkainstanceclient.ConsumerGroup, error) {
+	var groups []kafkainstanceclient.ConsumerGroup
+	for page := int32(1); ; page++ {
+		list, httpRes, err := api.GroupsApi.GetConsumerGroups(ctx).
+			Topic(topic).
+			Page(page).
+			Size(consumerGroupsPageSize).
+			Execute()
+		if httpRes != nil {
+			httpRes.Body.Close()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, list.GetItems()...)
+		if len(list.GetItems()) == 0 || len(groups) >= int(list.GetTotal()) {
+			return groups, nil
+		}
+	}
+}
+
+// summarizeConsumerGroups computes the members and lag of each group on the topic
+func summarizeConsumerGroups(groups []kafkainstanceclient.ConsumerGroup, topic string) []topicConsumerGroup {
+	summaries := make([]topicConsumerGroup, 0, len(groups))
+	for _, group := range groups {
+		summary := topicConsumerGroup{
+			GroupID: group.GetGroupId(),
+			State:   string(group.GetState()),
+		}
+
+		members := map[string]bool{}
+		for _, consumer := range group.GetConsumers() {
+			if consumer.GetTopic() != topic {
+				continue
+			}
+
+			summary.Partitions++
+			summary.TotalLag += consumer.GetLag()
+			if consumer.GetLag() > 0 {
+				summary.PartitionsWithLag++
+			}
+			if consumer.GetLag() > summary.MaxLag {
+				summary.MaxLag = consumer.GetLag()
+			}
+			if memberID := consumer.GetMemberId(); memberID != "" {
+				members[memberID] = true
+			}
+		}
+		summary.ActiveMembers = len(members)
+
+		summaries = append(summaries, summary)
+	}
+	return summaries
+}
@@ -0,0 +1,41 @@
+package describe
+
+import (
+	"reflect"
+	"testing"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
+)
+
+func Test_summarizeConsumerGroups(t *testing.T) {
+	member := func(id string) *string { return &id }
+	stable := kafkainstanceclient.CONSUMERGROUPSTATE_STABLE
+
+	groups := []kafkainstanceclient.ConsumerGroup{
+		{
+			GroupId: "orders-service",
+			State:   &stable,
+			Consumers: []kafkainstanceclient.Consumer{
+				{Topic: "orders", Partition: 0, Lag: 10, MemberId: member("consumer-1")},
+				{Topic: "orders", Partition: 1, Lag: 0, MemberId: member("consumer-1")},
+				{Topic: "orders", Partition: 2, Lag: 25, MemberId: member("consumer-2")},
+				{Topic: "payments", Partition: 0, Lag: 100, MemberId: member("consumer-3")},
+			},
+		},
+		{
+			GroupId: "audit",
+			Consumers: []kafkainstanceclient.Consumer{
+				{Topic: "orders", Partition: 0, Lag: 5},
+			},
+		},
+	}
+
+	want := []topicConsumerGroup{
+		{GroupID: "orders-service", State: "STABLE", ActiveMembers: 2, Partitions: 3, PartitionsWithLag: 2, TotalLag: 35, MaxLag: 25},
+		{GroupID: "audit", Partitions: 1, PartitionsWithLag: 1, TotalLag: 5, MaxLag: 5},
+	}
+
+	if got := summarizeConsumerGroups(groups, "orders"); !reflect.DeepEqual(got, want) {
+		t.Errorf("summarizeConsumerGroups() = %+v, want %+v", got, want)
+	}
+}
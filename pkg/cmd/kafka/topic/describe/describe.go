@@ -2,6 +2,7 @@ package describe
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 
 	kafkaflagutil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
@@ -14,6 +15,7 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
 	"github.com/spf13/cobra"
 )
 
@@ -21,6 +23,8 @@ type options struct {
 	name         string
 	kafkaID      string
 	outputFormat string
+	consumers    bool
+	partitions   bool
 
 	IO             *iostreams.IOStreams
 	Connection     factory.ConnectionFunc
@@ -79,6 +83,15 @@ func NewDescribeTopicCommand(f *factory.Factory) *cobra.Command {
 	})
 	_ = cmd.MarkFlagRequired("name")
 
+	flags.BoolVar(&opts.partitions, "partitions", false, opts.localizer.MustLocalize("kafka.topic.describe.flag.partitions"))
+	flags.BoolVar(&opts.consumers, "consumers", false, opts.localizer.MustLocalize("kafka.topic.describe.flag.consumers"))
+	flags.AddPrintSchemaFunc(func() interface{} {
+		if opts.consumers || opts.partitions {
+			return topicDocument{}
+		}
+		return kafkainstanceclient.Topic{}
+	})
+
 	flagutil.EnableOutputFlagCompletion(cmd)
 
 	return cmd
@@ -126,5 +139,49 @@ func runCmd(opts *options) error {
 		}
 	}
 
-	return dump.Formatted(opts.IO.Out, opts.outputFormat, topicResponse)
+	if !opts.consumers && !opts.partitions {
+		return dump.Formatted(opts.IO.Out, opts.outputFormat, topicResponse)
+	}
+
+	document, err := toDocument(topicResponse)
+	if err != nil {
+		return err
+	}
+
+	if opts.partitions {
+		partitions, err := fetchPartitionDetails(opts.Context, api, topicResponse)
+		if err != nil {
+			return err
+		}
+		document["partitions"] = partitions
+	}
+
+	if opts.consumers {
+		groups, err := fetchTopicConsumerGroups(opts.Context, api, opts.name)
+		if err != nil {
+			return err
+		}
+		document["consumerGroups"] = summarizeConsumerGroups(groups, opts.name)
+	}
+
+	return dump.Formatted(opts.IO.Out, opts.outputFormat, document)
+}
+
+// topicDocument describes the document printed when details are added to the topic
+type topicDocument struct {
+	kafkainstanceclient.Topic
+	Partitions     []partitionDetail    `json:"partitions,omitempty"`
+	ConsumerGroups []topicConsumerGroup `json:"consumerGroups,omitempty"`
+}
+
+// toDocument converts the topic to a generic document so that details can be added to it
+func toDocument(topic kafkainstanceclient.Topic) (map[string]interface{}, error) {
+	data, err := json.Marshal(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	var document map[string]interface{}
+	err = json.Unmarshal(data, &document)
+	return document, err
 }
@@ -0,0 +1,99 @@
+package describe
+
+import (
+	"context"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
+)
+
+// partitionDetail is the replication state and offsets of a partition
+type partitionDetail struct {
+	Partition       int32   `json:"partition" yaml:"partition"`
+	Leader          *int32  `json:"leader" yaml:"leader"`
+	Replicas        []int32 `json:"replicas" yaml:"replicas"`
+	Isr             []int32 `json:"isr" yaml:"isr"`
+	UnderReplicated bool    `json:"underReplicated" yaml:"underReplicated"`
+	// EarliestOffset is the offset of the first record retained, nil when the partition is empty
+	EarliestOffset *int64 `json:"earliestOffset" yaml:"earliestOffset"`
+	// LatestOffset is the offset the next record will be written at, nil when the partition is empty
+	LatestOffset *int64 `json:"latestOffset" yaml:"latestOffset"`
+}
+
+// fetchPartitionDetails fetches the earliest and latest offsets of every partition of the topic
+func fetchPartitionDetails(ctx context.Context, api *kafkainstanceclient.APIClient, topic kafkainstanceclient.Topic) ([]partitionDetail, error) {
+	details := mapPartitions(topic.GetPartitions())
+
+	for i := range details {
+		// the first record from offset 0 is the earliest record still retained
+		earliest, err := consumeOne(api.RecordsApi.ConsumeRecords(ctx, topic.GetName()).
+			Partition(details[i].Partition).
+			Offset(0))
+		if err != nil {
+			return nil, err
+		}
+		if earliest == nil {
+			continue
+		}
+		details[i].EarliestOffset = earliest
+
+		// without an offset or timestamp the most recent records are returned
+		latest, err := consumeOne(api.RecordsApi.ConsumeRecords(ctx, topic.GetName()).
+			Partition(details[i].Partition))
+		if err != nil {
+			return nil, err
+		}
+		if latest != nil {
+			next := *latest + 1
+			details[i].LatestOffset = &next
+		}
+	}
+
+	return details, nil
+}
+
+// consumeOne returns the offset of the last record of a single record request, nil when there are no records
+func consumeOne(request kafkainstanceclient.ApiConsumeRecordsRequest) (*int64, error) {
+	records, httpRes, err := request.
+		Limit(1).
+		Include([]kafkainstanceclient.RecordIncludedProperty{kafkainstanceclient.RECORDINCLUDEDPROPERTY_OFFSET}).
+		Execute()
+	if httpRes != nil {
+		httpRes.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	items := records.GetItems()
+	if len(items) == 0 {
+		return nil, nil
+	}
+	return items[len(items)-1].Offset, nil
+}
+
+// mapPartitions flattens the nodes of each partition to their broker IDs
+func mapPartitions(partitions []kafkainstanceclient.Partition) []partitionDetail {
+	details := make([]partitionDetail, len(partitions))
+	for i, p := range partitions {
+		details[i] = partitionDetail{
+			Partition: p.GetPartition(),
+			Replicas:  nodeIDs(p.GetReplicas()),
+			Isr:       nodeIDs(p.GetIsr()),
+		}
+		if p.Leader != nil {
+			details[i].Leader = p.Leader.Id
+		}
+		details[i].UnderReplicated = len(details[i].Isr) < len(details[i].Replicas)
+	}
+	return details
+}
+
+func nodeIDs(nodes []kafkainstanceclient.Node) []int32 {
+	ids := make([]int32, 0, len(nodes))
+	for _, n := range nodes {
+		if n.Id != nil {
+			ids = append(ids, *n.Id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,37 @@
+package describe
+
+import (
+	"reflect"
+	"testing"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
+)
+
+func Test_mapPartitions(t *testing.T) {
+	node := func(id int32) kafkainstanceclient.Node { return kafkainstanceclient.Node{Id: &id} }
+	nodes := func(ids ...int32) *[]kafkainstanceclient.Node {
+		n := make([]kafkainstanceclient.Node, len(ids))
+		for i, id := range ids {
+			n[i] = node(id)
+		}
+		return &n
+	}
+	leader := node(1)
+	leaderID := int32(1)
+
+	partitions := []kafkainstanceclient.Partition{
+		{Partition: 0, Leader: &leader, Replicas: nodes(1, 2, 3), Isr: nodes(1, 2, 3)},
+		{Partition: 1, Leader: &leader, Replicas: nodes(1, 2, 3), Isr: nodes(1)},
+		{Partition: 2},
+	}
+
+	want := []partitionDetail{
+		{Partition: 0, Leader: &leaderID, Replicas: []int32{1, 2, 3}, Isr: []int32{1, 2, 3}},
+		{Partition: 1, Leader: &leaderID, Replicas: []int32{1, 2, 3}, Isr: []int32{1}, UnderReplicated: true},
+		{Partition: 2, Replicas: []int32{}, Isr: []int32{}},
+	}
+
+	if got := mapPartitions(partitions); !reflect.DeepEqual(got, want) {
+		t.Errorf("mapPartitions() = %+v, want %+v", got, want)
+	}
+}
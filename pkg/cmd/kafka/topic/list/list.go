@@ -2,9 +2,12 @@ package list
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"sort"
 
 	kafkaflagutil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/kafkacmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/topiccmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
@@ -13,9 +16,12 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
 	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/connection"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/kafkautil"
 	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
 
 	"github.com/spf13/cobra"
 
@@ -35,6 +41,9 @@ type options struct {
 	search  string
 	page    int32
 	size    int32
+
+	withSize bool
+	sortBy   string
 }
 
 type topicRow struct {
@@ -44,6 +53,20 @@ type topicRow struct {
 	RetentionSize   string `json:"retention.bytes,omitempty" header:"Retention size (bytes)"`
 }
 
+type topicSizeRow struct {
+	Name            string `json:"name" yaml:"name" header:"Name"`
+	PartitionsCount int    `json:"partitions_count" yaml:"partitions_count" header:"Partitions"`
+	SizeBytes       int64  `json:"size_bytes" yaml:"size_bytes" header:"Size (bytes)"`
+	StorageUsage    string `json:"storage_usage,omitempty" yaml:"storage_usage,omitempty" header:"Instance storage used"`
+}
+
+const (
+	sortByName = "name"
+	sortBySize = "size"
+)
+
+var validSortByValues = []string{sortByName, sortBySize}
+
 // NewListTopicCommand gets a new command for getting kafkas.
 func NewListTopicCommand(f *factory.Factory) *cobra.Command {
 	opts := &options{
@@ -56,11 +79,12 @@ func NewListTopicCommand(f *factory.Factory) *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:     "list",
-		Short:   opts.localizer.MustLocalize("kafka.topic.list.cmd.shortDescription"),
-		Long:    opts.localizer.MustLocalize("kafka.topic.list.cmd.longDescription"),
-		Example: opts.localizer.MustLocalize("kafka.topic.list.cmd.example"),
-		Args:    cobra.NoArgs,
+		Use:         "list",
+		Short:       opts.localizer.MustLocalize("kafka.topic.list.cmd.shortDescription"),
+		Long:        opts.localizer.MustLocalize("kafka.topic.list.cmd.longDescription"),
+		Example:     opts.localizer.MustLocalize("kafka.topic.list.cmd.example"),
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{cmdutil.PagerAnnotation: "true"},
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if opts.output != "" {
 				if err := flagutil.ValidateOutput(opts.output); err != nil {
@@ -76,6 +100,14 @@ func NewListTopicCommand(f *factory.Factory) *cobra.Command {
 				return opts.localizer.MustLocalizeError("kafka.common.validation.size.error.invalid.minValue", localize.NewEntry("Size", opts.size))
 			}
 
+			if !flagutil.IsValidInput(opts.sortBy, validSortByValues...) {
+				return flagutil.InvalidValueError("sort-by", opts.sortBy, validSortByValues...)
+			}
+
+			if opts.sortBy == sortBySize {
+				opts.withSize = true
+			}
+
 			if opts.search != "" {
 				validator := topiccmdutil.Validator{
 					Localizer: opts.localizer,
@@ -107,7 +139,17 @@ func NewListTopicCommand(f *factory.Factory) *cobra.Command {
 	flags.Int32VarP(&opts.page, "page", "", cmdutil.ConvertPageValueToInt32(build.DefaultPageNumber), opts.localizer.MustLocalize("kafka.topic.list.flag.page.description"))
 	flags.Int32VarP(&opts.size, "size", "", cmdutil.ConvertSizeValueToInt32(build.DefaultPageSize), opts.localizer.MustLocalize("kafka.topic.list.flag.size.description"))
 
+	flags.BoolVar(&opts.withSize, "with-size", false, opts.localizer.MustLocalize("kafka.topic.list.flag.withSize.description"))
+	flags.StringVar(&opts.sortBy, "sort-by", sortByName, opts.localizer.MustLocalize("kafka.topic.list.flag.sortBy.description"))
+	flags.AddPrintSchemaFunc(func() interface{} {
+		if opts.withSize {
+			return dump.NewList("TopicList", 0, 0, 0, []topicSizeRow{})
+		}
+		return dump.NewList("TopicList", 0, 0, 0, []kafkainstanceclient.Topic{})
+	})
+
 	flagutil.EnableOutputFlagCompletion(cmd)
+	flagutil.EnableStaticFlagCompletion(cmd, "sort-by", validSortByValues)
 
 	return cmd
 }
@@ -123,6 +165,10 @@ func runCmd(opts *options) error {
 		return err
 	}
 
+	if opts.sortBy == sortBySize {
+		return runSortedBySize(opts, conn, api, kafkaInstance)
+	}
+
 	a := api.TopicsApi.GetTopics(opts.Context)
 
 	if opts.search != "" {
@@ -166,13 +212,22 @@ func runCmd(opts *options) error {
 	}
 
 	stdout := opts.IO.Out
+
+	if opts.withSize {
+		rows, err := mapTopicResultsToSizeRows(opts, conn, kafkaInstance, topicData.GetItems())
+		if err != nil {
+			return err
+		}
+		return printSizeRows(opts, rows)
+	}
+
 	switch opts.output {
 	case dump.EmptyFormat:
 		topics := topicData.GetItems()
 		rows := mapTopicResultsToTableFormat(topics)
 		dump.Table(stdout, rows)
 	default:
-		return dump.Formatted(stdout, opts.output, topicData)
+		return dump.Formatted(stdout, opts.output, kafkacmdutil.NewListDocument(&topicData, "TopicList", opts.page, opts.size, topicData.GetItems()))
 	}
 
 	return nil
@@ -213,3 +268,87 @@ func mapTopicResultsToTableFormat(topics []kafkainstanceclient.Topic) []topicRow
 
 	return rows
 }
+
+// runSortedBySize lists the topics sorted by size. All topics are fetched, so that the largest topics
+// of the instance are on the first page, and not only the largest topics of the requested page.
+func runSortedBySize(opts *options, conn connection.Connection, api *kafkainstanceclient.APIClient, kafkaInstance *kafkamgmtclient.KafkaRequest) error {
+	topics, err := topiccmdutil.FetchFilteredTopics(opts.Context, api, opts.search)
+	if err != nil {
+		return err
+	}
+
+	if len(topics) == 0 && opts.output == "" {
+		opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.list.log.info.noTopics", localize.NewEntry("InstanceName", kafkaInstance.GetName())))
+		return nil
+	}
+
+	rows, err := mapTopicResultsToSizeRows(opts, conn, kafkaInstance, topics)
+	if err != nil {
+		return err
+	}
+
+	return printSizeRows(opts, pageOfRows(rows, opts.page, opts.size))
+}
+
+func printSizeRows(opts *options, rows []topicSizeRow) error {
+	if opts.output == dump.EmptyFormat {
+		dump.Table(opts.IO.Out, rows)
+		return nil
+	}
+	// the size rows keep their bare array, which existing scripts read with '.[]'
+	return dump.Formatted(opts.IO.Out, opts.output, rows)
+}
+
+// pageOfRows returns the rows of the page, pages are numbered from 1
+func pageOfRows(rows []topicSizeRow, page int32, size int32) []topicSizeRow {
+	start := int(page-1) * int(size)
+	if start >= len(rows) {
+		return []topicSizeRow{}
+	}
+
+	end := start + int(size)
+	if end > len(rows) {
+		end = len(rows)
+	}
+
+	return rows[start:end]
+}
+
+// mapTopicResultsToSizeRows joins the topics with the disk usage reported by the metrics API
+func mapTopicResultsToSizeRows(opts *options, conn connection.Connection, kafkaInstance *kafkamgmtclient.KafkaRequest, topics []kafkainstanceclient.Topic) ([]topicSizeRow, error) {
+	mgmtAPI := conn.API().KafkaMgmt()
+
+	sizes, err := kafkautil.GetTopicSizes(opts.Context, mgmtAPI, kafkaInstance.GetId())
+	if err != nil {
+		return nil, opts.localizer.MustLocalizeError("kafka.topic.list.error.metricsUnavailable", localize.NewEntry("Error", err))
+	}
+
+	var maxStorage int64
+	limits, err := kafkautil.GetKafkaInstanceLimits(opts.Context, mgmtAPI, kafkaInstance)
+	if err != nil {
+		opts.Logger.Debug("Could not fetch the limits of Kafka instance", kafkaInstance.GetName(), err)
+	} else if limits != nil {
+		maxStorage = limits.MaxDataRetentionSize
+	}
+
+	rows := make([]topicSizeRow, len(topics))
+	for i, t := range topics {
+		row := topicSizeRow{
+			Name:            t.GetName(),
+			PartitionsCount: len(t.GetPartitions()),
+			SizeBytes:       sizes[t.GetName()],
+		}
+		if maxStorage > 0 {
+			row.StorageUsage = fmt.Sprintf("%.2f%%", float64(row.SizeBytes)*100/float64(maxStorage))
+		}
+		rows[i] = row
+	}
+
+	if opts.sortBy == sortBySize {
+		sort.SliceStable(rows, func(i, j int) bool {
+			return rows[i].SizeBytes > rows[j].SizeBytes
+		})
+	}
+
+	return rows, nil
+}
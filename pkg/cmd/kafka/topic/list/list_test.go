@@ -0,0 +1,98 @@
+package list
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory/fakes"
+)
+
+func Test_pageOfRows(t *testing.T) {
+	rows := []topicSizeRow{{Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d"}, {Name: "e"}}
+
+	tests := []struct {
+		name string
+		page int32
+		size int32
+		want []string
+	}{
+		{name: "first page", page: 1, size: 2, want: []string{"a", "b"}},
+		{name: "last page", page: 3, size: 2, want: []string{"e"}},
+		{name: "after the last page", page: 4, size: 2, want: []string{}},
+		{name: "page larger than the rows", page: 1, size: 10, want: []string{"a", "b", "c", "d", "e"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, row := range pageOfRows(rows, tt.page, tt.size) {
+				got = append(got, row.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("pageOfRows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListCommand_sortBySize(t *testing.T) {
+	f := fakes.NewFactory(t)
+
+	mux := http.NewServeMux()
+	server := f.WithServer(t, mux)
+
+	mux.HandleFunc("/api/kafkas_mgmt/v1/kafkas/k1", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{
+			"id": "k1", "name": "kafka", "status": "ready",
+			"bootstrap_server_host": "kafka:443", "admin_api_server_url": server.URL,
+		})
+	})
+	mux.HandleFunc("/api/v1/topics", func(w http.ResponseWriter, r *http.Request) {
+		// the topics are returned by name, the size sort is done by the CLI
+		var items []map[string]interface{}
+		for _, name := range []string{"a", "b", "c", "d"} {
+			items = append(items, map[string]interface{}{
+				"name":       name,
+				"partitions": []map[string]interface{}{{"partition": 0}},
+			})
+		}
+		writeJSON(w, map[string]interface{}{"items": items, "page": 1, "size": len(items), "total": len(items)})
+	})
+	mux.HandleFunc("/api/kafkas_mgmt/v1/kafkas/k1/metrics/query", func(w http.ResponseWriter, r *http.Request) {
+		var items []map[string]interface{}
+		for topic, size := range map[string]int{"a": 10, "b": 30, "c": 20, "d": 40} {
+			// each partition is reported by its two replicas
+			for _, broker := range []string{"0", "1"} {
+				items = append(items, map[string]interface{}{
+					"metric": map[string]string{"__name__": "kafka_log_log_size", "topic": topic, "partition": "0", "broker": broker},
+					"value":  size,
+				})
+			}
+		}
+		writeJSON(w, map[string]interface{}{"kind": "MetricsInstantQueryList", "id": "k1", "items": items})
+	})
+
+	cmd := NewListTopicCommand(f.Factory)
+	cmd.SetArgs([]string{"--instance-id", "k1", "--sort-by", "size", "--size", "2", "-o", "json"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() error = %v, stderr: %v", err, f.ErrOut.String())
+	}
+
+	var got []topicSizeRow
+	if err := json.Unmarshal(f.Out.Bytes(), &got); err != nil {
+		t.Fatalf("invalid output %q: %v", f.Out.String(), err)
+	}
+	want := []topicSizeRow{
+		{Name: "d", PartitionsCount: 1, SizeBytes: 40},
+		{Name: "b", PartitionsCount: 1, SizeBytes: 30},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("output = %+v, want %+v", got, want)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,236 @@
+package migrate
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	kafkaflagutil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/topiccmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// strimziAPIVersion is the API version of the KafkaTopic resources written by the export
+	strimziAPIVersion = "kafka.strimzi.io/v1beta2"
+	// strimziClusterLabel is the label binding a KafkaTopic to its Kafka cluster
+	strimziClusterLabel = "strimzi.io/cluster"
+
+	formatStrimzi = "strimzi"
+)
+
+var exportFormats = []string{formatStrimzi}
+
+// invalidResourceNameChars matches the characters which are not allowed in the name of a Kubernetes resource
+var invalidResourceNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+type exportOptions struct {
+	format    string
+	outputDir string
+	cluster   string
+	kafkaID   string
+
+	IO         *iostreams.IOStreams
+	Connection factory.ConnectionFunc
+	Logger     logging.Logger
+	localizer  localize.Localizer
+	Context    context.Context
+}
+
+// NewExportCommand creates a new command for writing the topics of a Kafka instance as Kubernetes resources
+func NewExportCommand(f *factory.Factory) *cobra.Command {
+	opts := &exportOptions{
+		IO:         f.IOStreams,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "export",
+		Short:   f.Localizer.MustLocalize("kafka.topic.export.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("kafka.topic.export.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("kafka.topic.export.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !flagutil.IsValidInput(opts.format, exportFormats...) {
+				return flagutil.InvalidValueError("format", opts.format, exportFormats...)
+			}
+
+			if opts.kafkaID == "" {
+				kafkaInstance, err := contextutil.GetCurrentKafkaInstance(f)
+				if err != nil {
+					return err
+				}
+
+				opts.kafkaID = kafkaInstance.GetId()
+			}
+
+			return runExport(opts)
+		},
+	}
+
+	flags := kafkaflagutil.NewFlagSet(cmd, f.Localizer)
+	flags.StringVar(&opts.format, "format", formatStrimzi, f.Localizer.MustLocalize("kafka.topic.export.flag.format.description"))
+	flags.StringVar(&opts.outputDir, "output", "", f.Localizer.MustLocalize("kafka.topic.export.flag.output.description"))
+	flags.StringVar(&opts.cluster, "cluster", "", f.Localizer.MustLocalize("kafka.topic.export.flag.cluster.description"))
+	flags.AddInstanceID(&opts.kafkaID)
+
+	_ = cmd.RegisterFlagCompletionFunc("format", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return exportFormats, cobra.ShellCompDirectiveNoSpace
+	})
+
+	return cmd
+}
+
+func runExport(opts *exportOptions) error {
+	conn, err := opts.Connection()
+	if err != nil {
+		return err
+	}
+
+	api, kafkaInstance, err := conn.API().KafkaAdmin(opts.kafkaID)
+	if err != nil {
+		return err
+	}
+
+	topics, err := topiccmdutil.FetchAllTopics(opts.Context, api)
+	if err != nil {
+		return err
+	}
+
+	var resources []topiccmdutil.StrimziTopic
+	for i := range topics {
+		if topics[i].GetIsInternal() {
+			continue
+		}
+		resources = append(resources, toStrimziTopic(&topics[i], opts.cluster))
+	}
+	uniqueResourceNames(resources)
+
+	if len(resources) == 0 {
+		opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.list.log.info.noTopics", localize.NewEntry("InstanceName", kafkaInstance.GetName())))
+		return nil
+	}
+
+	// without an output directory all resources are written to stdout as a single YAML stream
+	if opts.outputDir == "" {
+		for _, resource := range resources {
+			data, err := yaml.Marshal(resource)
+			if err != nil {
+				return err
+			}
+			if _, err = opts.IO.Out.Write(append([]byte("---\n"), data...)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err = os.MkdirAll(opts.outputDir, 0o755); err != nil {
+		return err
+	}
+
+	for _, resource := range resources {
+		data, err := yaml.Marshal(resource)
+		if err != nil {
+			return err
+		}
+		if err = os.WriteFile(filepath.Join(opts.outputDir, resource.Metadata.Name+".yaml"), data, 0o600); err != nil {
+			return err
+		}
+	}
+
+	opts.Logger.Info(opts.localizer.MustLocalizePlural("kafka.topic.export.log.info.exported", len(resources),
+		localize.NewEntry("Count", len(resources)),
+		localize.NewEntry("InstanceName", kafkaInstance.GetName()),
+		localize.NewEntry("Dir", opts.outputDir),
+	))
+
+	return nil
+}
+
+// toStrimziTopic describes a topic as a Strimzi KafkaTopic resource.
+// When the topic name is not a valid resource name, it is kept in the topicName of the spec.
+func toStrimziTopic(topic *kafkainstanceclient.Topic, cluster string) topiccmdutil.StrimziTopic {
+	var resource topiccmdutil.StrimziTopic
+	resource.APIVersion = strimziAPIVersion
+	resource.Kind = topiccmdutil.StrimziTopicKind
+	resource.Metadata.Name = resourceName(topic.GetName())
+	if resource.Metadata.Name != topic.GetName() {
+		resource.Spec.TopicName = topic.GetName()
+	}
+	if cluster != "" {
+		resource.Metadata.Labels = map[string]string{strimziClusterLabel: cluster}
+	}
+
+	partitions := topic.GetPartitions()
+	resource.Spec.Partitions = int32(len(partitions))
+	if len(partitions) > 0 {
+		resource.Spec.Replicas = int32(len(partitions[0].GetReplicas()))
+	}
+
+	for _, entry := range topic.GetConfig() {
+		if resource.Spec.Config == nil {
+			resource.Spec.Config = map[string]interface{}{}
+		}
+		resource.Spec.Config[entry.Key] = configValue(entry.Value)
+	}
+
+	return resource
+}
+
+// resourceName converts a topic name to a valid Kubernetes resource name
+func resourceName(topicName string) string {
+	name := invalidResourceNameChars.ReplaceAllString(strings.ToLower(topicName), "-")
+	return strings.Trim(name, ".-")
+}
+
+// uniqueResourceNames suffixes the converted resource names which are empty or shared by several topics,
+// such as "Orders" and "orders", with a hash of the topic name, so that no resource or file overwrites another.
+// Topic names which are valid resource names are unique in the instance, so they are kept.
+func uniqueResourceNames(resources []topiccmdutil.StrimziTopic) {
+	count := map[string]int{}
+	for _, resource := range resources {
+		count[resource.Metadata.Name]++
+	}
+
+	for i := range resources {
+		resource := &resources[i]
+		if resource.Spec.TopicName == "" {
+			continue
+		}
+		if resource.Metadata.Name != "" && count[resource.Metadata.Name] == 1 {
+			continue
+		}
+
+		hash := sha256.Sum256([]byte(resource.Spec.TopicName))
+		resource.Metadata.Name = strings.TrimPrefix(resource.Metadata.Name+"-"+hex.EncodeToString(hash[:4]), "-")
+	}
+}
+
+// configValue keeps numbers and booleans typed in the YAML of the resource
+func configValue(value string) interface{} {
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return n
+	}
+	if value == "true" || value == "false" {
+		return value == "true"
+	}
+	return value
+}
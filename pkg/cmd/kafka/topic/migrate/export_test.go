@@ -0,0 +1,101 @@
+package migrate
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/topiccmdutil"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
+	"gopkg.in/yaml.v2"
+)
+
+func TestResourceName(t *testing.T) {
+	tests := []struct {
+		topicName string
+		want      string
+	}{
+		{topicName: "orders", want: "orders"},
+		{topicName: "orders.v1", want: "orders.v1"},
+		{topicName: "Orders_Topic", want: "orders-topic"},
+		{topicName: "_private", want: "private"},
+	}
+	for _, tt := range tests {
+		if got := resourceName(tt.topicName); got != tt.want {
+			t.Errorf("resourceName(%q) = %q, want %q", tt.topicName, got, tt.want)
+		}
+	}
+}
+
+func TestStrimziTopicRoundTrip(t *testing.T) {
+	topic := kafkainstanceclient.NewTopic()
+	topic.SetName("Orders_Topic")
+	topic.SetPartitions([]kafkainstanceclient.Partition{
+		{Partition: 0, Replicas: &[]kafkainstanceclient.Node{{}, {}, {}}},
+		{Partition: 1, Replicas: &[]kafkainstanceclient.Node{{}, {}, {}}},
+	})
+	topic.SetConfig([]kafkainstanceclient.ConfigEntry{
+		{Key: "cleanup.policy", Value: "compact"},
+		{Key: "retention.ms", Value: "604800000"},
+	})
+
+	resource := toStrimziTopic(topic, "my-cluster")
+	if resource.Spec.Replicas != 3 {
+		t.Errorf("replicas = %d, want 3", resource.Spec.Replicas)
+	}
+
+	data, err := yaml.Marshal(resource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	if err = os.WriteFile(filepath.Join(dir, resource.Metadata.Name+".yaml"), data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := topiccmdutil.ReadStrimziTopics(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []topiccmdutil.TopicDefinition{
+		{Name: "Orders_Topic", Partitions: 2, Config: map[string]string{"cleanup.policy": "compact", "retention.ms": "604800000"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("topiccmdutil.ReadStrimziTopics() = %v, want %v", got, want)
+	}
+}
+
+func TestUniqueResourceNames(t *testing.T) {
+	var resources []topiccmdutil.StrimziTopic
+	for _, name := range []string{"orders", "Orders", "a_b", "a-b", "A.B", "__"} {
+		topic := kafkainstanceclient.NewTopic()
+		topic.SetName(name)
+		resources = append(resources, toStrimziTopic(topic, ""))
+	}
+
+	uniqueResourceNames(resources)
+
+	names := map[string]string{}
+	for _, resource := range resources {
+		topicName := resource.Spec.TopicName
+		if topicName == "" {
+			topicName = resource.Metadata.Name
+		}
+		if other, ok := names[resource.Metadata.Name]; ok {
+			t.Errorf("topics %q and %q have the same resource name %q", other, topicName, resource.Metadata.Name)
+		}
+		names[resource.Metadata.Name] = topicName
+
+		if resource.Metadata.Name != resourceName(resource.Metadata.Name) || resource.Metadata.Name == "" {
+			t.Errorf("resource name %q of topic %q is not a valid resource name", resource.Metadata.Name, topicName)
+		}
+	}
+
+	// valid topic names and converted names without collision are kept
+	for i, want := range map[int]string{0: "orders", 3: "a-b", 4: "a.b"} {
+		if got := resources[i].Metadata.Name; got != want {
+			t.Errorf("resource name of topic %q = %q, want %q", resources[i].Spec.TopicName, got, want)
+		}
+	}
+}
@@ -0,0 +1,304 @@
+package migrate
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	kafkaflagutil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/topiccmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/checkpoint"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
+
+	"github.com/spf13/cobra"
+)
+
+// Actions of the import plan
+const (
+	actionCreate   = "create"
+	actionExists   = "exists"
+	actionInternal = "skip (internal)"
+)
+
+type importOptions struct {
+	kafkaConfigFile string
+	strimziDir      string
+	kafkaID         string
+	dryRun          bool
+	resume          bool
+	skipPolicy      bool
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     logging.Logger
+	localizer  localize.Localizer
+	Context    context.Context
+}
+
+type importRow struct {
+	Name       string `json:"name" header:"Name"`
+	Partitions int32  `json:"partitions" header:"Partitions"`
+	Config     string `json:"config" header:"Config"`
+	Action     string `json:"action" header:"Action"`
+}
+
+// NewImportCommand creates a new command for creating the topics of a self-managed cluster in a Kafka instance
+func NewImportCommand(f *factory.Factory) *cobra.Command {
+	opts := &importOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "import",
+		Short:   f.Localizer.MustLocalize("kafka.topic.import.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("kafka.topic.import.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("kafka.topic.import.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if (opts.kafkaConfigFile == "") == (opts.strimziDir == "") {
+				return f.Localizer.MustLocalizeError("kafka.topic.import.error.oneSource")
+			}
+
+			if opts.kafkaID == "" {
+				kafkaInstance, err := contextutil.GetCurrentKafkaInstance(f)
+				if err != nil {
+					return err
+				}
+
+				opts.kafkaID = kafkaInstance.GetId()
+			}
+
+			return runImport(opts)
+		},
+	}
+
+	flags := kafkaflagutil.NewFlagSet(cmd, f.Localizer)
+	flags.StringVar(&opts.kafkaConfigFile, "from-kafka-config", "", f.Localizer.MustLocalize("kafka.topic.import.flag.fromKafkaConfig.description"))
+	flags.StringVar(&opts.strimziDir, "from-strimzi", "", f.Localizer.MustLocalize("kafka.topic.import.flag.fromStrimzi.description"))
+	flags.BoolVar(&opts.dryRun, "dry-run", false, f.Localizer.MustLocalize("kafka.topic.import.flag.dryRun.description"))
+	flags.BoolVar(&opts.skipPolicy, "skip-policy", false, f.Localizer.MustLocalize("kafka.topic.import.flag.skipPolicy.description"))
+	flags.AddResume(&opts.resume)
+	flags.AddInstanceID(&opts.kafkaID)
+
+	return cmd
+}
+
+func runImport(opts *importOptions) error {
+	topics, err := readTopicDefinitions(opts)
+	if err != nil {
+		return err
+	}
+	if len(topics) == 0 {
+		return opts.localizer.MustLocalizeError("kafka.topic.import.error.noTopics")
+	}
+
+	conn, err := opts.Connection()
+	if err != nil {
+		return err
+	}
+
+	api, kafkaInstance, err := conn.API().KafkaAdmin(opts.kafkaID)
+	if err != nil {
+		return err
+	}
+
+	existing, err := topiccmdutil.FetchAllTopics(opts.Context, api)
+	if err != nil {
+		return err
+	}
+
+	rows := importPlan(topics, existing)
+	opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.import.log.info.plan", localize.NewEntry("InstanceName", kafkaInstance.GetName())))
+	dump.Table(opts.IO.Out, rows)
+
+	if !opts.skipPolicy {
+		cfg, err := opts.Config.Load()
+		if err != nil {
+			return err
+		}
+		// no topic is created when the name of any of them does not match the policy
+		if err = topiccmdutil.ValidateNamePolicy(opts.Context, cfg, conn.API().GetConfig().HTTPClient, opts.localizer, namesToCreate(rows)...); err != nil {
+			return err
+		}
+	}
+
+	if opts.dryRun {
+		opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.import.log.info.dryRun"))
+		return nil
+	}
+
+	store, err := checkpoint.DefaultStore(opts.Config)
+	if err != nil {
+		return err
+	}
+	progress, err := store.Open(importOperation(opts), opts.resume)
+	if err != nil {
+		return err
+	}
+	if skipped := len(progress.Completed); skipped > 0 {
+		opts.Logger.Info(opts.localizer.MustLocalizePlural("common.checkpoint.log.info.resuming", skipped, localize.NewEntry("Count", skipped)))
+	}
+
+	var created, failed int
+	for i, row := range rows {
+		if row.Action != actionCreate || progress.IsCompleted(row.Name) {
+			continue
+		}
+		if err = createTopic(opts, api, topics[i]); err != nil {
+			failed++
+			opts.Logger.Info(icon.ErrorPrefix(), opts.localizer.MustLocalize("kafka.topic.import.log.info.topicFailed", localize.NewEntry("TopicName", row.Name), localize.NewEntry("Error", err)))
+			continue
+		}
+		created++
+		opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("kafka.topic.import.log.info.topicCreated", localize.NewEntry("TopicName", row.Name)))
+		if err = progress.Complete(row.Name); err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 {
+		opts.Logger.Info(opts.localizer.MustLocalize("common.checkpoint.log.info.resumeHint"))
+		return opts.localizer.MustLocalizeError("kafka.topic.import.error.someFailed", localize.NewEntry("Failed", failed), localize.NewEntry("Count", created+failed))
+	}
+
+	if err = progress.Remove(); err != nil {
+		return err
+	}
+
+	opts.Logger.Info(opts.localizer.MustLocalizePlural("kafka.topic.import.log.info.done", created, localize.NewEntry("Count", created), localize.NewEntry("InstanceName", kafkaInstance.GetName())))
+	return nil
+}
+
+// namesToCreate returns the names of the topics which the import plan creates
+func namesToCreate(rows []importRow) []string {
+	names := []string{}
+	for _, row := range rows {
+		if row.Action == actionCreate {
+			names = append(names, row.Name)
+		}
+	}
+	return names
+}
+
+// importOperation identifies an import in the checkpoint store by its Kafka instance and source
+func importOperation(opts *importOptions) string {
+	source := opts.kafkaConfigFile
+	if opts.strimziDir != "" {
+		source = opts.strimziDir
+	}
+	if abs, err := filepath.Abs(source); err == nil {
+		source = abs
+	}
+	return strings.Join([]string{"kafka topic import", opts.kafkaID, source}, " ")
+}
+
+func readTopicDefinitions(opts *importOptions) ([]topiccmdutil.TopicDefinition, error) {
+	if opts.strimziDir != "" {
+		return topiccmdutil.ReadStrimziTopics(opts.strimziDir)
+	}
+
+	file, err := os.Open(opts.kafkaConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return parseKafkaTopicsDescribe(file)
+}
+
+// importPlan returns what is done with each topic: internal topics of Kafka and
+// topics which already exist in the instance are skipped, the others are created
+func importPlan(topics []topiccmdutil.TopicDefinition, existing []kafkainstanceclient.Topic) []importRow {
+	existingNames := map[string]bool{}
+	for _, t := range existing {
+		existingNames[t.GetName()] = true
+	}
+
+	rows := make([]importRow, len(topics))
+	for i, topic := range topics {
+		row := importRow{
+			Name:       topic.Name,
+			Partitions: topic.Partitions,
+			Config:     dump.OrPlaceholder(formatConfig(topic.Config)),
+			Action:     actionCreate,
+		}
+		switch {
+		case strings.HasPrefix(topic.Name, "__"):
+			row.Action = actionInternal
+		case existingNames[topic.Name]:
+			row.Action = actionExists
+		}
+		rows[i] = row
+	}
+
+	return rows
+}
+
+func createTopic(opts *importOptions, api *kafkainstanceclient.APIClient, topic topiccmdutil.TopicDefinition) error {
+	entries := make([]kafkainstanceclient.ConfigEntry, 0, len(topic.Config))
+	for _, key := range sortedKeys(topic.Config) {
+		entries = append(entries, *kafkainstanceclient.NewConfigEntry(key, topic.Config[key]))
+	}
+
+	settings := kafkainstanceclient.TopicSettings{Config: &entries}
+	if topic.Partitions > 0 {
+		settings.NumPartitions = &topic.Partitions
+	}
+
+	_, httpRes, err := api.TopicsApi.CreateTopic(opts.Context).NewTopicInput(kafkainstanceclient.NewTopicInput{
+		Name:     topic.Name,
+		Settings: settings,
+	}).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err == nil || httpRes == nil {
+		return err
+	}
+
+	operationTmplPair := localize.NewEntry("Operation", "create")
+	switch httpRes.StatusCode {
+	case http.StatusUnauthorized:
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.unauthorized", operationTmplPair)
+	case http.StatusForbidden:
+		return opts.localizer.MustLocalizeError("kafka.topic.common.error.forbidden", operationTmplPair)
+	case http.StatusConflict:
+		return opts.localizer.MustLocalizeError("kafka.topic.import.error.conflict")
+	default:
+		return err
+	}
+}
+
+func formatConfig(config map[string]string) string {
+	pairs := make([]string, 0, len(config))
+	for _, key := range sortedKeys(config) {
+		pairs = append(pairs, key+"="+config[key])
+	}
+	return strings.Join(pairs, ", ")
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
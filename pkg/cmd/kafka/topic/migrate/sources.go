@@ -0,0 +1,76 @@
+package migrate
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/topiccmdutil"
+)
+
+// parseKafkaTopicsDescribe reads the topics from the output of "kafka-topics.sh --describe".
+// Only the summary line of each topic is used, the lines describing its partitions are ignored.
+func parseKafkaTopicsDescribe(r io.Reader) ([]topiccmdutil.TopicDefinition, error) {
+	var topics []topiccmdutil.TopicDefinition
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		// partition lines are indented and do not have a partition count
+		if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") || !strings.Contains(line, "PartitionCount") {
+			continue
+		}
+
+		fields := describeFields(line)
+		topic := topiccmdutil.TopicDefinition{Name: fields["Topic"], Config: parseDescribeConfigs(fields["Configs"])}
+		if topic.Name == "" {
+			return nil, fmt.Errorf("invalid topic description: %v", line)
+		}
+
+		partitions, err := strconv.ParseInt(fields["PartitionCount"], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid partition count for topic %v: %v", topic.Name, fields["PartitionCount"])
+		}
+		topic.Partitions = int32(partitions)
+
+		topics = append(topics, topic)
+	}
+
+	return topics, scanner.Err()
+}
+
+// describeFields splits a line such as "Topic: orders	PartitionCount: 3	Configs: retention.ms=1000"
+// into its fields. Older versions of Kafka do not put a space after the colon.
+func describeFields(line string) map[string]string {
+	fields := map[string]string{}
+	for _, field := range strings.Split(line, "\t") {
+		key, value, ok := strings.Cut(field, ":")
+		if ok {
+			fields[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+	return fields
+}
+
+// parseDescribeConfigs parses "key=value" pairs separated by commas.
+// Values can contain commas too, such as "cleanup.policy=compact,delete".
+func parseDescribeConfigs(configs string) map[string]string {
+	config := map[string]string{}
+
+	var lastKey string
+	for _, part := range strings.Split(configs, ",") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			if lastKey != "" && part != "" {
+				config[lastKey] += "," + part
+			}
+			continue
+		}
+		lastKey = strings.TrimSpace(key)
+		config[lastKey] = strings.TrimSpace(value)
+	}
+
+	return config
+}
@@ -0,0 +1,30 @@
+package migrate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/topiccmdutil"
+)
+
+func TestParseKafkaTopicsDescribe(t *testing.T) {
+	output := "Topic: orders\tTopicId: 4DYC7eRmQZKgGW2mQnxTeQ\tPartitionCount: 3\tReplicationFactor: 3\tConfigs: cleanup.policy=compact,delete,retention.ms=604800000\n" +
+		"\tTopic: orders\tPartition: 0\tLeader: 1\tReplicas: 1,2,3\tIsr: 1,2,3\n" +
+		"\tTopic: orders\tPartition: 1\tLeader: 2\tReplicas: 2,3,1\tIsr: 2,3,1\n" +
+		"Topic:payments\tPartitionCount:1\tReplicationFactor:1\tConfigs:\n" +
+		"\tTopic: payments\tPartition: 0\tLeader: 1\tReplicas: 1\tIsr: 1\n"
+
+	got, err := parseKafkaTopicsDescribe(strings.NewReader(output))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []topiccmdutil.TopicDefinition{
+		{Name: "orders", Partitions: 3, Config: map[string]string{"cleanup.policy": "compact,delete", "retention.ms": "604800000"}},
+		{Name: "payments", Partitions: 1, Config: map[string]string{}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseKafkaTopicsDescribe() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,355 @@
+package producetest
+
+import (
+	"math/rand"
+	"net/http"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	kafkaflagutil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/kafkacmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/spinner"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultCount       = 100
+	defaultRate        = 10
+	defaultSize        = 100
+	defaultConcurrency = 4
+
+	payloadChars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+type options struct {
+	topicName    string
+	kafkaID      string
+	count        int
+	rate         float64
+	size         int
+	concurrency  int
+	outputFormat string
+
+	f *factory.Factory
+}
+
+// report is the result of a produce test
+type report struct {
+	Topic          string        `json:"topic" yaml:"topic"`
+	Requested      int           `json:"requested" yaml:"requested"`
+	Produced       int           `json:"produced" yaml:"produced"`
+	Failed         int           `json:"failed" yaml:"failed"`
+	Duration       time.Duration `json:"duration_ns" yaml:"duration_ns"`
+	MessagesPerSec float64       `json:"messages_per_sec" yaml:"messages_per_sec"`
+	BytesPerSec    float64       `json:"bytes_per_sec" yaml:"bytes_per_sec"`
+	Latency        latencyStats  `json:"latency_ms" yaml:"latency_ms"`
+}
+
+// latencyStats are the percentiles of the produce request latencies in milliseconds
+type latencyStats struct {
+	Min float64 `json:"min" yaml:"min"`
+	P50 float64 `json:"p50" yaml:"p50"`
+	P90 float64 `json:"p90" yaml:"p90"`
+	P99 float64 `json:"p99" yaml:"p99"`
+	Max float64 `json:"max" yaml:"max"`
+}
+
+type reportRow struct {
+	Produced       int     `header:"Produced"`
+	Failed         int     `header:"Failed"`
+	Duration       string  `header:"Duration"`
+	MessagesPerSec float64 `header:"Messages/sec"`
+	BytesPerSec    float64 `header:"Bytes/sec"`
+	P50            float64 `header:"p50 (ms)"`
+	P90            float64 `header:"p90 (ms)"`
+	P99            float64 `header:"p99 (ms)"`
+	Max            float64 `header:"Max (ms)"`
+}
+
+// NewProduceTestCommand creates a new command which produces synthetic messages to a topic
+// and reports the achieved throughput and latency
+func NewProduceTestCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "produce-test",
+		Short:   f.Localizer.MustLocalize("kafka.topic.produceTest.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("kafka.topic.produceTest.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("kafka.topic.produceTest.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			if opts.count < 1 {
+				return flagutil.InvalidValueError("count", opts.count)
+			}
+			if opts.rate < 0 {
+				return flagutil.InvalidValueError("rate", opts.rate)
+			}
+			if opts.size < 1 {
+				return flagutil.InvalidValueError("size", opts.size)
+			}
+			if opts.concurrency < 1 {
+				return flagutil.InvalidValueError("concurrency", opts.concurrency)
+			}
+
+			if opts.kafkaID == "" {
+				kafkaInstance, err := contextutil.GetCurrentKafkaInstance(f)
+				if err != nil {
+					return err
+				}
+
+				opts.kafkaID = kafkaInstance.GetId()
+			}
+
+			return runProduceTest(opts)
+		},
+	}
+
+	flags := kafkaflagutil.NewFlagSet(cmd, f.Localizer)
+
+	flags.StringVar(&opts.topicName, "name", "", f.Localizer.MustLocalize("kafka.topic.common.flag.name.description"))
+	flags.IntVar(&opts.count, "count", defaultCount, f.Localizer.MustLocalize("kafka.topic.produceTest.flag.count.description"))
+	flags.Float64Var(&opts.rate, "rate", defaultRate, f.Localizer.MustLocalize("kafka.topic.produceTest.flag.rate.description"))
+	flags.IntVar(&opts.size, "size", defaultSize, f.Localizer.MustLocalize("kafka.topic.produceTest.flag.size.description"))
+	flags.IntVar(&opts.concurrency, "concurrency", defaultConcurrency, f.Localizer.MustLocalize("kafka.topic.produceTest.flag.concurrency.description"))
+	flags.AddInstanceID(&opts.kafkaID)
+	flags.AddOutput(&opts.outputFormat)
+
+	_ = cmd.MarkFlagRequired("name")
+
+	_ = cmd.RegisterFlagCompletionFunc("name", func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return kafkacmdutil.FilterValidTopicNameArgs(f, toComplete)
+	})
+
+	return cmd
+}
+
+// nolint:funlen
+func runProduceTest(opts *options) error {
+	f := opts.f
+
+	conn, err := f.Connection()
+	if err != nil {
+		return err
+	}
+
+	api, kafkaInstance, err := conn.API().KafkaAdmin(opts.kafkaID)
+	if err != nil {
+		return err
+	}
+
+	_, httpRes, err := api.TopicsApi.GetTopic(f.Context, opts.topicName).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			return f.Localizer.MustLocalizeError("kafka.topic.common.error.topicNotFoundError",
+				localize.NewEntry("TopicName", opts.topicName),
+				localize.NewEntry("InstanceName", kafkaInstance.GetName()),
+			)
+		}
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(f.Context, os.Interrupt)
+	defer stop()
+
+	payload := newPayload(opts.size)
+
+	s := spinner.New(f.IOStreams.ErrOut, f.Localizer)
+	s.SetLocalizedSuffix("kafka.topic.produceTest.log.info.producing",
+		localize.NewEntry("Count", opts.count),
+		localize.NewEntry("Topic", opts.topicName),
+	)
+	s.Start()
+
+	var (
+		mu        sync.Mutex
+		latencies []time.Duration
+		failed    int
+		lastErr   error
+		wg        sync.WaitGroup
+	)
+
+	jobs := make(chan int)
+	for w := 0; w < opts.concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				key := "produce-test-" + time.Now().UTC().Format("20060102T150405") + "-" + strconv.Itoa(i)
+				record := kafkainstanceclient.Record{
+					Key:   &key,
+					Value: payload,
+				}
+
+				start := time.Now()
+				_, httpRes, err := api.RecordsApi.ProduceRecord(ctx, opts.topicName).Record(record).Execute()
+				latency := time.Since(start)
+				if httpRes != nil {
+					httpRes.Body.Close()
+				}
+
+				mu.Lock()
+				if err != nil {
+					failed++
+					lastErr = err
+				} else {
+					latencies = append(latencies, latency)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	started := time.Now()
+	var ticker *time.Ticker
+	if opts.rate > 0 {
+		ticker = time.NewTicker(sendInterval(opts.rate))
+		defer ticker.Stop()
+	}
+
+dispatch:
+	for i := 0; i < opts.count; i++ {
+		if ticker != nil && i > 0 {
+			select {
+			case <-ctx.Done():
+				break dispatch
+			case <-ticker.C:
+			}
+		}
+		select {
+		case <-ctx.Done():
+			break dispatch
+		case jobs <- i:
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	duration := time.Since(started)
+	s.Stop()
+
+	if ctx.Err() != nil {
+		f.Logger.Info(f.Localizer.MustLocalize("kafka.topic.produceTest.log.info.interrupted"))
+	}
+
+	if len(latencies) == 0 && lastErr != nil {
+		return lastErr
+	}
+	if lastErr != nil {
+		f.Logger.Info(f.Localizer.MustLocalize("kafka.topic.produceTest.log.info.lastError", localize.NewEntry("Error", lastErr)))
+	}
+
+	result := newReport(opts, latencies, failed, duration)
+
+	if opts.outputFormat != dump.EmptyFormat {
+		return dump.Formatted(f.IOStreams.Out, opts.outputFormat, result)
+	}
+
+	dump.Table(f.IOStreams.Out, []reportRow{{
+		Produced:       result.Produced,
+		Failed:         result.Failed,
+		Duration:       result.Duration.Round(time.Millisecond).String(),
+		MessagesPerSec: result.MessagesPerSec,
+		BytesPerSec:    result.BytesPerSec,
+		P50:            result.Latency.P50,
+		P90:            result.Latency.P90,
+		P99:            result.Latency.P99,
+		Max:            result.Latency.Max,
+	}})
+
+	return nil
+}
+
+func newReport(opts *options, latencies []time.Duration, failed int, duration time.Duration) report {
+	result := report{
+		Topic:     opts.topicName,
+		Requested: opts.count,
+		Produced:  len(latencies),
+		Failed:    failed,
+		Duration:  duration,
+		Latency:   computeLatencyStats(latencies),
+	}
+
+	if seconds := duration.Seconds(); seconds > 0 {
+		result.MessagesPerSec = round(float64(result.Produced) / seconds)
+		result.BytesPerSec = round(float64(result.Produced*opts.size) / seconds)
+	}
+
+	return result
+}
+
+// computeLatencyStats returns the latency percentiles using the nearest-rank method
+func computeLatencyStats(latencies []time.Duration) latencyStats {
+	if len(latencies) == 0 {
+		return latencyStats{}
+	}
+
+	sorted := make([]time.Duration, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	percentile := func(p float64) float64 {
+		rank := int(p/100*float64(len(sorted))+0.5) - 1
+		if rank < 0 {
+			rank = 0
+		}
+		if rank >= len(sorted) {
+			rank = len(sorted) - 1
+		}
+		return toMillis(sorted[rank])
+	}
+
+	return latencyStats{
+		Min: toMillis(sorted[0]),
+		P50: percentile(50),
+		P90: percentile(90),
+		P99: percentile(99),
+		Max: toMillis(sorted[len(sorted)-1]),
+	}
+}
+
+// sendInterval returns the time between two messages sent at rate messages per second.
+// Rates above one message per nanosecond are sent as fast as the ticker allows.
+func sendInterval(rate float64) time.Duration {
+	interval := time.Duration(float64(time.Second) / rate)
+	if interval < 1 {
+		return 1
+	}
+	return interval
+}
+
+func newPayload(size int) string {
+	// #nosec G404 -- the payload is synthetic test data
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	b := make([]byte, size)
+	for i := range b {
+		b[i] = payloadChars[r.Intn(len(payloadChars))]
+	}
+	return string(b)
+}
+
+func toMillis(d time.Duration) float64 {
+	return round(float64(d) / float64(time.Millisecond))
+}
+
+func round(v float64) float64 {
+	return float64(int64(v*100+0.5)) / 100
+}
@@ -0,0 +1,57 @@
+package producetest
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_computeLatencyStats(t *testing.T) {
+	tests := []struct {
+		name      string
+		latencies []time.Duration
+		want      latencyStats
+	}{
+		{
+			name: "no latencies",
+			want: latencyStats{},
+		},
+		{
+			name:      "single latency",
+			latencies: []time.Duration{5 * time.Millisecond},
+			want:      latencyStats{Min: 5, P50: 5, P90: 5, P99: 5, Max: 5},
+		},
+		{
+			name: "unsorted latencies",
+			latencies: []time.Duration{
+				10 * time.Millisecond, 1 * time.Millisecond, 9 * time.Millisecond, 2 * time.Millisecond, 8 * time.Millisecond,
+				3 * time.Millisecond, 7 * time.Millisecond, 4 * time.Millisecond, 6 * time.Millisecond, 5 * time.Millisecond,
+			},
+			want: latencyStats{Min: 1, P50: 5, P90: 9, P99: 10, Max: 10},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := computeLatencyStats(tt.latencies); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("computeLatencyStats() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_sendInterval(t *testing.T) {
+	tests := []struct {
+		rate float64
+		want time.Duration
+	}{
+		{rate: 1, want: time.Second},
+		{rate: 4, want: 250 * time.Millisecond},
+		{rate: 1e9, want: time.Nanosecond},
+		{rate: 1e12, want: time.Nanosecond},
+	}
+	for _, tt := range tests {
+		if got := sendInterval(tt.rate); got != tt.want {
+			t.Errorf("sendInterval(%v) = %v, want %v", tt.rate, got, tt.want)
+		}
+	}
+}
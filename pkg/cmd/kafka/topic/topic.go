@@ -1,13 +1,17 @@
 package topic
 
 import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/config"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/consume"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/create"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/delete"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/describe"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/list"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/migrate"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/produce"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/producetest"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/update"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/watch"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
 	"github.com/spf13/cobra"
 )
@@ -33,7 +37,12 @@ func NewTopicCommand(f *factory.Factory) *cobra.Command {
 		describe.NewDescribeTopicCommand(f),
 		update.NewUpdateTopicCommand(f),
 		produce.NewProduceTopicCommand(f),
+		producetest.NewProduceTestCommand(f),
 		consume.NewConsumeTopicCommand(f),
+		migrate.NewImportCommand(f),
+		migrate.NewExportCommand(f),
+		config.NewConfigCommand(f),
+		watch.NewWatchCommand(f),
 	)
 
 	return cmd
@@ -0,0 +1,91 @@
+package topiccmdutil
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+)
+
+// maxPolicySize is the maximum size of a naming policy fetched from a URL
+const maxPolicySize = 64 * 1024
+
+// policyFetchTimeout is the timeout to fetch a naming policy from a URL
+const policyFetchTimeout = 10 * time.Second
+
+// LoadNamePolicy returns the regular expression of the topic naming policy.
+// The policy is either the expression, or an http(s) URL which returns it, so that a team can share one policy.
+// The expression must match the whole name of the topic.
+func LoadNamePolicy(ctx context.Context, policy string, client *http.Client) (*regexp.Regexp, error) {
+	expr := strings.TrimSpace(policy)
+
+	if strings.HasPrefix(expr, "http://") || strings.HasPrefix(expr, "https://") {
+		var err error
+		if expr, err = fetchNamePolicy(ctx, expr, client); err != nil {
+			return nil, err
+		}
+	}
+
+	return regexp.Compile("^(?:" + expr + ")$")
+}
+
+func fetchNamePolicy(ctx context.Context, url string, client *http.Client) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, policyFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %v from %v", res.Status, url)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxPolicySize))
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(body)), nil
+}
+
+// ValidateNamePolicy checks the names of new topics against the naming policy of the config, if any.
+// A policy URL is fetched with client, which should be the HTTP client of the connection
+// so that the insecure and proxy settings of the CLI apply.
+func ValidateNamePolicy(ctx context.Context, cfg *config.Config, client *http.Client, localizer localize.Localizer, names ...string) error {
+	if cfg.TopicNamePolicy == "" {
+		return nil
+	}
+
+	policy, err := LoadNamePolicy(ctx, cfg.TopicNamePolicy, client)
+	if err != nil {
+		return localizer.MustLocalizeError("kafka.topic.common.error.invalidNamePolicy",
+			localize.NewEntry("Policy", cfg.TopicNamePolicy),
+			localize.NewEntry("Error", err),
+		)
+	}
+
+	for _, name := range names {
+		if !policy.MatchString(name) {
+			return localizer.MustLocalizeError("kafka.topic.common.error.namePolicyViolation",
+				localize.NewEntry("TopicName", name),
+				localize.NewEntry("Policy", cfg.TopicNamePolicy),
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package topiccmdutil
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize/goi18n"
+)
+
+func TestLoadNamePolicy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/policy" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintln(w, `team-[a-z]+`)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		policy  string
+		topic   string
+		want    bool
+		wantErr bool
+	}{
+		{name: "matching name", policy: `team-[a-z]+`, topic: "team-orders", want: true},
+		{name: "policy matches the whole name", policy: `team-[a-z]+`, topic: "other-team-orders", want: false},
+		{name: "alternatives are anchored", policy: `a|b`, topic: "ab", want: false},
+		{name: "invalid expression", policy: `team-[`, wantErr: true},
+		{name: "policy from URL", policy: server.URL + "/policy", topic: "team-orders", want: true},
+		{name: "policy from URL is anchored", policy: server.URL + "/policy", topic: "team-1", want: false},
+		{name: "unavailable URL", policy: server.URL + "/missing", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := LoadNamePolicy(context.Background(), tt.policy, server.Client())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadNamePolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got := policy.MatchString(tt.topic); got != tt.want {
+				t.Errorf("MatchString(%q) = %v, want %v", tt.topic, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateNamePolicy(t *testing.T) {
+	localizer, _ := goi18n.New(nil)
+
+	tests := []struct {
+		name    string
+		policy  string
+		topics  []string
+		wantErr bool
+	}{
+		{name: "no policy", policy: "", topics: []string{"anything"}},
+		{name: "all names match", policy: `team-[a-z]+`, topics: []string{"team-orders", "team-payments"}},
+		{name: "one name does not match", policy: `team-[a-z]+`, topics: []string{"team-orders", "scratch"}, wantErr: true},
+		{name: "invalid policy", policy: `team-[`, topics: []string{"team-orders"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{TopicNamePolicy: tt.policy}
+			err := ValidateNamePolicy(context.Background(), cfg, http.DefaultClient, localizer, tt.topics...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateNamePolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package topiccmdutil
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v2"
+)
+
+// StrimziTopicKind is the kind of the Strimzi custom resource describing a topic
+const StrimziTopicKind = "KafkaTopic"
+
+// TopicDefinition is a topic declared outside of the Kafka instance,
+// such as in the definition of a self-managed cluster
+type TopicDefinition struct {
+	Name       string
+	Partitions int32
+	Config     map[string]string
+}
+
+// StrimziTopic is the subset of the Strimzi KafkaTopic custom resource describing a topic
+type StrimziTopic struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Metadata   struct {
+		Name   string            `yaml:"name"`
+		Labels map[string]string `yaml:"labels,omitempty"`
+	} `yaml:"metadata"`
+	Spec struct {
+		TopicName  string                 `yaml:"topicName,omitempty"`
+		Partitions int32                  `yaml:"partitions"`
+		Replicas   int32                  `yaml:"replicas,omitempty"`
+		Config     map[string]interface{} `yaml:"config,omitempty"`
+	} `yaml:"spec"`
+}
+
+// ReadStrimziTopics reads the KafkaTopic custom resources from a YAML file, or from the YAML files of a directory.
+// Files can contain several documents, resources of other kinds are ignored.
+func ReadStrimziTopics(path string) ([]TopicDefinition, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return readStrimziTopicFile(path)
+	}
+
+	var files []string
+	for _, pattern := range []string{"*.yaml", "*.yml"} {
+		matches, err := filepath.Glob(filepath.Join(path, pattern))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, matches...)
+	}
+	sort.Strings(files)
+
+	var topics []TopicDefinition
+	for _, file := range files {
+		fileTopics, err := readStrimziTopicFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", file, err)
+		}
+		topics = append(topics, fileTopics...)
+	}
+
+	return topics, nil
+}
+
+func readStrimziTopicFile(file string) ([]TopicDefinition, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var topics []TopicDefinition
+
+	decoder := yaml.NewDecoder(f)
+	for {
+		var resource StrimziTopic
+		err = decoder.Decode(&resource)
+		if errors.Is(err, io.EOF) {
+			return topics, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if resource.Kind != StrimziTopicKind {
+			continue
+		}
+
+		topic := TopicDefinition{
+			Name:       resource.Spec.TopicName,
+			Partitions: resource.Spec.Partitions,
+			Config:     map[string]string{},
+		}
+		if topic.Name == "" {
+			topic.Name = resource.Metadata.Name
+		}
+		for key, value := range resource.Spec.Config {
+			topic.Config[key] = fmt.Sprint(value)
+		}
+
+		topics = append(topics, topic)
+	}
+}
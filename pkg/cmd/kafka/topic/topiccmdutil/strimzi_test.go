@@ -0,0 +1,61 @@
+package topiccmdutil
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadStrimziTopics(t *testing.T) {
+	dir := t.TempDir()
+	crs := `apiVersion: kafka.strimzi.io/v1beta2
+kind: KafkaTopic
+metadata:
+  name: orders
+  labels:
+    strimzi.io/cluster: my-cluster
+spec:
+  partitions: 3
+  replicas: 3
+  config:
+    retention.ms: 604800000
+    cleanup.policy: compact
+---
+apiVersion: kafka.strimzi.io/v1beta2
+kind: KafkaUser
+metadata:
+  name: orders-app
+---
+apiVersion: kafka.strimzi.io/v1beta2
+kind: KafkaTopic
+metadata:
+  name: payments-topic
+spec:
+  topicName: Payments
+  partitions: 1
+`
+	if err := os.WriteFile(filepath.Join(dir, "topics.yaml"), []byte(crs), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []TopicDefinition{
+		{Name: "orders", Partitions: 3, Config: map[string]string{"retention.ms": "604800000", "cleanup.policy": "compact"}},
+		{Name: "Payments", Partitions: 1, Config: map[string]string{}},
+	}
+
+	// the resources are read from a directory or from a single file
+	for _, path := range []string{dir, filepath.Join(dir, "topics.yaml")} {
+		got, err := ReadStrimziTopics(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("ReadStrimziTopics(%q) = %v, want %v", path, got, want)
+		}
+	}
+
+	if _, err := ReadStrimziTopics(filepath.Join(dir, "missing.yaml")); err == nil {
+		t.Errorf("ReadStrimziTopics() error = nil, want an error for a missing file")
+	}
+}
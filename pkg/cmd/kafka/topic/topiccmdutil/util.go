@@ -1,8 +1,14 @@
 package topiccmdutil
 
 import (
+	"context"
 	"fmt"
+	"path"
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
 )
@@ -13,6 +19,10 @@ var (
 	CleanupPolicy    = "cleanup.policy"
 )
 
+const topicsPageSize = 100
+
+var isoDurationRegexp = regexp.MustCompile(`^P(?:(\d+(?:\.\d+)?)W)?(?:(\d+(?:\.\d+)?)D)?(?:T(?:(\d+(?:\.\d+)?)H)?(?:(\d+(?:\.\d+)?)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
 var ValidCleanupPolicies = []string{"delete", "compact", "compact,delete"}
 
 // CreateConfigEntries converts a key value map of config entries to an array of config entries
@@ -69,3 +79,92 @@ func GetConfigValue(configEntries []kafkainstanceclient.ConfigEntry, keyName str
 
 	return val
 }
+
+// FetchAllTopics pages through the topics of a Kafka instance and returns all of them
+func FetchAllTopics(ctx context.Context, api *kafkainstanceclient.APIClient) ([]kafkainstanceclient.Topic, error) {
+	return FetchFilteredTopics(ctx, api, "")
+}
+
+// FetchFilteredTopics pages through the topics of a Kafka instance and returns all of them
+// which match the search filter, or all topics when it is empty
+func FetchFilteredTopics(ctx context.Context, api *kafkainstanceclient.APIClient, filter string) ([]kafkainstanceclient.Topic, error) {
+	var topics []kafkainstanceclient.Topic
+
+	for page := int32(1); ; page++ {
+		req := api.TopicsApi.GetTopics(ctx).Size(topicsPageSize).Page(page)
+		if filter != "" {
+			req = req.Filter(filter)
+		}
+
+		topicList, httpRes, err := req.Execute()
+		if httpRes != nil {
+			_ = httpRes.Body.Close()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		items := topicList.GetItems()
+		topics = append(topics, items...)
+
+		if len(items) == 0 || len(topics) >= int(topicList.GetTotal()) {
+			return topics, nil
+		}
+	}
+}
+
+// IsTopicPattern returns true when the name is a glob pattern matching several topics, such as "tmp-*"
+func IsTopicPattern(name string) bool {
+	return strings.ContainsAny(name, "*?[")
+}
+
+// MatchTopicNames returns the sorted names of the topics which match the glob pattern
+func MatchTopicNames(topics []kafkainstanceclient.Topic, pattern string) ([]string, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("invalid topic pattern %q: %w", pattern, err)
+	}
+
+	var names []string
+	for _, t := range topics {
+		if matched, _ := path.Match(pattern, t.GetName()); matched {
+			names = append(names, t.GetName())
+		}
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// CountPartitions returns the total number of partitions of the given topics
+func CountPartitions(topics []kafkainstanceclient.Topic) int {
+	var count int
+	for _, t := range topics {
+		count += len(t.GetPartitions())
+	}
+	return count
+}
+
+// ParseISODuration parses an ISO 8601 duration such as "P14D" or "PT1H30M".
+// Years and months are not supported, as their length is not fixed.
+func ParseISODuration(value string) (time.Duration, error) {
+	matches := isoDurationRegexp.FindStringSubmatch(value)
+	if matches == nil || value == "P" || value == "PT" {
+		return 0, fmt.Errorf("invalid ISO 8601 duration: %v", value)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+
+	var duration time.Duration
+	for i, unit := range units {
+		if matches[i+1] == "" {
+			continue
+		}
+		n, err := strconv.ParseFloat(matches[i+1], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid ISO 8601 duration: %v", value)
+		}
+		duration += time.Duration(n * float64(unit))
+	}
+
+	return duration, nil
+}
@@ -3,6 +3,7 @@ package topiccmdutil
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
 )
@@ -46,3 +47,94 @@ func TestCreateConfigEntries(t *testing.T) {
 		})
 	}
 }
+
+func TestParseISODuration(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{
+			name:  "should parse days",
+			value: "P14D",
+			want:  14 * 24 * time.Hour,
+		},
+		{
+			name:  "should parse weeks",
+			value: "P2W",
+			want:  14 * 24 * time.Hour,
+		},
+		{
+			name:  "should parse time components",
+			value: "PT1H30M15S",
+			want:  time.Hour + 30*time.Minute + 15*time.Second,
+		},
+		{
+			name:  "should parse days and time components",
+			value: "P1DT12H",
+			want:  36 * time.Hour,
+		},
+		{
+			name:    "should fail on empty duration",
+			value:   "P",
+			wantErr: true,
+		},
+		{
+			name:    "should fail on months",
+			value:   "P1M",
+			wantErr: true,
+		},
+		{
+			name:    "should fail on invalid value",
+			value:   "14 days",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// nolint:scopelint
+			got, err := ParseISODuration(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseISODuration() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseISODuration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchTopicNames(t *testing.T) {
+	var topics []kafkainstanceclient.Topic
+	for _, name := range []string{"tmp-b", "orders", "tmp-a", "tmp"} {
+		topic := kafkainstanceclient.NewTopic()
+		topic.SetName(name)
+		topics = append(topics, *topic)
+	}
+
+	tests := []struct {
+		name    string
+		pattern string
+		want    []string
+		wantErr bool
+	}{
+		{name: "prefix", pattern: "tmp-*", want: []string{"tmp-a", "tmp-b"}},
+		{name: "single character", pattern: "tmp-?", want: []string{"tmp-a", "tmp-b"}},
+		{name: "character class", pattern: "tmp-[a]", want: []string{"tmp-a"}},
+		{name: "no match", pattern: "test-*", want: nil},
+		{name: "invalid pattern", pattern: "tmp-[", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MatchTopicNames(topics, tt.pattern)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MatchTopicNames() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MatchTopicNames() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -6,10 +6,12 @@ import (
 	"net/http"
 	"regexp"
 	"strconv"
+	"time"
 
 	"github.com/redhat-developer/app-services-cli/pkg/core/errors"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/kafkautil"
 )
 
 const (
@@ -162,3 +164,43 @@ func (v *Validator) ValidateNameIsAvailable(val interface{}) error {
 
 	return nil
 }
+
+// ValidateInstanceLimits validates the requested topic settings against the limits of the Kafka instance size.
+// usedPartitions is the number of partitions which are already in use by other topics in the instance.
+func (v *Validator) ValidateInstanceLimits(limits *kafkautil.InstanceLimits, usedPartitions int, partitions int, retentionMs int, retentionBytes int) error {
+	if limits == nil {
+		return nil
+	}
+
+	maxPartitions := int(limits.MaxPartitions)
+	if maxPartitions > 0 && usedPartitions+partitions > maxPartitions {
+		return v.Localizer.MustLocalizeError("kafka.topic.common.validation.partitions.error.instanceLimit",
+			localize.NewEntry("Max", maxPartitions),
+			localize.NewEntry("Used", usedPartitions),
+			localize.NewEntry("Partitions", partitions),
+			localize.NewEntry("Available", maxPartitions-usedPartitions),
+		)
+	}
+
+	if limits.MaxDataRetentionSize > 0 && int64(retentionBytes) > limits.MaxDataRetentionSize {
+		return v.Localizer.MustLocalizeError("kafka.topic.common.validation.retentionSize.error.instanceLimit",
+			localize.NewEntry("Max", limits.MaxDataRetentionSize),
+			localize.NewEntry("RetentionSize", retentionBytes),
+		)
+	}
+
+	if limits.MaxDataRetentionPeriod != "" && retentionMs > 0 {
+		maxPeriod, err := ParseISODuration(limits.MaxDataRetentionPeriod)
+		if err != nil {
+			return nil
+		}
+		if time.Duration(retentionMs)*time.Millisecond > maxPeriod {
+			return v.Localizer.MustLocalizeError("kafka.topic.common.validation.retentionPeriod.error.instanceLimit",
+				localize.NewEntry("Max", maxPeriod.Milliseconds()),
+				localize.NewEntry("RetentionPeriod", retentionMs),
+			)
+		}
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize/goi18n"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/kafkautil"
 )
 
 var validator *Validator
@@ -394,3 +395,91 @@ func TestValidateMessageRetentionSize(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateInstanceLimits(t *testing.T) {
+	limits := &kafkautil.InstanceLimits{
+		MaxPartitions:          1500,
+		MaxDataRetentionSize:   1000,
+		MaxDataRetentionPeriod: "P14D",
+	}
+
+	type args struct {
+		limits         *kafkautil.InstanceLimits
+		usedPartitions int
+		partitions     int
+		retentionMs    int
+		retentionBytes int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Should be valid when limits are not available",
+			args: args{
+				partitions: 2000,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should be valid when within the limits",
+			args: args{
+				limits:         limits,
+				usedPartitions: 100,
+				partitions:     1400,
+				retentionMs:    604800000,
+				retentionBytes: -1,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should be invalid when exceeding the partition limit",
+			args: args{
+				limits:         limits,
+				partitions:     2000,
+				retentionBytes: -1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should be invalid when existing partitions leave no room",
+			args: args{
+				limits:         limits,
+				usedPartitions: 1500,
+				partitions:     1,
+				retentionBytes: -1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should be invalid when exceeding the retention size limit",
+			args: args{
+				limits:         limits,
+				partitions:     1,
+				retentionBytes: 1001,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should be invalid when exceeding the retention period limit",
+			args: args{
+				limits:         limits,
+				partitions:     1,
+				retentionMs:    1209600001,
+				retentionBytes: -1,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// nolint
+			err := validator.ValidateInstanceLimits(tt.args.limits, tt.args.usedPartitions, tt.args.partitions, tt.args.retentionMs, tt.args.retentionBytes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateInstanceLimits() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
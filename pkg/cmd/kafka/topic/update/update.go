@@ -13,6 +13,7 @@ import (
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
@@ -37,6 +38,8 @@ type options struct {
 	kafkaID           string
 	interactive       bool
 	cleanupPolicy     string
+	dryRun            bool
+	outputFormat      string
 
 	IO             *iostreams.IOStreams
 	Connection     factory.ConnectionFunc
@@ -69,6 +72,10 @@ func NewUpdateTopicCommand(f *factory.Factory) *cobra.Command {
 				Localizer: opts.localizer,
 			}
 
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
 			if !opts.IO.CanPrompt() && opts.retentionMsStr == "" && opts.partitionsStr == "" && opts.retentionBytesStr == "" {
 				return opts.localizer.MustLocalizeError("argument.error.requiredWhenNonInteractive", localize.NewEntry("Argument", "name"))
 			} else if opts.retentionMsStr == "" && opts.partitionsStr == "" && opts.retentionBytesStr == "" && opts.cleanupPolicy == "" {
@@ -152,6 +159,8 @@ func NewUpdateTopicCommand(f *factory.Factory) *cobra.Command {
 	flags.StringVar(&opts.retentionBytesStr, "retention-bytes", "", opts.localizer.MustLocalize("kafka.topic.common.input.retentionBytes.description"))
 	flags.StringVar(&opts.cleanupPolicy, "cleanup-policy", "", opts.localizer.MustLocalize("kafka.topic.common.input.cleanupPolicy.description"))
 	flags.StringVar(&opts.partitionsStr, "partitions", "", opts.localizer.MustLocalize("kafka.topic.common.input.partitions.description"))
+	flags.BoolVar(&opts.dryRun, "dry-run", false, opts.localizer.MustLocalize("kafka.topic.update.flag.dryRun.description"))
+	flags.AddOutput(&opts.outputFormat)
 
 	flags.StringVar(&opts.name, "name", "", opts.localizer.MustLocalize("kafka.topic.common.flag.name.description"))
 	_ = cmd.RegisterFlagCompletionFunc("name", func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -259,6 +268,10 @@ func runCmd(opts *options) error {
 		return nil
 	}
 
+	if opts.dryRun {
+		return printDryRun(opts, &topic, configEntryMap, topicSettings, kafkaNameTmplPair)
+	}
+
 	if len(configEntryMap) > 0 {
 		configEntries := topiccmdutil.CreateConfigEntries(configEntryMap)
 		topicSettings.SetConfig(*configEntries)
@@ -299,6 +312,40 @@ func runCmd(opts *options) error {
 	return nil
 }
 
+// printDryRun prints the changes of the update to the topic without applying them
+func printDryRun(opts *options, topic *kafkainstanceclient.Topic, configEntryMap map[string]*string, topicSettings *kafkainstanceclient.TopicSettings, kafkaNameTmplPair *localize.TemplateEntry) error {
+	before := map[string]interface{}{
+		"partitions": len(topic.GetPartitions()),
+	}
+	for _, key := range []string{topiccmdutil.RetentionMsKey, topiccmdutil.RetentionSizeKey, topiccmdutil.CleanupPolicy} {
+		before[key] = topiccmdutil.GetConfigValue(topic.GetConfig(), key)
+	}
+
+	after := make(map[string]interface{}, len(before))
+	for key, value := range before {
+		after[key] = value
+	}
+	for key, value := range configEntryMap {
+		after[key] = *value
+	}
+	if partitions, ok := topicSettings.GetNumPartitionsOk(); ok {
+		after["partitions"] = *partitions
+	}
+
+	diff, err := dump.NewDiff("Topic", topic.GetName(), before, after)
+	if err != nil {
+		return err
+	}
+
+	if err = dump.PrintDiff(opts.IO.Out, opts.outputFormat, diff); err != nil {
+		return err
+	}
+
+	opts.Logger.Info(opts.localizer.MustLocalize("kafka.topic.update.log.info.dryRun", localize.NewEntry("TopicName", opts.name), kafkaNameTmplPair))
+
+	return nil
+}
+
 func runInteractivePrompt(opts *options) (err error) {
 	conn, err := opts.Connection()
 	if err != nil {
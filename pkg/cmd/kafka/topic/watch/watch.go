@@ -0,0 +1,384 @@
+package watch
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	kafkaflagutil "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/topiccmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/httputil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
+	"github.com/spf13/cobra"
+)
+
+const defaultInterval = time.Minute
+
+// Kinds of topic changes
+const (
+	changeCreated    = "created"
+	changeDeleted    = "deleted"
+	changePartitions = "partitions"
+	changeConfig     = "config"
+)
+
+type options struct {
+	kafkaID      string
+	interval     time.Duration
+	outputFormat string
+	manifest     string
+
+	f *factory.Factory
+}
+
+// topicSnapshot is the definition of a topic at one point in time
+type topicSnapshot struct {
+	partitions int
+	config     map[string]string
+}
+
+// topicChange is a difference in the definition of a topic between two snapshots.
+// Key is the configuration key for config changes.
+type topicChange struct {
+	Time     time.Time `json:"time" yaml:"time"`
+	Topic    string    `json:"topic" yaml:"topic"`
+	Change   string    `json:"change" yaml:"change"`
+	Key      string    `json:"key,omitempty" yaml:"key,omitempty"`
+	Previous string    `json:"previous,omitempty" yaml:"previous,omitempty"`
+	Current  string    `json:"current,omitempty" yaml:"current,omitempty"`
+}
+
+// topicChangeRow is a topicChange printed in a table
+type topicChangeRow struct {
+	Topic    string `header:"Topic"`
+	Change   string `header:"Change"`
+	Key      string `header:"Key"`
+	Previous string `header:"Previous"`
+	Current  string `header:"Current"`
+}
+
+// NewWatchCommand creates a new command to report the changes made to the topics of a Kafka instance
+func NewWatchCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "watch",
+		Short:   f.Localizer.MustLocalize("kafka.topic.watch.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("kafka.topic.watch.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("kafka.topic.watch.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			if opts.interval < time.Second {
+				return f.Localizer.MustLocalizeError("kafka.topic.watch.error.invalidInterval", localize.NewEntry("Interval", opts.interval))
+			}
+
+			if opts.kafkaID == "" {
+				kafkaInstance, err := contextutil.GetCurrentKafkaInstance(f)
+				if err != nil {
+					return err
+				}
+
+				opts.kafkaID = kafkaInstance.GetId()
+			}
+
+			return runWatch(opts)
+		},
+	}
+
+	flags := kafkaflagutil.NewFlagSet(cmd, f.Localizer)
+
+	flags.AddInstanceID(&opts.kafkaID)
+	flags.DurationVar(&opts.interval, "interval", defaultInterval, f.Localizer.MustLocalize("kafka.topic.watch.flag.interval.description"))
+	flags.StringVar(&opts.manifest, "manifest", "", f.Localizer.MustLocalize("kafka.topic.watch.flag.manifest.description"))
+	flags.AddOutput(&opts.outputFormat)
+
+	return cmd
+}
+
+func runWatch(opts *options) error {
+	f := opts.f
+
+	var manifest map[string]topicSnapshot
+	if opts.manifest != "" {
+		definitions, err := topiccmdutil.ReadStrimziTopics(opts.manifest)
+		if err != nil {
+			return err
+		}
+		manifest = manifestSnapshot(definitions)
+	}
+
+	conn, err := f.Connection()
+	if err != nil {
+		return err
+	}
+
+	api, kafkaInstance, err := conn.API().KafkaAdmin(opts.kafkaID)
+	if err != nil {
+		return err
+	}
+
+	topics, err := topiccmdutil.FetchAllTopics(f.Context, api)
+	if err != nil {
+		return err
+	}
+	previous := snapshot(topics)
+
+	f.Logger.Info(f.Localizer.MustLocalize("kafka.topic.watch.log.info.watching",
+		localize.NewEntry("Count", len(previous)),
+		localize.NewEntry("InstanceName", kafkaInstance.GetName()),
+		localize.NewEntry("Interval", opts.interval),
+	))
+
+	// with a manifest, the drift from the manifest is printed when it starts and every time it changes
+	var drift []topicChange
+	if manifest != nil {
+		drift = diffManifest(manifest, topics, time.Now())
+		if err = printDrift(opts, drift, time.Now()); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(opts.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-f.Context.Done():
+			return nil
+		case now := <-ticker.C:
+			topics, err := topiccmdutil.FetchAllTopics(httputil.WithoutCache(f.Context), api)
+			if err != nil {
+				// the next snapshot is compared with the last successful one
+				f.Logger.Info(f.Localizer.MustLocalize("kafka.topic.watch.log.info.snapshotFailed", localize.NewEntry("Error", err)))
+				continue
+			}
+
+			if manifest != nil {
+				current := diffManifest(manifest, topics, now)
+				if sameChanges(drift, current) {
+					continue
+				}
+				drift = current
+
+				if err = printDrift(opts, drift, now); err != nil {
+					return err
+				}
+				continue
+			}
+
+			current := snapshot(topics)
+			changes := diffSnapshots(previous, current, now)
+			previous = current
+
+			if len(changes) == 0 {
+				continue
+			}
+
+			if err = printChanges(opts, "kafka.topic.watch.log.info.changes", changes, now); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// printDrift prints the differences between the topics and the manifest
+func printDrift(opts *options, drift []topicChange, now time.Time) error {
+	if len(drift) == 0 {
+		opts.f.Logger.Info(opts.f.Localizer.MustLocalize("kafka.topic.watch.log.info.noDrift", localize.NewEntry("Time", now.Format(time.RFC3339))))
+		return nil
+	}
+
+	return printChanges(opts, "kafka.topic.watch.log.info.drift", drift, now)
+}
+
+func printChanges(opts *options, messageID string, changes []topicChange, now time.Time) error {
+	f := opts.f
+
+	if opts.outputFormat != dump.EmptyFormat {
+		return dump.Formatted(f.IOStreams.Out, opts.outputFormat, changes)
+	}
+
+	f.Logger.Info(f.Localizer.MustLocalize(messageID,
+		localize.NewEntry("Count", len(changes)),
+		localize.NewEntry("Time", now.Format(time.RFC3339)),
+	))
+
+	rows := make([]topicChangeRow, len(changes))
+	for i, c := range changes {
+		rows[i] = topicChangeRow{
+			Topic:    c.Topic,
+			Change:   c.Change,
+			Key:      dump.OrPlaceholder(c.Key),
+			Previous: dump.OrPlaceholder(c.Previous),
+			Current:  dump.OrPlaceholder(c.Current),
+		}
+	}
+	dump.Table(f.IOStreams.Out, rows)
+	f.Logger.Info("")
+
+	return nil
+}
+
+// snapshot maps the topics to their definitions by name
+func snapshot(topics []kafkainstanceclient.Topic) map[string]topicSnapshot {
+	snapshots := make(map[string]topicSnapshot, len(topics))
+	for _, topic := range topics {
+		config := make(map[string]string, len(topic.GetConfig()))
+		for _, entry := range topic.GetConfig() {
+			config[entry.Key] = entry.Value
+		}
+		snapshots[topic.GetName()] = topicSnapshot{
+			partitions: len(topic.GetPartitions()),
+			config:     config,
+		}
+	}
+	return snapshots
+}
+
+// manifestSnapshot maps the topics declared in a manifest to their definitions by name
+func manifestSnapshot(definitions []topiccmdutil.TopicDefinition) map[string]topicSnapshot {
+	snapshots := make(map[string]topicSnapshot, len(definitions))
+	for _, definition := range definitions {
+		snapshots[definition.Name] = topicSnapshot{
+			partitions: int(definition.Partitions),
+			config:     definition.Config,
+		}
+	}
+	return snapshots
+}
+
+// diffManifest returns the differences between the topics of the instance and the manifest,
+// reported as changes from the manifest to the instance.
+// Internal topics are ignored, and only the configuration keys declared in the manifest are compared,
+// as the instance also returns the default value of the other keys.
+func diffManifest(manifest map[string]topicSnapshot, topics []kafkainstanceclient.Topic, now time.Time) []topicChange {
+	var external []kafkainstanceclient.Topic
+	for _, topic := range topics {
+		if !topic.GetIsInternal() {
+			external = append(external, topic)
+		}
+	}
+
+	current := snapshot(external)
+	for name, declared := range manifest {
+		actual, ok := current[name]
+		if !ok {
+			continue
+		}
+		config := make(map[string]string, len(declared.config))
+		for key := range declared.config {
+			if value, ok := actual.config[key]; ok {
+				config[key] = value
+			}
+		}
+		actual.config = config
+		// a manifest without a partition count accepts any number of partitions
+		if declared.partitions == 0 {
+			actual.partitions = 0
+		}
+		current[name] = actual
+	}
+
+	return diffSnapshots(manifest, current, now)
+}
+
+// sameChanges returns whether two lists of changes are equal, regardless of when they were detected
+func sameChanges(a []topicChange, b []topicChange) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		x, y := a[i], b[i]
+		x.Time, y.Time = time.Time{}, time.Time{}
+		if x != y {
+			return false
+		}
+	}
+	return true
+}
+
+// diffSnapshots returns the changes between two snapshots, sorted by topic and configuration key
+func diffSnapshots(previous map[string]topicSnapshot, current map[string]topicSnapshot, now time.Time) []topicChange {
+	var changes []topicChange
+
+	for _, name := range sortedTopicNames(previous, current) {
+		before, existed := previous[name]
+		after, exists := current[name]
+
+		switch {
+		case !existed:
+			changes = append(changes, topicChange{Time: now, Topic: name, Change: changeCreated, Current: strconv.Itoa(after.partitions)})
+			continue
+		case !exists:
+			changes = append(changes, topicChange{Time: now, Topic: name, Change: changeDeleted, Previous: strconv.Itoa(before.partitions)})
+			continue
+		}
+
+		if before.partitions != after.partitions {
+			changes = append(changes, topicChange{
+				Time:     now,
+				Topic:    name,
+				Change:   changePartitions,
+				Previous: strconv.Itoa(before.partitions),
+				Current:  strconv.Itoa(after.partitions),
+			})
+		}
+
+		for _, key := range sortedConfigKeys(before.config, after.config) {
+			beforeValue, beforeOk := before.config[key]
+			afterValue, afterOk := after.config[key]
+			if beforeOk == afterOk && beforeValue == afterValue {
+				continue
+			}
+			changes = append(changes, topicChange{
+				Time:     now,
+				Topic:    name,
+				Change:   changeConfig,
+				Key:      key,
+				Previous: beforeValue,
+				Current:  afterValue,
+			})
+		}
+	}
+
+	return changes
+}
+
+func sortedTopicNames(previous map[string]topicSnapshot, current map[string]topicSnapshot) []string {
+	names := make([]string, 0, len(current))
+	for name := range previous {
+		names = append(names, name)
+	}
+	for name := range current {
+		if _, ok := previous[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedConfigKeys(previous map[string]string, current map[string]string) []string {
+	keys := make([]string, 0, len(current))
+	for key := range previous {
+		keys = append(keys, key)
+	}
+	for key := range current {
+		if _, ok := previous[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
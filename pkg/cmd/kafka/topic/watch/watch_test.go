@@ -0,0 +1,120 @@
+package watch
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/topiccmdutil"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
+)
+
+func Test_diffSnapshots(t *testing.T) {
+	now := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	previous := map[string]topicSnapshot{
+		"orders":   {partitions: 3, config: map[string]string{"retention.ms": "604800000", "cleanup.policy": "delete"}},
+		"payments": {partitions: 1, config: map[string]string{}},
+		"legacy":   {partitions: 1, config: map[string]string{}},
+	}
+
+	tests := []struct {
+		name    string
+		current map[string]topicSnapshot
+		want    []topicChange
+	}{
+		{
+			name:    "no changes",
+			current: previous,
+			want:    nil,
+		},
+		{
+			name: "topics created, deleted and changed",
+			current: map[string]topicSnapshot{
+				"orders":   {partitions: 6, config: map[string]string{"retention.ms": "86400000", "max.message.bytes": "2097152"}},
+				"payments": {partitions: 1, config: map[string]string{}},
+				"audit":    {partitions: 2, config: map[string]string{}},
+			},
+			want: []topicChange{
+				{Time: now, Topic: "audit", Change: changeCreated, Current: "2"},
+				{Time: now, Topic: "legacy", Change: changeDeleted, Previous: "1"},
+				{Time: now, Topic: "orders", Change: changePartitions, Previous: "3", Current: "6"},
+				{Time: now, Topic: "orders", Change: changeConfig, Key: "cleanup.policy", Previous: "delete"},
+				{Time: now, Topic: "orders", Change: changeConfig, Key: "max.message.bytes", Current: "2097152"},
+				{Time: now, Topic: "orders", Change: changeConfig, Key: "retention.ms", Previous: "604800000", Current: "86400000"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := diffSnapshots(previous, tt.current, now); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffSnapshots() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func newTopic(name string, partitions int, internal bool, config map[string]string) kafkainstanceclient.Topic {
+	topic := kafkainstanceclient.NewTopic()
+	topic.SetName(name)
+	topic.SetIsInternal(internal)
+	topic.SetPartitions(make([]kafkainstanceclient.Partition, partitions))
+	entries := []kafkainstanceclient.ConfigEntry{}
+	for key, value := range config {
+		entries = append(entries, *kafkainstanceclient.NewConfigEntry(key, value))
+	}
+	topic.SetConfig(entries)
+	return *topic
+}
+
+func Test_diffManifest(t *testing.T) {
+	now := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	manifest := manifestSnapshot([]topiccmdutil.TopicDefinition{
+		{Name: "orders", Partitions: 3, Config: map[string]string{"retention.ms": "604800000"}},
+		{Name: "payments", Partitions: 0, Config: map[string]string{}},
+		{Name: "legacy", Partitions: 1, Config: map[string]string{}},
+	})
+
+	topics := []kafkainstanceclient.Topic{
+		// the default value of cleanup.policy is not declared in the manifest
+		newTopic("orders", 6, false, map[string]string{"retention.ms": "86400000", "cleanup.policy": "delete"}),
+		// the manifest does not declare the partitions of payments
+		newTopic("payments", 12, false, map[string]string{"cleanup.policy": "delete"}),
+		newTopic("audit", 2, false, map[string]string{}),
+		newTopic("__consumer_offsets", 50, true, map[string]string{}),
+	}
+
+	want := []topicChange{
+		{Time: now, Topic: "audit", Change: changeCreated, Current: "2"},
+		{Time: now, Topic: "legacy", Change: changeDeleted, Previous: "1"},
+		{Time: now, Topic: "orders", Change: changePartitions, Previous: "3", Current: "6"},
+		{Time: now, Topic: "orders", Change: changeConfig, Key: "retention.ms", Previous: "604800000", Current: "86400000"},
+	}
+	if got := diffManifest(manifest, topics, now); !reflect.DeepEqual(got, want) {
+		t.Errorf("diffManifest() = %+v, want %+v", got, want)
+	}
+
+	matching := []kafkainstanceclient.Topic{
+		newTopic("orders", 3, false, map[string]string{"retention.ms": "604800000", "cleanup.policy": "delete"}),
+		newTopic("payments", 1, false, map[string]string{}),
+		newTopic("legacy", 1, false, map[string]string{}),
+	}
+	if got := diffManifest(manifest, matching, now); len(got) != 0 {
+		t.Errorf("diffManifest() = %+v, want no differences", got)
+	}
+}
+
+func Test_sameChanges(t *testing.T) {
+	earlier := []topicChange{{Time: time.Unix(0, 0), Topic: "orders", Change: changeDeleted, Previous: "3"}}
+	later := []topicChange{{Time: time.Unix(60, 0), Topic: "orders", Change: changeDeleted, Previous: "3"}}
+	other := []topicChange{{Time: time.Unix(60, 0), Topic: "orders", Change: changePartitions, Previous: "3", Current: "6"}}
+
+	if !sameChanges(earlier, later) {
+		t.Errorf("sameChanges() = false for changes detected at different times")
+	}
+	if sameChanges(earlier, other) {
+		t.Errorf("sameChanges() = true for different changes")
+	}
+	if sameChanges(earlier, nil) || !sameChanges(nil, []topicChange{}) {
+		t.Errorf("sameChanges() compares the number of changes")
+	}
+}
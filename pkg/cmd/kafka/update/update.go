@@ -15,6 +15,7 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/config"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/color"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/spinner"
@@ -34,10 +35,13 @@ import (
 )
 
 type options struct {
-	name        string
-	id          string
-	owner       string
-	skipConfirm bool
+	name         string
+	id           string
+	owner        string
+	kafkaVersion string
+	skipConfirm  bool
+	dryRun       bool
+	outputFormat string
 
 	interactive    bool
 	userIsOrgAdmin bool
@@ -82,16 +86,23 @@ func NewUpdateCommand(f *factory.Factory) *cobra.Command {
 
 			opts.userIsOrgAdmin = token.IsOrgAdmin(cfg.AccessToken)
 
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
 			if !opts.IO.CanPrompt() {
 				var missingFlags []string
-				if !opts.skipConfirm {
+				if !opts.skipConfirm && !opts.dryRun {
 					missingFlags = append(missingFlags, "yes")
 				}
 				if len(missingFlags) > 0 {
 					return flagutil.RequiredWhenNonInteractiveError(missingFlags...)
 				}
 			}
-			if opts.owner == "" && opts.reauth == "" {
+			if opts.kafkaVersion != "" && (opts.owner != "" || opts.reauth != "") {
+				return opts.localizer.MustLocalizeError("kafka.update.error.kafkaVersionWithOtherFlags")
+			}
+			if opts.owner == "" && opts.reauth == "" && opts.kafkaVersion == "" {
 				opts.interactive = true
 			}
 
@@ -100,7 +111,7 @@ func NewUpdateCommand(f *factory.Factory) *cobra.Command {
 			}
 
 			if opts.id != "" || opts.name != "" {
-				return run(&opts)
+				return update(&opts)
 			}
 
 			kafkaInstance, err := contextutil.GetCurrentKafkaInstance(f)
@@ -110,7 +121,7 @@ func NewUpdateCommand(f *factory.Factory) *cobra.Command {
 
 			opts.id = kafkaInstance.GetId()
 
-			return run(&opts)
+			return update(&opts)
 		},
 	}
 
@@ -118,9 +129,12 @@ func NewUpdateCommand(f *factory.Factory) *cobra.Command {
 
 	flags.StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("kafka.update.flag.id"))
 	flags.StringVar(&opts.owner, "owner", "", opts.localizer.MustLocalize("kafka.update.flag.owner"))
+	flags.StringVar(&opts.kafkaVersion, "kafka-version", "", opts.localizer.MustLocalize("kafka.update.flag.kafkaVersion"))
 	flags.TriBoolVar(&opts.reauth, "reauthentication", flagutil.TRIBOOL_DEFAULT, opts.localizer.MustLocalize("kafka.update.flag.reauthentication"))
 	flags.AddYes(&opts.skipConfirm)
 	flags.StringVar(&opts.name, "name", "", opts.localizer.MustLocalize("kafka.update.flag.name"))
+	flags.BoolVar(&opts.dryRun, "dry-run", false, opts.localizer.MustLocalize("kafka.update.flag.dryRun"))
+	flags.AddOutput(&opts.outputFormat)
 
 	_ = kafkaflagutil.RegisterNameFlagCompletionFunc(cmd, f)
 	_ = flagutil.RegisterUserCompletionFunc(cmd, "owner", f)
@@ -128,6 +142,13 @@ func NewUpdateCommand(f *factory.Factory) *cobra.Command {
 	return cmd
 }
 
+func update(opts *options) error {
+	if opts.kafkaVersion != "" {
+		return runUpgrade(opts)
+	}
+	return run(opts)
+}
+
 func run(opts *options) error {
 	conn, err := opts.Connection()
 	if err != nil {
@@ -153,6 +174,10 @@ func run(opts *options) error {
 		return err
 	}
 
+	if opts.dryRun {
+		return printDryRun(opts, kafkaInstance, updatableFields(kafkaInstance), updatedFields(kafkaInstance, updateObj))
+	}
+
 	// create a text block with a summary of what is being updated
 	updateSummary := generateUpdateSummary(reflect.ValueOf(*updateObj), reflect.ValueOf(*kafkaInstance))
 
@@ -313,6 +338,42 @@ func promptConfirmUpdate(opts *options) (bool, error) {
 	return confirmUpdate, nil
 }
 
+// printDryRun prints the changes of the update without applying them
+func printDryRun(opts *options, kafkaInstance *kafkamgmtclient.KafkaRequest, before map[string]interface{}, after map[string]interface{}) error {
+	diff, err := dump.NewDiff("KafkaRequest", kafkaInstance.GetName(), before, after)
+	if err != nil {
+		return err
+	}
+
+	if err = dump.PrintDiff(opts.IO.Out, opts.outputFormat, diff); err != nil {
+		return err
+	}
+
+	opts.logger.Info(opts.localizer.MustLocalize("kafka.update.log.info.dryRun", localize.NewEntry("Name", kafkaInstance.GetName())))
+
+	return nil
+}
+
+// updatableFields returns the fields of the Kafka instance which can be changed by the update
+func updatableFields(kafkaInstance *kafkamgmtclient.KafkaRequest) map[string]interface{} {
+	return map[string]interface{}{
+		"owner":                    kafkaInstance.GetOwner(),
+		"reauthentication_enabled": kafkaInstance.GetReauthenticationEnabled(),
+	}
+}
+
+// updatedFields returns the fields of the Kafka instance once the update is applied
+func updatedFields(kafkaInstance *kafkamgmtclient.KafkaRequest, updateObj *kafkamgmtclient.KafkaUpdateRequest) map[string]interface{} {
+	fields := updatableFields(kafkaInstance)
+	if owner, ok := updateObj.GetOwnerOk(); ok {
+		fields["owner"] = *owner
+	}
+	if reauth, ok := updateObj.GetReauthenticationEnabledOk(); ok {
+		fields["reauthentication_enabled"] = *reauth
+	}
+	return fields
+}
+
 // creates a summary of what values will be changed in this update
 // returns a formatted string. Example:
 // owner: foo_user	➡️	bar_user
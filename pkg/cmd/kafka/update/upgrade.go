@@ -0,0 +1,134 @@
+package update
+
+import (
+	"github.com/blang/semver"
+	"github.com/redhat-developer/app-services-cli/pkg/api/fleetadmin"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/kafkacmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/color"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/spinner"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+)
+
+// runUpgrade changes the Kafka version of the instance through the fleet manager admin API,
+// as the version is not managed by the owners of the instance
+func runUpgrade(opts *options) error {
+	conn, err := opts.Connection()
+	if err != nil {
+		return err
+	}
+
+	api := conn.API()
+
+	kafkaInstance, err := getCurrentKafkaInstance(opts, api.KafkaMgmt())
+	if err != nil {
+		return err
+	}
+
+	kafkaAPI := api.FleetAdmin().KafkaAPI()
+
+	adminInstance, httpRes, err := kafkaAPI.GetKafkaByID(opts.Context, kafkaInstance.GetId())
+	if err != nil {
+		return kafkacmdutil.AdminAPIError(opts.localizer, httpRes, err)
+	}
+
+	currentVersion := currentKafkaVersion(adminInstance)
+	if err = validateUpgrade(opts, adminInstance, currentVersion); err != nil {
+		return err
+	}
+
+	if opts.dryRun {
+		return printDryRun(opts, kafkaInstance,
+			map[string]interface{}{"kafka_version": currentVersion},
+			map[string]interface{}{"kafka_version": opts.kafkaVersion},
+		)
+	}
+
+	opts.logger.Infof(`
+ %v %v
+
+   %v: %v    %v    %v
+
+%v
+`,
+		color.Underline(color.Bold(opts.localizer.MustLocalize("kafka.update.summaryTitle"))),
+		icon.Emoji("\U0001f50e", ""),
+		color.Bold("kafka_version"), currentVersion, icon.Emoji("➡", "=>"), opts.kafkaVersion,
+		opts.localizer.MustLocalize("kafka.update.kafkaVersion.disclaimer"),
+	)
+
+	if !opts.skipConfirm {
+		confirm, promptErr := promptConfirmUpdate(opts)
+		if promptErr != nil {
+			return promptErr
+		}
+		if !confirm {
+			opts.logger.Debug("User has chosen to not upgrade Kafka instance")
+			return nil
+		}
+	}
+
+	s := spinner.New(opts.IO.ErrOut, opts.localizer)
+	s.SetLocalizedSuffix("kafka.update.log.info.updating", localize.NewEntry("Name", kafkaInstance.GetName()))
+	s.Start()
+
+	_, httpRes, err = kafkaAPI.UpdateKafka(opts.Context, kafkaInstance.GetId(), fleetadmin.KafkaUpdateRequest{
+		KafkaVersion: opts.kafkaVersion,
+	})
+
+	s.Stop()
+
+	if err != nil {
+		return kafkacmdutil.AdminAPIError(opts.localizer, httpRes, err)
+	}
+
+	opts.logger.Info()
+	opts.logger.Info(opts.localizer.MustLocalize("kafka.update.log.info.upgradeStarted",
+		localize.NewEntry("Name", kafkaInstance.GetName()),
+		localize.NewEntry("ID", kafkaInstance.GetId()),
+		localize.NewEntry("Version", opts.kafkaVersion),
+	))
+
+	return nil
+}
+
+// currentKafkaVersion returns the Kafka version running on the instance
+func currentKafkaVersion(kafka *fleetadmin.Kafka) string {
+	if kafka.ActualKafkaVersion != "" {
+		return kafka.ActualKafkaVersion
+	}
+	return kafka.Version
+}
+
+// validateUpgrade checks that the requested version is an upgrade of the running version
+func validateUpgrade(opts *options, kafka *fleetadmin.Kafka, currentVersion string) error {
+	if kafka.KafkaUpgrading {
+		return opts.localizer.MustLocalizeError("kafka.update.error.upgradeInProgress",
+			localize.NewEntry("Name", kafka.Name),
+			localize.NewEntry("Version", kafka.DesiredKafkaVersion),
+		)
+	}
+
+	target, err := semver.ParseTolerant(opts.kafkaVersion)
+	if err != nil {
+		return opts.localizer.MustLocalizeError("kafka.update.error.invalidKafkaVersion", localize.NewEntry("Version", opts.kafkaVersion))
+	}
+
+	current, err := semver.ParseTolerant(currentVersion)
+	if err != nil {
+		// the running version is unknown, let the service decide if the upgrade is allowed
+		return nil
+	}
+
+	switch {
+	case target.EQ(current):
+		return opts.localizer.MustLocalizeError("kafka.update.log.info.nothingToUpdate")
+	case target.LT(current):
+		return opts.localizer.MustLocalizeError("kafka.update.error.kafkaDowngrade",
+			localize.NewEntry("Version", opts.kafkaVersion),
+			localize.NewEntry("CurrentVersion", currentVersion),
+		)
+	}
+
+	return nil
+}
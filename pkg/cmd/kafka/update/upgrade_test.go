@@ -0,0 +1,93 @@
+package update
+
+import (
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/fleetadmin"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize/goi18n"
+)
+
+func Test_validateUpgrade(t *testing.T) {
+	localizer, _ := goi18n.New(nil)
+
+	tests := []struct {
+		name           string
+		kafkaVersion   string
+		kafka          fleetadmin.Kafka
+		currentVersion string
+		wantErr        string
+	}{
+		{
+			name:           "upgrade",
+			kafkaVersion:   "3.1.0",
+			currentVersion: "3.0.1",
+		},
+		{
+			name:           "upgrade with a partial version",
+			kafkaVersion:   "3.1",
+			currentVersion: "3.0.1",
+		},
+		{
+			name:           "downgrade",
+			kafkaVersion:   "2.8.1",
+			currentVersion: "3.0.1",
+			wantErr: localizer.MustLocalize("kafka.update.error.kafkaDowngrade",
+				localize.NewEntry("Version", "2.8.1"),
+				localize.NewEntry("CurrentVersion", "3.0.1"),
+			),
+		},
+		{
+			name:           "same version",
+			kafkaVersion:   "3.0.1",
+			currentVersion: "3.0.1",
+			wantErr:        localizer.MustLocalize("kafka.update.log.info.nothingToUpdate"),
+		},
+		{
+			name:           "unknown running version",
+			kafkaVersion:   "3.1.0",
+			currentVersion: "",
+		},
+		{
+			name:           "invalid requested version",
+			kafkaVersion:   "latest",
+			currentVersion: "3.0.1",
+			wantErr:        localizer.MustLocalize("kafka.update.error.invalidKafkaVersion", localize.NewEntry("Version", "latest")),
+		},
+		{
+			name:           "upgrade in progress",
+			kafkaVersion:   "3.1.0",
+			kafka:          fleetadmin.Kafka{Name: "my-kafka", KafkaUpgrading: true, DesiredKafkaVersion: "3.0.1"},
+			currentVersion: "2.8.1",
+			wantErr: localizer.MustLocalize("kafka.update.error.upgradeInProgress",
+				localize.NewEntry("Name", "my-kafka"),
+				localize.NewEntry("Version", "3.0.1"),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := &options{kafkaVersion: tt.kafkaVersion, localizer: localizer}
+
+			err := validateUpgrade(opts, &tt.kafka, tt.currentVersion)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("validateUpgrade() error = %v, want no error", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("validateUpgrade() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_currentKafkaVersion(t *testing.T) {
+	if got := currentKafkaVersion(&fleetadmin.Kafka{Version: "2.8.1", ActualKafkaVersion: "3.0.1"}); got != "3.0.1" {
+		t.Errorf("currentKafkaVersion() = %v, want the actual version", got)
+	}
+	if got := currentKafkaVersion(&fleetadmin.Kafka{Version: "2.8.1"}); got != "2.8.1" {
+		t.Errorf("currentKafkaVersion() = %v, want the version when the actual version is unknown", got)
+	}
+}
@@ -1,15 +1,7 @@
 package use
 
 import (
-	"context"
-	"fmt"
-
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
-
-	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
-	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
-	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
-	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
 	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
 
 	"github.com/redhat-developer/app-services-cli/pkg/shared/connection"
@@ -21,130 +13,54 @@ import (
 	"github.com/spf13/cobra"
 )
 
-type options struct {
-	id          string
-	name        string
-	interactive bool
-
-	IO             *iostreams.IOStreams
-	Connection     factory.ConnectionFunc
-	Logger         logging.Logger
-	localizer      localize.Localizer
-	Context        context.Context
-	ServiceContext servicecontext.IContext
-}
-
 func NewUseCommand(f *factory.Factory) *cobra.Command {
-	opts := &options{
-		Connection:     f.Connection,
-		Logger:         f.Logger,
-		IO:             f.IOStreams,
-		localizer:      f.Localizer,
-		Context:        f.Context,
-		ServiceContext: f.ServiceContext,
+	opts := &contextutil.UseOptions{
+		GetByID: func(conn connection.Connection, id string) (*contextutil.Resource, error) {
+			kafkaInstance, _, err := kafkautil.GetKafkaByID(f.Context, conn.API().KafkaMgmt(), id)
+			return kafkaResource(kafkaInstance), err
+		},
+		GetByName: func(conn connection.Connection, name string) (*contextutil.Resource, error) {
+			kafkaInstance, _, err := kafkautil.GetKafkaByName(f.Context, conn.API().KafkaMgmt(), name)
+			return kafkaResource(kafkaInstance), err
+		},
+		InteractiveSelect: func(conn connection.Connection) (*contextutil.Resource, error) {
+			kafkaInstance, err := kafkautil.InteractiveSelect(f.Context, conn, f.Logger, f.Localizer)
+			return kafkaResource(kafkaInstance), err
+		},
+		SetID: func(svcConfig *servicecontext.ServiceConfig, id string) {
+			svcConfig.KafkaID = id
+		},
+		IDOrNameRequiredError: "kafka.use.error.idOrNameRequired",
+		SaveError:             "kafka.use.error.saveError",
+		UseSuccess:            "kafka.use.log.info.useSuccess",
 	}
 
 	cmd := &cobra.Command{
 		Use:     "use",
-		Short:   opts.localizer.MustLocalize("kafka.use.cmd.shortDescription"),
-		Long:    opts.localizer.MustLocalize("kafka.use.cmd.longDescription"),
-		Example: opts.localizer.MustLocalize("kafka.use.cmd.example"),
+		Short:   f.Localizer.MustLocalize("kafka.use.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("kafka.use.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("kafka.use.cmd.example"),
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if opts.id == "" && opts.name == "" {
-				if !opts.IO.CanPrompt() {
-					return opts.localizer.MustLocalizeError("kafka.use.error.idOrNameRequired")
-				}
-				opts.interactive = true
-			}
-
-			if opts.name != "" && opts.id != "" {
-				return opts.localizer.MustLocalizeError("service.error.idAndNameCannotBeUsed")
-			}
-
-			return runUse(opts)
+			return contextutil.Use(f, opts)
 		},
 	}
 
-	flags := flagutil.NewFlagSet(cmd, opts.localizer)
+	flags := flagutil.NewFlagSet(cmd, f.Localizer)
 
-	flags.StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("kafka.use.flag.id"))
-	flags.StringVar(&opts.name, "name", "", opts.localizer.MustLocalize("kafka.use.flag.name"))
+	flags.StringVar(&opts.ID, "id", "", f.Localizer.MustLocalize("kafka.use.flag.id"))
+	flags.StringVar(&opts.Name, "name", "", f.Localizer.MustLocalize("kafka.use.flag.name"))
 
 	if err := flagutil.RegisterNameFlagCompletionFunc(cmd, f); err != nil {
-		opts.Logger.Debug(opts.localizer.MustLocalize("kafka.common.error.load.completions.name.flag"), err)
+		f.Logger.Debug(f.Localizer.MustLocalize("kafka.common.error.load.completions.name.flag"), err)
 	}
 
 	return cmd
 }
 
-func runUse(opts *options) error {
-
-	svcContext, err := opts.ServiceContext.Load()
-	if err != nil {
-		return err
-	}
-
-	currCtx, err := contextutil.GetCurrentContext(svcContext, opts.localizer)
-	if err != nil {
-		return err
-	}
-
-	conn, err := opts.Connection()
-	if err != nil {
-		return err
-	}
-
-	if opts.interactive {
-		// run the use command interactively
-		err = runInteractivePrompt(opts, &conn)
-		if err != nil {
-			return err
-		}
-		// no Kafka was selected, exit program
-		if opts.name == "" {
-			return nil
-		}
-	}
-
-	api := conn.API()
-
-	var res *kafkamgmtclient.KafkaRequest
-	if opts.name != "" {
-		res, _, err = kafkautil.GetKafkaByName(opts.Context, api.KafkaMgmt(), opts.name)
-		if err != nil {
-			return err
-		}
-	} else {
-		res, _, err = kafkautil.GetKafkaByID(opts.Context, api.KafkaMgmt(), opts.id)
-		if err != nil {
-			return err
-		}
-	}
-
-	nameTmplEntry := localize.NewEntry("Name", res.GetName())
-	currCtx.KafkaID = res.GetId()
-	svcContext.Contexts[svcContext.CurrentContext] = *currCtx
-
-	if err := opts.ServiceContext.Save(svcContext); err != nil {
-		saveErrMsg := opts.localizer.MustLocalize("kafka.use.error.saveError", nameTmplEntry)
-		return fmt.Errorf("%v: %w", saveErrMsg, err)
-	}
-
-	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("kafka.use.log.info.useSuccess", nameTmplEntry))
-
-	return nil
-}
-
-func runInteractivePrompt(opts *options, conn *connection.Connection) error {
-	opts.Logger.Debug(opts.localizer.MustLocalize("common.log.debug.startingInteractivePrompt"))
-
-	selectedKafka, err := kafkautil.InteractiveSelect(opts.Context, *conn, opts.Logger, opts.localizer)
-	if err != nil {
-		return err
+func kafkaResource(kafkaInstance *kafkamgmtclient.KafkaRequest) *contextutil.Resource {
+	if kafkaInstance == nil {
+		return nil
 	}
-
-	opts.name = selectedKafka.GetName()
-
-	return nil
+	return &contextutil.Resource{ID: kafkaInstance.GetId(), Name: kafkaInstance.GetName()}
 }
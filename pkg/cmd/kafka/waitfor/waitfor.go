@@ -0,0 +1,128 @@
+package waitfor
+
+import (
+	"context"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/jobs"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/kafkautil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/svcstatus"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/waitutil"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultCondition = "status=ready"
+	defaultTimeout   = 20 * time.Minute
+)
+
+type options struct {
+	id        string
+	condition string
+	timeout   time.Duration
+	async     bool
+
+	f *factory.Factory
+}
+
+// NewWaitForCommand creates a new command which waits until a Kafka instance satisfies a condition
+func NewWaitForCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "wait-for",
+		Short:   f.Localizer.MustLocalize("kafka.waitFor.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("kafka.waitFor.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("kafka.waitFor.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.timeout <= 0 {
+				return f.Localizer.MustLocalizeError("waitFor.error.invalidTimeout", localize.NewEntry("Timeout", opts.timeout))
+			}
+
+			if opts.async {
+				return jobs.RunAsync(f)
+			}
+
+			if opts.id == "" {
+				kafkaInstance, err := contextutil.GetCurrentKafkaInstance(f)
+				if err != nil {
+					return err
+				}
+
+				opts.id = kafkaInstance.GetId()
+			}
+
+			return runWaitFor(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, f.Localizer)
+
+	flags.StringVar(&opts.id, "id", "", f.Localizer.MustLocalize("kafka.waitFor.flag.id.description"))
+	flags.StringVar(&opts.condition, "condition", defaultCondition, f.Localizer.MustLocalize("waitFor.flag.condition.description"))
+	flags.DurationVar(&opts.timeout, "timeout", defaultTimeout, f.Localizer.MustLocalize("waitFor.flag.timeout.description"))
+	flags.AddAsync(&opts.async)
+
+	return cmd
+}
+
+func runWaitFor(opts *options) error {
+	f := opts.f
+
+	condition, err := waitutil.ParseCondition(opts.condition)
+	if err != nil {
+		return err
+	}
+
+	conn, err := f.Connection()
+	if err != nil {
+		return err
+	}
+
+	api := conn.API().KafkaMgmt()
+
+	kafkaInstance, httpRes, err := kafkautil.GetKafkaByID(f.Context, api, opts.id)
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		return err
+	}
+
+	fetch := func(ctx context.Context) (interface{}, error) {
+		kafka, httpRes, err := kafkautil.GetKafkaByID(ctx, api, opts.id)
+		if httpRes != nil {
+			defer httpRes.Body.Close()
+		}
+		return kafka, err
+	}
+
+	abort := func(resource interface{}) error {
+		kafka := resource.(*kafkamgmtclient.KafkaRequest)
+		if kafka.GetStatus() == svcstatus.StatusFailed {
+			return f.Localizer.MustLocalizeError("kafka.waitFor.error.failed",
+				localize.NewEntry("Name", kafka.GetName()),
+				localize.NewEntry("Reason", kafka.GetFailedReason()),
+			)
+		}
+		return nil
+	}
+
+	_, err = waitutil.UntilWithSpinner(f, kafkaInstance.GetName(), fetch, condition, waitutil.Options{
+		Interval: cmdutil.DefaultPollTime,
+		Timeout:  opts.timeout,
+		Abort:    abort,
+	})
+
+	return err
+}
@@ -0,0 +1,62 @@
+package login
+
+import (
+	"sort"
+
+	"github.com/redhat-developer/app-services-cli/internal/build"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+)
+
+// environmentPresets are the built-in environments accepted by the `--env` option.
+// Each preset sets the API gateway and the authentication server, including its SSO realm, together.
+var environmentPresets = map[string]config.EnvironmentConfig{
+	"production": {
+		APIUrl:  build.ProductionAPIURL,
+		AuthURL: build.ProductionAuthURL,
+	},
+	"staging": {
+		APIUrl:  build.StagingAPIURL,
+		AuthURL: build.StagingAuthURL,
+	},
+	"integration": {
+		APIUrl:  build.IntegrationAPIURL,
+		AuthURL: build.StagingAuthURL,
+	},
+}
+
+// resolveEnvironment returns the URLs of the named environment.
+// Environments defined in the config override the values of the built-in presets.
+func resolveEnvironment(name string, configured map[string]config.EnvironmentConfig) (*config.EnvironmentConfig, error) {
+	preset, isPreset := environmentPresets[name]
+	override, isConfigured := configured[name]
+
+	if !isPreset && !isConfigured {
+		return nil, flagutil.InvalidValueError("env", name, environmentNames(configured)...)
+	}
+
+	if override.APIUrl != "" {
+		preset.APIUrl = override.APIUrl
+	}
+	if override.AuthURL != "" {
+		preset.AuthURL = override.AuthURL
+	}
+
+	return &preset, nil
+}
+
+// environmentNames returns the sorted names of the built-in and configured environments
+func environmentNames(configured map[string]config.EnvironmentConfig) []string {
+	names := make([]string, 0, len(environmentPresets)+len(configured))
+	for name := range environmentPresets {
+		names = append(names, name)
+	}
+	for name := range configured {
+		if _, ok := environmentPresets[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
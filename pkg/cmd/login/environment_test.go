@@ -0,0 +1,55 @@
+package login
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/internal/build"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+)
+
+func TestResolveEnvironment(t *testing.T) {
+	configured := map[string]config.EnvironmentConfig{
+		"staging": {AuthURL: "https://sso.example.com/auth/realms/test"},
+		"local":   {APIUrl: "http://localhost:8000", AuthURL: "http://localhost:8080/auth/realms/rhoas"},
+	}
+
+	tests := []struct {
+		name    string
+		env     string
+		want    *config.EnvironmentConfig
+		wantErr bool
+	}{
+		{
+			name: "built-in preset",
+			env:  "production",
+			want: &config.EnvironmentConfig{APIUrl: build.ProductionAPIURL, AuthURL: build.ProductionAuthURL},
+		},
+		{
+			name: "built-in preset overridden by the config",
+			env:  "staging",
+			want: &config.EnvironmentConfig{APIUrl: build.StagingAPIURL, AuthURL: "https://sso.example.com/auth/realms/test"},
+		},
+		{
+			name: "environment defined in the config",
+			env:  "local",
+			want: &config.EnvironmentConfig{APIUrl: "http://localhost:8000", AuthURL: "http://localhost:8080/auth/realms/rhoas"},
+		},
+		{
+			name:    "unknown environment",
+			env:     "qa",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveEnvironment(tt.env, configured)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveEnvironment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resolveEnvironment() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
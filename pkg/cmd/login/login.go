@@ -9,6 +9,7 @@ import (
 
 	"github.com/redhat-developer/app-services-cli/pkg/core/auth/login"
 
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/config"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
@@ -27,10 +28,11 @@ import (
 // When the value of the `--api-gateway` option is one of the keys of this map it will be replaced by the
 // corresponding value.
 var apiGatewayAliases = map[string]string{
-	"production": build.ProductionAPIURL,
-	"prod":       build.ProductionAPIURL,
-	"staging":    build.StagingAPIURL,
-	"stage":      build.StagingAPIURL,
+	"production":  build.ProductionAPIURL,
+	"prod":        build.ProductionAPIURL,
+	"staging":     build.StagingAPIURL,
+	"stage":       build.StagingAPIURL,
+	"integration": build.IntegrationAPIURL,
 }
 
 // When the value of the `--auth-url` option is one of the keys of this map it will be replaced by the
@@ -52,6 +54,7 @@ type options struct {
 
 	url                   string
 	authURL               string
+	env                   string
 	clientID              string
 	scopes                []string
 	insecureSkipTLSVerify bool
@@ -81,6 +84,12 @@ func NewLoginCmd(f *factory.Factory) *cobra.Command {
 				opts.clientID = build.DefaultOfflineTokenClientID
 			}
 
+			if opts.env != "" {
+				if err := applyEnvironment(opts, cmd); err != nil {
+					return err
+				}
+			}
+
 			if opts.IO.IsSSHSession() && opts.offlineToken == "" {
 				opts.Logger.Debug(opts.localizer.MustLocalize("login.log.debug.sshLoginDetected", localize.NewEntry("OfflineTokenURL", build.OfflineTokenURL)))
 			}
@@ -93,13 +102,47 @@ func NewLoginCmd(f *factory.Factory) *cobra.Command {
 	cmd.Flags().BoolVar(&opts.insecureSkipTLSVerify, "insecure", false, opts.localizer.MustLocalize("login.flag.insecure"))
 	cmd.Flags().StringVar(&opts.clientID, "client-id", build.DefaultClientID, opts.localizer.MustLocalize("login.flag.clientId"))
 	cmd.Flags().StringVar(&opts.authURL, "auth-url", build.ProductionAuthURL, opts.localizer.MustLocalize("login.flag.authUrl"))
+	cmd.Flags().StringVar(&opts.env, "env", "", opts.localizer.MustLocalize("login.flag.env"))
 	cmd.Flags().BoolVar(&opts.printURL, "print-sso-url", false, opts.localizer.MustLocalize("login.flag.printSsoUrl"))
 	cmd.Flags().StringArrayVar(&opts.scopes, "scope", kcconnection.DefaultScopes, opts.localizer.MustLocalize("login.flag.scope"))
-	cmd.Flags().StringVarP(&opts.offlineToken, "token", "t", "", opts.localizer.MustLocalize("login.flag.token", localize.NewEntry("OfflineTokenURL", build.OfflineTokenURL)))
+	flagutil.NewFlagSet(cmd, opts.localizer).AddSecret(&opts.offlineToken, "token", "t", opts.IO.In, opts.localizer.MustLocalize("login.flag.token", localize.NewEntry("OfflineTokenURL", build.OfflineTokenURL)))
+
+	_ = cmd.RegisterFlagCompletionFunc("env", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		var configured map[string]config.EnvironmentConfig
+		if cfg, err := opts.Config.Load(); err == nil {
+			configured = cfg.Environments
+		}
+		return environmentNames(configured), cobra.ShellCompDirectiveNoSpace
+	})
 
 	return cmd
 }
 
+// applyEnvironment sets the API gateway and authentication URLs from the selected environment.
+// The "--api-gateway" and "--auth-url" flags take precedence when set explicitly.
+func applyEnvironment(opts *options, cmd *cobra.Command) error {
+	cfg, err := opts.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	env, err := resolveEnvironment(opts.env, cfg.Environments)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Flags().Changed("api-gateway") && env.APIUrl != "" {
+		opts.url = env.APIUrl
+	}
+	if !cmd.Flags().Changed("auth-url") && env.AuthURL != "" {
+		opts.authURL = env.AuthURL
+	}
+
+	opts.Logger.Debug("Using environment", opts.env, "with API gateway", opts.url, "and auth URL", opts.authURL)
+
+	return nil
+}
+
 // nolint:funlen
 func runLogin(opts *options) (err error) {
 	gatewayURL, err := getURLFromAlias(opts.url, apiGatewayAliases, opts.localizer)
@@ -8,26 +8,31 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
 	"github.com/spf13/cobra"
 )
 
 type options struct {
-	Config     config.IConfig
-	Connection factory.ConnectionFunc
-	Logger     logging.Logger
-	localizer  localize.Localizer
-	Context    context.Context
+	all bool
+
+	Config         config.IConfig
+	Connection     factory.ConnectionFunc
+	Logger         logging.Logger
+	localizer      localize.Localizer
+	Context        context.Context
+	ServiceContext servicecontext.IContext
 }
 
 // NewLogoutCommand gets the command that's logs the current logged in user
 func NewLogoutCommand(f *factory.Factory) *cobra.Command {
 	opts := &options{
-		Config:     f.Config,
-		Connection: f.Connection,
-		Logger:     f.Logger,
-		localizer:  f.Localizer,
-		Context:    f.Context,
+		Config:         f.Config,
+		Connection:     f.Connection,
+		Logger:         f.Logger,
+		localizer:      f.Localizer,
+		Context:        f.Context,
+		ServiceContext: f.ServiceContext,
 	}
 
 	cmd := &cobra.Command{
@@ -40,22 +45,58 @@ func NewLogoutCommand(f *factory.Factory) *cobra.Command {
 			return runLogout(opts)
 		},
 	}
+
+	cmd.Flags().BoolVar(&opts.all, "all", false, opts.localizer.MustLocalize("logout.flag.all.description"))
+
 	return cmd
 }
 
 func runLogout(opts *options) error {
+	// with --all the stored sessions are cleared even when the session has expired and no connection can be made
 	conn, err := opts.Connection()
-	if err != nil {
+	if err == nil {
+		err = conn.Logout(opts.Context)
+	} else if !opts.all {
 		return err
 	}
 
-	err = conn.Logout(opts.Context)
-
 	if err != nil {
-		return fmt.Errorf("%v: %w", opts.localizer.MustLocalize("logout.error.unableToLogout"), err)
+		if !opts.all {
+			return fmt.Errorf("%v: %w", opts.localizer.MustLocalize("logout.error.unableToLogout"), err)
+		}
+		// the stored credentials are removed even when the session could not be revoked
+		opts.Logger.Info(opts.localizer.MustLocalize("logout.log.info.revokeFailed", localize.NewEntry("Error", err)))
+	}
+
+	if opts.all {
+		if err = clearStoredSessions(opts); err != nil {
+			return err
+		}
 	}
 
 	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("logout.log.info.logoutSuccess"))
 
 	return nil
 }
+
+// clearStoredSessions removes the stored tokens and all service contexts
+func clearStoredSessions(opts *options) error {
+	cfg, err := opts.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	cfg.AccessToken = ""
+	cfg.RefreshToken = ""
+	cfg.Services = config.ServiceConfigMap{}
+
+	if err = opts.Config.Save(cfg); err != nil {
+		return err
+	}
+
+	if err = opts.ServiceContext.Remove(); err != nil {
+		return fmt.Errorf("%v: %w", opts.localizer.MustLocalize("logout.error.unableToRemoveContexts"), err)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package logout
 
 import (
 	"bytes"
+	"errors"
 	"testing"
 
 	"github.com/redhat-developer/app-services-cli/pkg/core/auth/token"
@@ -9,10 +10,12 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/core/config"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize/goi18n"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
 
 	"github.com/redhat-developer/app-services-cli/pkg/shared/connection"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/connection/kcconnection"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory/fakes"
 
 	"github.com/redhat-developer/app-services-cli/internal/mockutil"
 )
@@ -93,3 +96,48 @@ func TestNewLogoutCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestLogoutAll(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		cleared bool
+	}{
+		{
+			name:    "clears the stored sessions when the session has expired",
+			args:    []string{"--all"},
+			cleared: true,
+		},
+		{
+			name:    "fails without --all when the session has expired",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := fakes.NewFactory(t)
+			_ = f.Config.Save(&config.Config{AccessToken: "expired", RefreshToken: "expired"})
+			_ = f.ServiceContext.Save(&servicecontext.Context{CurrentContext: "default"})
+			// the connection cannot be made once the session has expired
+			f.Factory.Connection = func() (connection.Connection, error) {
+				return nil, errors.New("session expired")
+			}
+
+			cmd := NewLogoutCommand(f.Factory)
+			cmd.SetArgs(tt.args)
+			cmd.SetOut(f.Out)
+			cmd.SetErr(f.ErrOut)
+			err := cmd.Execute()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Execute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			cfg, _ := f.Config.Load()
+			_, ctxErr := f.ServiceContext.Load()
+			if cleared := cfg.AccessToken == "" && cfg.RefreshToken == "" && ctxErr != nil; cleared != tt.cleared {
+				t.Errorf("sessions cleared = %v, want %v", cleared, tt.cleared)
+			}
+		})
+	}
+}
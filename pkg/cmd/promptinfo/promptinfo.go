@@ -0,0 +1,130 @@
+package promptinfo
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/auth/token"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	Config         config.IConfig
+	ServiceContext servicecontext.IContext
+	IO             *iostreams.IOStreams
+	localizer      localize.Localizer
+
+	outputFormat string
+}
+
+// promptInfo is the session state printed for shell prompts
+type promptInfo struct {
+	Context string `json:"context"`
+	Kafka   string `json:"kafka"`
+	// TokenExpiresIn is the number of seconds until the session expires, nil when it never expires
+	TokenExpiresIn *int64 `json:"token_expires_in"`
+}
+
+// NewPromptInfoCommand creates a command which prints the session state on a single line
+func NewPromptInfoCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		Config:         f.Config,
+		ServiceContext: f.ServiceContext,
+		IO:             f.IOStreams,
+		localizer:      f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:         "prompt-info",
+		Short:       f.Localizer.MustLocalize("promptInfo.cmd.shortDescription"),
+		Long:        f.Localizer.MustLocalize("promptInfo.cmd.longDescription"),
+		Example:     f.Localizer.MustLocalize("promptInfo.cmd.example"),
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{cmdutil.OfflineAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, dump.JSONFormat) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, dump.JSONFormat)
+			}
+
+			return runPromptInfo(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, f.Localizer)
+	flags.AddOutputFormats(&opts.outputFormat, dump.JSONFormat)
+
+	return cmd
+}
+
+func runPromptInfo(opts *options) error {
+	cfg, err := opts.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	svcContext, err := opts.ServiceContext.Load()
+	if err != nil {
+		return err
+	}
+
+	info := promptInfo{
+		Context:        svcContext.CurrentContext,
+		Kafka:          svcContext.Contexts[svcContext.CurrentContext].KafkaID,
+		TokenExpiresIn: sessionExpiry(cfg, time.Now()),
+	}
+
+	if opts.outputFormat == dump.JSONFormat {
+		// json.Marshal keeps the document on a single line
+		data, err := json.Marshal(info)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(opts.IO.Out, string(data))
+		return nil
+	}
+
+	fmt.Fprintln(opts.IO.Out, formatLine(info))
+	return nil
+}
+
+// sessionExpiry returns the number of seconds until the session must be renewed with "rhoas login".
+// The refresh token is used when present, since the access token is renewed with it automatically.
+func sessionExpiry(cfg *config.Config, now time.Time) *int64 {
+	tokenStr := cfg.RefreshToken
+	if tokenStr == "" {
+		tokenStr = cfg.AccessToken
+	}
+
+	var seconds int64
+	expires, left, err := token.GetExpiry(tokenStr, now)
+	if err != nil {
+		return &seconds
+	}
+	if !expires {
+		return nil
+	}
+	if left > 0 {
+		seconds = int64(left / time.Second)
+	}
+	return &seconds
+}
+
+// formatLine prints the session state as space separated key=value pairs
+func formatLine(info promptInfo) string {
+	expiresIn := "never"
+	if info.TokenExpiresIn != nil {
+		expiresIn = strconv.FormatInt(*info.TokenExpiresIn, 10)
+	}
+
+	return fmt.Sprintf("context=%s kafka=%s token_expires_in=%s", info.Context, info.Kafka, expiresIn)
+}
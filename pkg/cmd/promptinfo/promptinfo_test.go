@@ -0,0 +1,78 @@
+package promptinfo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+)
+
+func Test_sessionExpiry(t *testing.T) {
+	now := time.Now()
+	signed := func(claims jwt.MapClaims) string {
+		s, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	tests := []struct {
+		name string
+		cfg  config.Config
+		want *int64
+	}{
+		{
+			name: "not logged in",
+			cfg:  config.Config{},
+			want: int64Ptr(0),
+		},
+		{
+			name: "uses the refresh token",
+			cfg: config.Config{
+				AccessToken:  signed(jwt.MapClaims{"exp": now.Add(time.Minute).Unix()}),
+				RefreshToken: signed(jwt.MapClaims{"exp": now.Add(time.Hour).Unix()}),
+			},
+			want: int64Ptr(3600),
+		},
+		{
+			name: "expired session",
+			cfg:  config.Config{AccessToken: signed(jwt.MapClaims{"exp": now.Add(-time.Hour).Unix()})},
+			want: int64Ptr(0),
+		},
+		{
+			name: "offline token",
+			cfg:  config.Config{RefreshToken: signed(jwt.MapClaims{})},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sessionExpiry(&tt.cfg, now)
+			if (got == nil) != (tt.want == nil) {
+				t.Fatalf("sessionExpiry() = %v, want %v", got, tt.want)
+			}
+			// allow for the truncation of the current time to seconds in the token
+			if got != nil && (*got > *tt.want || *got < *tt.want-1) {
+				t.Errorf("sessionExpiry() = %v, want %v", *got, *tt.want)
+			}
+		})
+	}
+}
+
+func Test_formatLine(t *testing.T) {
+	info := promptInfo{Context: "dev", Kafka: "c5hv7iru4an1g84pogp0", TokenExpiresIn: int64Ptr(120)}
+	if got, want := formatLine(info), "context=dev kafka=c5hv7iru4an1g84pogp0 token_expires_in=120"; got != want {
+		t.Errorf("formatLine() = %q, want %q", got, want)
+	}
+
+	info = promptInfo{Context: "dev"}
+	if got, want := formatLine(info), "context=dev kafka= token_expires_in=never"; got != want {
+		t.Errorf("formatLine() = %q, want %q", got, want)
+	}
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
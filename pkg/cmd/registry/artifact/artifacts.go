@@ -10,6 +10,8 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/metadata"
 	migrate "github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/migrate"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/owner"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/prune"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/references"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/state"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/versions"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
@@ -35,15 +37,19 @@ func NewArtifactsCommand(f *factory.Factory) *cobra.Command {
 		update.NewUpdateCommand(f),
 
 		// Misc
-		metadata.NewGetMetadataCommand(f),
-		metadata.NewSetMetadataCommand(f),
+		metadata.NewMetadataCommand(f),
+		metadata.NewDeprecatedGetMetadataCommand(f),
+		metadata.NewDeprecatedSetMetadataCommand(f),
 		versions.NewVersionsCommand(f),
+		prune.NewPruneCommand(f),
 		download.NewDownloadCommand(f),
 		migrate.NewExportCommand(f),
 		migrate.NewImportCommand(f),
 		state.NewSetStateCommand(f),
 		owner.NewGetCommand(f),
 		owner.NewSetCommand(f),
+		owner.NewTransferCommand(f),
+		references.NewReferencesCommand(f),
 	)
 
 	return cmd
@@ -6,6 +6,7 @@ import (
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/confirm"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
@@ -13,6 +14,7 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/jobs"
 	"github.com/spf13/cobra"
 )
 
@@ -22,6 +24,7 @@ type options struct {
 
 	registryID string
 	force      bool
+	async      bool
 
 	IO             *iostreams.IOStreams
 	Connection     factory.ConnectionFunc
@@ -52,6 +55,14 @@ func NewDeleteCommand(f *factory.Factory) *cobra.Command {
 				return flagutil.RequiredWhenNonInteractiveError("yes")
 			}
 
+			if opts.async {
+				// background jobs cannot prompt for confirmation
+				if !opts.force {
+					return f.Localizer.MustLocalizeError("flag.error.requiredWithFlag", localize.NewEntry("Flag", "yes"), localize.NewEntry("OtherFlag", "async"))
+				}
+				return jobs.RunAsync(f)
+			}
+
 			if opts.registryID != "" {
 				return runDelete(opts)
 			}
@@ -66,10 +77,11 @@ func NewDeleteCommand(f *factory.Factory) *cobra.Command {
 		},
 	}
 
-	cmd.Flags().BoolVarP(&opts.force, "yes", "y", false, opts.localizer.MustLocalize("artifact.common.delete.without.prompt"))
+	cmd.Flags().BoolVarP(&opts.force, "yes", "y", confirm.DefaultYes(), opts.localizer.MustLocalize("artifact.common.delete.without.prompt"))
 	cmd.Flags().StringVar(&opts.artifact, "artifact-id", "", opts.localizer.MustLocalize("artifact.common.id"))
 	cmd.Flags().StringVarP(&opts.group, "group", "g", registrycmdutil.DefaultArtifactGroup, opts.localizer.MustLocalize("artifact.common.group"))
 	cmd.Flags().StringVar(&opts.registryID, "instance-id", "", opts.localizer.MustLocalize("artifact.common.registryIdToUse"))
+	flagutil.NewFlagSet(cmd, f.Localizer).AddAsync(&opts.async)
 	flagutil.EnableOutputFlagCompletion(cmd)
 
 	return cmd
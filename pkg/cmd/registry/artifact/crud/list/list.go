@@ -2,9 +2,11 @@ package list
 
 import (
 	"context"
+	"strings"
 
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
 
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
@@ -32,6 +34,8 @@ type artifactRow struct {
 	Type registryinstanceclient.ArtifactType `json:"type" header:"Type"`
 
 	State registryinstanceclient.ArtifactState `json:"state" header:"State"`
+
+	Labels string `json:"labels,omitempty" header:"Labels"`
 }
 
 type options struct {
@@ -68,11 +72,12 @@ func NewListCommand(f *factory.Factory) *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:     "list",
-		Short:   f.Localizer.MustLocalize("artifact.cmd.list.description.short"),
-		Long:    f.Localizer.MustLocalize("artifact.cmd.list.description.long"),
-		Example: f.Localizer.MustLocalize("artifact.cmd.list.example"),
-		Args:    cobra.NoArgs,
+		Use:         "list",
+		Short:       f.Localizer.MustLocalize("artifact.cmd.list.description.short"),
+		Long:        f.Localizer.MustLocalize("artifact.cmd.list.description.long"),
+		Example:     f.Localizer.MustLocalize("artifact.cmd.list.example"),
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{cmdutil.PagerAnnotation: "true"},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
 				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
@@ -184,12 +189,13 @@ func mapResponseItemsToRows(artifacts []registryinstanceclient.SearchedArtifact)
 	for i := range artifacts {
 		k := (artifacts)[i]
 		row := artifactRow{
-			Id:        k.GetId(),
-			Name:      k.GetName(),
+			Id:        dump.OrPlaceholder(k.GetId()),
+			Name:      dump.OrPlaceholder(k.GetName()),
 			CreatedOn: k.GetCreatedOn(),
-			CreatedBy: k.GetCreatedBy(),
+			CreatedBy: dump.OrPlaceholder(k.GetCreatedBy()),
 			Type:      k.GetType(),
 			State:     k.GetState(),
+			Labels:    dump.OrPlaceholder(strings.Join(k.GetLabels(), ",")),
 		}
 
 		rows[i] = row
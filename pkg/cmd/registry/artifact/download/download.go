@@ -3,8 +3,11 @@ package download
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/util"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
@@ -14,12 +17,15 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
 
 	"github.com/spf13/cobra"
 )
 
 var unusedFlagIdValue int64 = -1
 
+const versionsPageSize = 100
+
 type options struct {
 	group string
 
@@ -30,6 +36,11 @@ type options struct {
 
 	registryID string
 
+	all         bool
+	allVersions bool
+	outputDir   string
+	layout      string
+
 	IO             *iostreams.IOStreams
 	Logger         logging.Logger
 	Connection     factory.ConnectionFunc
@@ -56,16 +67,30 @@ func NewDownloadCommand(f *factory.Factory) *cobra.Command {
 		Example: f.Localizer.MustLocalize("artifact.cmd.download.example"),
 		Args:    cobra.RangeArgs(0, 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if opts.registryID != "" {
-				return runGet(opts)
+			if opts.all {
+				if opts.contentId != unusedFlagIdValue || opts.globalId != unusedFlagIdValue || opts.hash != "" || opts.outputFile != "" {
+					return opts.localizer.MustLocalizeError("artifact.cmd.download.error.allAndIdentifier")
+				}
+				if err := util.ValidateDownloadLayout(opts.layout); err != nil {
+					return err
+				}
+			} else if opts.allVersions {
+				return opts.localizer.MustLocalizeError("artifact.cmd.download.error.allVersionsWithoutAll")
 			}
 
-			registryInstance, err := contextutil.GetCurrentRegistryInstance(f)
-			if err != nil {
-				return err
+			if opts.registryID == "" {
+				registryInstance, err := contextutil.GetCurrentRegistryInstance(f)
+				if err != nil {
+					return err
+				}
+
+				opts.registryID = registryInstance.GetId()
+			}
+
+			if opts.all {
+				return runDownloadAll(opts)
 			}
 
-			opts.registryID = registryInstance.GetId()
 			return runGet(opts)
 		},
 	}
@@ -78,6 +103,11 @@ func NewDownloadCommand(f *factory.Factory) *cobra.Command {
 	cmd.Flags().StringVarP(&opts.outputFile, "output-file", "", "", opts.localizer.MustLocalize("artifact.common.message.file.location"))
 	cmd.Flags().StringVar(&opts.registryID, "instance-id", "", opts.localizer.MustLocalize("artifact.common.registryIdToUse"))
 
+	cmd.Flags().BoolVar(&opts.all, "all", false, opts.localizer.MustLocalize("artifact.cmd.download.flag.all.description"))
+	cmd.Flags().BoolVar(&opts.allVersions, "all-versions", false, opts.localizer.MustLocalize("artifact.cmd.download.flag.allVersions.description"))
+	cmd.Flags().StringVar(&opts.outputDir, "output-dir", ".", opts.localizer.MustLocalize("artifact.cmd.download.flag.outputDir.description"))
+	cmd.Flags().StringVar(&opts.layout, "layout", util.DefaultDownloadLayout, opts.localizer.MustLocalize("artifact.cmd.download.flag.layout.description"))
+
 	flagutil.EnableOutputFlagCompletion(cmd)
 
 	return cmd
@@ -136,3 +166,116 @@ func runGet(opts *options) error {
 	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("artifact.common.message.fetched.successfully"))
 	return nil
 }
+
+// runDownloadAll downloads the artifacts of the group into the output directory,
+// using the layout to build the path of each artifact version
+func runDownloadAll(opts *options) error {
+	conn, err := opts.Connection()
+	if err != nil {
+		return err
+	}
+
+	dataAPI, _, err := conn.API().ServiceRegistryInstance(opts.registryID)
+	if err != nil {
+		return err
+	}
+
+	if opts.group == registrycmdutil.DefaultArtifactGroup {
+		opts.Logger.Info(opts.localizer.MustLocalize("registry.artifact.common.message.no.group", localize.NewEntry("DefaultArtifactGroup", registrycmdutil.DefaultArtifactGroup)))
+	}
+
+	artifacts, err := registrycmdutil.FetchAllArtifacts(opts.Context, dataAPI, opts.group)
+	if err != nil {
+		return err
+	}
+
+	if len(artifacts) == 0 {
+		opts.Logger.Info(opts.localizer.MustLocalize("artifact.common.message.no.artifact.available.for.group.and.registry", localize.NewEntry("Group", opts.group), localize.NewEntry("Registry", opts.registryID)))
+		return nil
+	}
+
+	var count int
+	for i := range artifacts {
+		artifact := artifacts[i]
+		group := registrycmdutil.ArtifactGroup(&artifact)
+
+		versions, err := getVersionsToDownload(opts, dataAPI, group, artifact.GetId())
+		if err != nil {
+			return err
+		}
+
+		ext := util.GetArtifactFileExtension(string(artifact.GetType()))
+		for _, version := range versions {
+			path := filepath.Join(opts.outputDir, util.GetArtifactFilePath(opts.layout, group, artifact.GetId(), version, ext))
+			opts.Logger.Debug("Downloading artifact", artifact.GetId(), "version", version, "to", path)
+
+			dataFile, _, err := dataAPI.VersionsApi.GetArtifactVersion(opts.Context, group, artifact.GetId(), version).Execute()
+			if err != nil {
+				return registrycmdutil.TransformInstanceError(err)
+			}
+
+			if err = copyToPath(dataFile, path); err != nil {
+				return err
+			}
+			count++
+		}
+	}
+
+	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("artifact.cmd.download.log.info.downloadedAll",
+		localize.NewEntry("Count", count),
+		localize.NewEntry("Directory", opts.outputDir),
+	))
+
+	return nil
+}
+
+// getVersionsToDownload returns the latest version of the artifact, or all of its versions when --all-versions is set
+func getVersionsToDownload(opts *options, dataAPI *registryinstanceclient.APIClient, group string, artifactID string) ([]string, error) {
+	if !opts.allVersions {
+		metadata, _, err := dataAPI.MetadataApi.GetArtifactMetaData(opts.Context, group, artifactID).Execute()
+		if err != nil {
+			return nil, registrycmdutil.TransformInstanceError(err)
+		}
+		return []string{metadata.GetVersion()}, nil
+	}
+
+	var versions []string
+	for offset := int32(0); ; offset += versionsPageSize {
+		response, _, err := dataAPI.VersionsApi.ListArtifactVersions(opts.Context, group, artifactID).
+			Offset(offset).
+			Limit(versionsPageSize).
+			Execute()
+		if err != nil {
+			return nil, registrycmdutil.TransformInstanceError(err)
+		}
+
+		for _, version := range response.GetVersions() {
+			versions = append(versions, version.GetVersion())
+		}
+
+		if len(response.GetVersions()) < versionsPageSize || int32(len(versions)) >= response.GetCount() {
+			return versions, nil
+		}
+	}
+}
+
+func copyToPath(dataFile *os.File, path string) error {
+	defer dataFile.Close()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	out, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	if _, err = dataFile.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	_, err = io.Copy(out, dataFile)
+	return err
+}
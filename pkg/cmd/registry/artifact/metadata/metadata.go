@@ -0,0 +1,42 @@
+package metadata
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+// NewMetadataCommand creates a new command group for the metadata of registry artifacts
+func NewMetadataCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "metadata",
+		Short:   f.Localizer.MustLocalize("artifact.cmd.metadata.description.short"),
+		Long:    f.Localizer.MustLocalize("artifact.cmd.metadata.description.long"),
+		Example: f.Localizer.MustLocalize("artifact.cmd.metadata.example"),
+		Args:    cobra.MinimumNArgs(1),
+	}
+
+	getCmd := NewGetMetadataCommand(f)
+	getCmd.Use = "get"
+
+	setCmd := NewSetMetadataCommand(f)
+	setCmd.Use = "set"
+
+	cmd.AddCommand(getCmd, setCmd)
+
+	return cmd
+}
+
+// NewDeprecatedGetMetadataCommand creates the "metadata-get" command, replaced by "metadata get"
+func NewDeprecatedGetMetadataCommand(f *factory.Factory) *cobra.Command {
+	cmd := NewGetMetadataCommand(f)
+	cmd.Deprecated = f.Localizer.MustLocalize("artifact.cmd.metadata.deprecated", localize.NewEntry("Command", "get"))
+	return cmd
+}
+
+// NewDeprecatedSetMetadataCommand creates the "metadata-set" command, replaced by "metadata set"
+func NewDeprecatedSetMetadataCommand(f *factory.Factory) *cobra.Command {
+	cmd := NewSetMetadataCommand(f)
+	cmd.Deprecated = f.Localizer.MustLocalize("artifact.cmd.metadata.deprecated", localize.NewEntry("Command", "set"))
+	return cmd
+}
@@ -3,6 +3,7 @@ package metadata
 import (
 	"context"
 	"encoding/json"
+	"strings"
 
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
@@ -29,6 +30,7 @@ type SetOptions struct {
 
 	name        string
 	description string
+	labels      []string
 
 	IO             *iostreams.IOStreams
 	Logger         logging.Logger
@@ -56,7 +58,13 @@ func NewSetMetadataCommand(f *factory.Factory) *cobra.Command {
 		Example: f.Localizer.MustLocalize("artifact.cmd.metadata.set.example"),
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if opts.name == "" && opts.description == "" && !opts.IO.CanPrompt() {
+			for _, label := range opts.labels {
+				if _, _, ok := cutLabel(label); !ok {
+					return f.Localizer.MustLocalizeError("artifact.cmd.metadata.set.error.invalidLabel", localize.NewEntry("Label", label))
+				}
+			}
+
+			if opts.name == "" && opts.description == "" && len(opts.labels) == 0 && !opts.IO.CanPrompt() {
 				return f.Localizer.MustLocalizeError("artifact.cmd.common.error.no.editor.mode.in.non.interactive")
 			}
 
@@ -85,6 +93,7 @@ func NewSetMetadataCommand(f *factory.Factory) *cobra.Command {
 
 	cmd.Flags().StringVar(&opts.name, "name", "", opts.localizer.MustLocalize("artifact.common.custom.name"))
 	cmd.Flags().StringVar(&opts.description, "description", "", opts.localizer.MustLocalize("artifact.common.custom.description"))
+	cmd.Flags().StringArrayVar(&opts.labels, "label", []string{}, opts.localizer.MustLocalize("artifact.cmd.metadata.set.flag.label.description"))
 
 	flagutil.EnableOutputFlagCompletion(cmd)
 
@@ -121,7 +130,7 @@ func runSet(opts *SetOptions) error {
 		Properties:  currentMetadata.Properties,
 	}
 
-	if opts.name != "" || opts.description != "" {
+	if opts.name != "" || opts.description != "" || len(opts.labels) > 0 {
 		if opts.name != "" {
 			editableMedata.Name = &opts.name
 		}
@@ -129,6 +138,11 @@ func runSet(opts *SetOptions) error {
 		if opts.description != "" {
 			editableMedata.Description = &opts.description
 		}
+
+		if len(opts.labels) > 0 {
+			labels := mergeLabels(editableMedata.GetLabels(), opts.labels)
+			editableMedata.Labels = &labels
+		}
 	} else {
 		opts.Logger.Info(opts.localizer.MustLocalize("artifact.common.message.running.editor.with.editable.metadata"))
 		editableMedata, err = runEditor(editableMedata)
@@ -177,3 +191,36 @@ func runEditor(currentMetadata *registryinstanceclient.EditableMetaData) (*regis
 	}
 	return &resultData, nil
 }
+
+// mergeLabels sets the key=value labels on the current labels of an artifact,
+// replacing any existing label with the same key
+func mergeLabels(current []string, labels []string) []string {
+	merged := make([]string, 0, len(current)+len(labels))
+	merged = append(merged, current...)
+
+	for _, label := range labels {
+		key, _, _ := cutLabel(label)
+		replaced := false
+		for i, existing := range merged {
+			if existingKey, _, _ := cutLabel(existing); existingKey == key {
+				merged[i] = label
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, label)
+		}
+	}
+
+	return merged
+}
+
+// cutLabel splits a key=value label, ok is false when the label has no key
+func cutLabel(label string) (key string, value string, ok bool) {
+	i := strings.Index(label, "=")
+	if i <= 0 {
+		return label, "", false
+	}
+	return label[:i], label[i+1:], true
+}
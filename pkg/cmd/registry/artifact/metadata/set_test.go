@@ -0,0 +1,41 @@
+package metadata
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeLabels(t *testing.T) {
+	tests := []struct {
+		name    string
+		current []string
+		labels  []string
+		want    []string
+	}{
+		{
+			name:    "adds new labels",
+			current: []string{"team=orders"},
+			labels:  []string{"tier=gold"},
+			want:    []string{"team=orders", "tier=gold"},
+		},
+		{
+			name:    "replaces label with the same key",
+			current: []string{"team=orders", "legacy", "tier=gold"},
+			labels:  []string{"team=payments"},
+			want:    []string{"team=payments", "legacy", "tier=gold"},
+		},
+		{
+			name:    "last value wins",
+			current: nil,
+			labels:  []string{"team=orders", "team=payments"},
+			want:    []string{"team=payments"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mergeLabels(tt.current, tt.labels); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mergeLabels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -6,12 +6,14 @@ import (
 	"os"
 
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
 	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/jobs"
 
 	"github.com/spf13/cobra"
 )
@@ -19,6 +21,7 @@ import (
 type ExportOptions struct {
 	file       string
 	registryID string
+	async      bool
 
 	IO             *iostreams.IOStreams
 	Connection     factory.ConnectionFunc
@@ -45,6 +48,10 @@ func NewExportCommand(f *factory.Factory) *cobra.Command {
 		Example: f.Localizer.MustLocalize("artifact.cmd.export.example"),
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.async {
+				return jobs.RunAsync(f)
+			}
+
 			if opts.registryID != "" {
 				return runExport(opts)
 			}
@@ -61,6 +68,7 @@ func NewExportCommand(f *factory.Factory) *cobra.Command {
 	cmd.Flags().StringVar(&opts.file, "output-file", "", opts.localizer.MustLocalize("artifact.common.file.location"))
 	cmd.Flags().StringVar(&opts.registryID, "instance-id", "", opts.localizer.MustLocalize("registry.common.flag.instance.id"))
 	_ = cmd.MarkFlagRequired("output-file")
+	flagutil.NewFlagSet(cmd, f.Localizer).AddAsync(&opts.async)
 
 	return cmd
 }
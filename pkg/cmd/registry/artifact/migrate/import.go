@@ -5,12 +5,14 @@ import (
 	"os"
 
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
 	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/jobs"
 
 	"github.com/spf13/cobra"
 )
@@ -18,6 +20,7 @@ import (
 type ImportOptions struct {
 	file       string
 	registryID string
+	async      bool
 
 	IO             *iostreams.IOStreams
 	Connection     factory.ConnectionFunc
@@ -44,6 +47,10 @@ func NewImportCommand(f *factory.Factory) *cobra.Command {
 		Example: f.Localizer.MustLocalize("artifact.cmd.import.example"),
 		Args:    cobra.RangeArgs(0, 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.async {
+				return jobs.RunAsync(f)
+			}
+
 			if len(args) > 0 {
 				opts.file = args[0]
 			}
@@ -63,6 +70,7 @@ func NewImportCommand(f *factory.Factory) *cobra.Command {
 	}
 	cmd.Flags().StringVar(&opts.file, "file", "", opts.localizer.MustLocalize("artifact.common.file.location"))
 	cmd.Flags().StringVar(&opts.registryID, "instance-id", "", opts.localizer.MustLocalize("registry.common.flag.instance.id"))
+	flagutil.NewFlagSet(cmd, f.Localizer).AddAsync(&opts.async)
 
 	return cmd
 }
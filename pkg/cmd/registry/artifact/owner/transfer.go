@@ -0,0 +1,164 @@
+package owner
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/confirm"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
+	"github.com/spf13/cobra"
+)
+
+type transferOptions struct {
+	from  string
+	to    string
+	group string
+
+	registryID string
+	force      bool
+	dryRun     bool
+
+	f *factory.Factory
+}
+
+// transferRow is an artifact whose ownership is transferred, printed to a table
+type transferRow struct {
+	Group      string `header:"Group"`
+	ArtifactID string `header:"Artifact ID"`
+	Name       string `header:"Name"`
+}
+
+// NewTransferCommand creates a new command to transfer the ownership of all the artifacts of a user to another user
+func NewTransferCommand(f *factory.Factory) *cobra.Command {
+	opts := &transferOptions{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "owner-transfer",
+		Short:   f.Localizer.MustLocalize("artifact.cmd.owner.transfer.description.short"),
+		Long:    f.Localizer.MustLocalize("artifact.cmd.owner.transfer.description.long"),
+		Example: f.Localizer.MustLocalize("artifact.cmd.owner.transfer.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if opts.from == opts.to {
+				return f.Localizer.MustLocalizeError("artifact.cmd.owner.transfer.error.sameOwner")
+			}
+
+			if !f.IOStreams.CanPrompt() && !opts.force && !opts.dryRun {
+				return flagutil.RequiredWhenNonInteractiveError("yes")
+			}
+
+			if opts.registryID != "" {
+				return runTransfer(opts)
+			}
+
+			registryInstance, err := contextutil.GetCurrentRegistryInstance(f)
+			if err != nil {
+				return err
+			}
+
+			opts.registryID = registryInstance.GetId()
+			return runTransfer(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.from, "from", "", f.Localizer.MustLocalize("artifact.cmd.owner.transfer.flag.from"))
+	cmd.Flags().StringVar(&opts.to, "to", "", f.Localizer.MustLocalize("artifact.cmd.owner.transfer.flag.to"))
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", f.Localizer.MustLocalize("artifact.cmd.owner.transfer.flag.group"))
+	cmd.Flags().StringVar(&opts.registryID, "instance-id", "", f.Localizer.MustLocalize("artifact.common.registryIdToUse"))
+	cmd.Flags().BoolVarP(&opts.force, "yes", "y", confirm.DefaultYes(), f.Localizer.MustLocalize("artifact.cmd.owner.transfer.flag.yes"))
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, f.Localizer.MustLocalize("artifact.cmd.owner.transfer.flag.dryRun"))
+
+	_ = cmd.MarkFlagRequired("from")
+	_ = cmd.MarkFlagRequired("to")
+
+	return cmd
+}
+
+func runTransfer(opts *transferOptions) error {
+	f := opts.f
+
+	conn, err := f.Connection()
+	if err != nil {
+		return err
+	}
+
+	dataAPI, _, err := conn.API().ServiceRegistryInstance(opts.registryID)
+	if err != nil {
+		return err
+	}
+
+	artifacts, err := registrycmdutil.FetchAllArtifacts(f.Context, dataAPI, opts.group)
+	if err != nil {
+		return err
+	}
+
+	rows := artifactsOwnedBy(artifacts, opts.from)
+	if len(rows) == 0 {
+		f.Logger.Info(f.Localizer.MustLocalize("artifact.cmd.owner.transfer.log.info.nothingToTransfer", localize.NewEntry("Owner", opts.from)))
+		return nil
+	}
+
+	f.Logger.Info(f.Localizer.MustLocalize("artifact.cmd.owner.transfer.log.info.artifactsToTransfer", localize.NewEntry("Count", len(rows)), localize.NewEntry("Owner", opts.to)))
+	dump.Table(f.IOStreams.Out, rows)
+	f.Logger.Info()
+
+	if opts.dryRun {
+		return nil
+	}
+
+	if !opts.force {
+		var shouldContinue bool
+		prompt := &survey.Confirm{
+			Message: f.Localizer.MustLocalize("artifact.cmd.owner.transfer.input.confirm.message", localize.NewEntry("Count", len(rows)), localize.NewEntry("Owner", opts.to)),
+		}
+		if err = survey.AskOne(prompt, &shouldContinue); err != nil {
+			return err
+		}
+
+		if !shouldContinue {
+			return nil
+		}
+	}
+
+	for i, row := range rows {
+		request := dataAPI.MetadataApi.UpdateArtifactOwner(f.Context, row.Group, row.ArtifactID).
+			ArtifactOwner(registryinstanceclient.ArtifactOwner{Owner: &opts.to})
+
+		if _, err = request.Execute(); err != nil {
+			return f.Localizer.MustLocalizeError("artifact.cmd.owner.transfer.error.transferFailed",
+				localize.NewEntry("ArtifactID", row.ArtifactID),
+				localize.NewEntry("Group", row.Group),
+				localize.NewEntry("Transferred", i),
+				localize.NewEntry("Error", registrycmdutil.TransformInstanceError(err)),
+			)
+		}
+	}
+
+	f.Logger.Info(icon.SuccessPrefix(), f.Localizer.MustLocalize("artifact.cmd.owner.transfer.log.info.transferred", localize.NewEntry("Count", len(rows)), localize.NewEntry("Owner", opts.to)))
+
+	return nil
+}
+
+// artifactsOwnedBy returns the artifacts owned by the user.
+// The owner of an artifact is the user who created it, until the ownership is changed.
+func artifactsOwnedBy(artifacts []registryinstanceclient.SearchedArtifact, owner string) []transferRow {
+	var rows []transferRow
+	for i := range artifacts {
+		if artifacts[i].GetCreatedBy() != owner {
+			continue
+		}
+		rows = append(rows, transferRow{
+			Group:      registrycmdutil.ArtifactGroup(&artifacts[i]),
+			ArtifactID: artifacts[i].GetId(),
+			Name:       dump.OrPlaceholder(artifacts[i].GetName()),
+		})
+	}
+	return rows
+}
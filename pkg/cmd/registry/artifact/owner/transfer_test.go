@@ -0,0 +1,37 @@
+package owner
+
+import (
+	"reflect"
+	"testing"
+
+	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
+)
+
+func TestArtifactsOwnedBy(t *testing.T) {
+	artifact := func(group string, id string, createdBy string) registryinstanceclient.SearchedArtifact {
+		a := registryinstanceclient.SearchedArtifact{Id: id, CreatedBy: createdBy}
+		if group != "" {
+			a.GroupId = &group
+		}
+		return a
+	}
+
+	artifacts := []registryinstanceclient.SearchedArtifact{
+		artifact("orders", "order-created", "alice"),
+		artifact("orders", "order-shipped", "bob"),
+		artifact("", "customer", "alice"),
+	}
+
+	got := artifactsOwnedBy(artifacts, "alice")
+	want := []transferRow{
+		{Group: "orders", ArtifactID: "order-created", Name: "-"},
+		{Group: "default", ArtifactID: "customer", Name: "-"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("artifactsOwnedBy() = %v, want %v", got, want)
+	}
+
+	if got := artifactsOwnedBy(artifacts, "carol"); len(got) != 0 {
+		t.Errorf("artifactsOwnedBy() = %v, want no artifacts", got)
+	}
+}
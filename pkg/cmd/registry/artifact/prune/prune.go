@@ -0,0 +1,181 @@
+package prune
+
+import (
+	"context"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/confirm"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	artifact   string
+	group      string
+	keepLatest int
+
+	registryID string
+	force      bool
+	dryRun     bool
+
+	IO         *iostreams.IOStreams
+	Connection factory.ConnectionFunc
+	Logger     logging.Logger
+	localizer  localize.Localizer
+	Context    context.Context
+}
+
+// versionRow is a version to prune, printed to a table
+type versionRow struct {
+	ArtifactID string `header:"Artifact ID"`
+	Version    string `header:"Version"`
+	GlobalID   int64  `header:"Global ID"`
+	CreatedOn  string `header:"Created on"`
+}
+
+// NewPruneCommand creates a new command to delete the old versions of artifacts
+func NewPruneCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "prune",
+		Short:   f.Localizer.MustLocalize("artifact.cmd.prune.description.short"),
+		Long:    f.Localizer.MustLocalize("artifact.cmd.prune.description.long"),
+		Example: f.Localizer.MustLocalize("artifact.cmd.prune.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.keepLatest < 1 {
+				return f.Localizer.MustLocalizeError("artifact.cmd.prune.error.keepLatest")
+			}
+
+			if !opts.IO.CanPrompt() && !opts.force && !opts.dryRun {
+				return flagutil.RequiredWhenNonInteractiveError("yes")
+			}
+
+			if opts.registryID != "" {
+				return runPrune(opts)
+			}
+
+			registryInstance, err := contextutil.GetCurrentRegistryInstance(f)
+			if err != nil {
+				return err
+			}
+
+			opts.registryID = registryInstance.GetId()
+			return runPrune(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.artifact, "artifact-id", "", opts.localizer.MustLocalize("artifact.cmd.prune.flag.artifactId"))
+	cmd.Flags().StringVarP(&opts.group, "group", "g", registrycmdutil.DefaultArtifactGroup, opts.localizer.MustLocalize("artifact.common.group"))
+	cmd.Flags().IntVar(&opts.keepLatest, "keep-latest", 0, opts.localizer.MustLocalize("artifact.cmd.prune.flag.keepLatest"))
+	cmd.Flags().StringVar(&opts.registryID, "instance-id", "", opts.localizer.MustLocalize("artifact.common.registryIdToUse"))
+	cmd.Flags().BoolVarP(&opts.force, "yes", "y", confirm.DefaultYes(), opts.localizer.MustLocalize("artifact.cmd.prune.flag.yes"))
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, opts.localizer.MustLocalize("artifact.cmd.prune.flag.dryRun"))
+
+	_ = cmd.MarkFlagRequired("keep-latest")
+
+	return cmd
+}
+
+func runPrune(opts *options) error {
+	conn, err := opts.Connection()
+	if err != nil {
+		return err
+	}
+
+	dataAPI, _, err := conn.API().ServiceRegistryInstance(opts.registryID)
+	if err != nil {
+		return err
+	}
+
+	if opts.group == registrycmdutil.DefaultArtifactGroup {
+		opts.Logger.Info(opts.localizer.MustLocalize("registry.artifact.common.message.no.group", localize.NewEntry("DefaultArtifactGroup", registrycmdutil.DefaultArtifactGroup)))
+	}
+
+	artifactIDs := []string{opts.artifact}
+	if opts.artifact == "" {
+		artifacts, err := registrycmdutil.FetchAllArtifacts(opts.Context, dataAPI, opts.group)
+		if err != nil {
+			return err
+		}
+
+		artifactIDs = make([]string, len(artifacts))
+		for i := range artifacts {
+			artifactIDs[i] = artifacts[i].GetId()
+		}
+	}
+
+	var rows []versionRow
+	for _, artifactID := range artifactIDs {
+		versions, err := registrycmdutil.FetchAllVersions(opts.Context, dataAPI, opts.group, artifactID)
+		if err != nil {
+			return err
+		}
+
+		for _, version := range registrycmdutil.VersionsToPrune(versions, opts.keepLatest) {
+			rows = append(rows, versionRow{
+				ArtifactID: artifactID,
+				Version:    version.GetVersion(),
+				GlobalID:   version.GetGlobalId(),
+				CreatedOn:  version.GetCreatedOn(),
+			})
+		}
+	}
+
+	if len(rows) == 0 {
+		opts.Logger.Info(opts.localizer.MustLocalize("artifact.cmd.prune.log.info.nothingToPrune", localize.NewEntry("Keep", opts.keepLatest)))
+		return nil
+	}
+
+	opts.Logger.Info(opts.localizer.MustLocalize("artifact.cmd.prune.log.info.versionsToPrune", localize.NewEntry("Count", len(rows))))
+	dump.Table(opts.IO.Out, rows)
+	opts.Logger.Info()
+
+	if opts.dryRun {
+		return nil
+	}
+
+	if !opts.force {
+		var shouldContinue bool
+		prompt := &survey.Confirm{
+			Message: opts.localizer.MustLocalize("artifact.cmd.prune.input.confirm.message", localize.NewEntry("Count", len(rows))),
+		}
+		if err = survey.AskOne(prompt, &shouldContinue); err != nil {
+			return err
+		}
+
+		if !shouldContinue {
+			return nil
+		}
+	}
+
+	for i, row := range rows {
+		if err = registrycmdutil.DeleteArtifactVersion(opts.Context, dataAPI, opts.group, row.ArtifactID, row.Version); err != nil {
+			return opts.localizer.MustLocalizeError("artifact.cmd.prune.error.deleteFailed",
+				localize.NewEntry("ArtifactID", row.ArtifactID),
+				localize.NewEntry("Version", row.Version),
+				localize.NewEntry("Deleted", i),
+				localize.NewEntry("Error", registrycmdutil.TransformInstanceError(err)),
+			)
+		}
+	}
+
+	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("artifact.cmd.prune.log.info.pruned", localize.NewEntry("Count", len(rows))))
+
+	return nil
+}
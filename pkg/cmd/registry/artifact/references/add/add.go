@@ -0,0 +1,159 @@
+package add
+
+import (
+	"context"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
+
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	artifact     string
+	group        string
+	refGroup     string
+	refArtifact  string
+	refVersion   string
+	refName      string
+	outputFormat string
+	registryID   string
+
+	IO         *iostreams.IOStreams
+	Connection factory.ConnectionFunc
+	Logger     logging.Logger
+	localizer  localize.Localizer
+	Context    context.Context
+}
+
+// NewAddCommand creates a new command for adding a reference to an artifact
+func NewAddCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "add",
+		Short:   f.Localizer.MustLocalize("artifact.cmd.references.add.description.short"),
+		Long:    f.Localizer.MustLocalize("artifact.cmd.references.add.description.long"),
+		Example: f.Localizer.MustLocalize("artifact.cmd.references.add.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			if opts.artifact == "" {
+				return f.Localizer.MustLocalizeError("artifact.common.message.artifactIdRequired")
+			}
+
+			if opts.refName == "" {
+				opts.refName = opts.refArtifact
+			}
+
+			if opts.registryID != "" {
+				return runAdd(opts)
+			}
+
+			registryInstance, err := contextutil.GetCurrentRegistryInstance(f)
+			if err != nil {
+				return err
+			}
+
+			opts.registryID = registryInstance.GetId()
+
+			return runAdd(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.artifact, "artifact-id", "", opts.localizer.MustLocalize("artifact.common.id"))
+	cmd.Flags().StringVarP(&opts.group, "group", "g", registrycmdutil.DefaultArtifactGroup, opts.localizer.MustLocalize("artifact.common.group"))
+	cmd.Flags().StringVar(&opts.refGroup, "ref-group", registrycmdutil.DefaultArtifactGroup, opts.localizer.MustLocalize("artifact.cmd.references.add.flag.refGroup"))
+	cmd.Flags().StringVar(&opts.refArtifact, "ref-artifact", "", opts.localizer.MustLocalize("artifact.cmd.references.add.flag.refArtifact"))
+	cmd.Flags().StringVar(&opts.refVersion, "ref-version", "", opts.localizer.MustLocalize("artifact.cmd.references.add.flag.refVersion"))
+	cmd.Flags().StringVar(&opts.refName, "ref-name", "", opts.localizer.MustLocalize("artifact.cmd.references.add.flag.refName"))
+	cmd.Flags().StringVar(&opts.registryID, "instance-id", "", opts.localizer.MustLocalize("registry.common.flag.instance.id"))
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "", opts.localizer.MustLocalize("artifact.common.message.output.format"))
+
+	_ = cmd.MarkFlagRequired("ref-artifact")
+
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+func runAdd(opts *options) error {
+	conn, err := opts.Connection()
+	if err != nil {
+		return err
+	}
+
+	dataAPI, _, err := conn.API().ServiceRegistryInstance(opts.registryID)
+	if err != nil {
+		return err
+	}
+
+	references, err := registrycmdutil.FetchReferences(opts.Context, dataAPI, opts.group, opts.artifact, "")
+	if err != nil {
+		return err
+	}
+
+	for _, r := range references {
+		if r.GetName() == opts.refName {
+			return opts.localizer.MustLocalizeError("artifact.cmd.references.add.error.nameExists", localize.NewEntry("Name", opts.refName))
+		}
+	}
+
+	// the registry does not check that the referenced artifact exists
+	if err = checkReferencedArtifact(opts, dataAPI); err != nil {
+		return opts.localizer.MustLocalizeError("artifact.cmd.references.add.error.refNotFound", localize.NewEntry("ArtifactID", opts.refArtifact), localize.NewEntry("Error", err))
+	}
+
+	reference := registryinstanceclient.NewArtifactReference(opts.refGroup, opts.refArtifact, opts.refName)
+	if opts.refVersion != "" {
+		reference.SetVersion(opts.refVersion)
+	}
+	references = append(references, *reference)
+
+	content, err := registrycmdutil.FetchLatestContent(opts.Context, dataAPI, opts.group, opts.artifact)
+	if err != nil {
+		return err
+	}
+
+	version, err := registrycmdutil.CreateVersionWithReferences(opts.Context, dataAPI, opts.group, opts.artifact, content, references)
+	if err != nil {
+		return err
+	}
+
+	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("artifact.cmd.references.add.log.info.added",
+		localize.NewEntry("Name", opts.refName),
+		localize.NewEntry("ArtifactID", opts.artifact),
+		localize.NewEntry("Version", version.GetVersion())))
+
+	if opts.outputFormat != "" {
+		return dump.Formatted(opts.IO.Out, opts.outputFormat, version)
+	}
+
+	return nil
+}
+
+func checkReferencedArtifact(opts *options, dataAPI *registryinstanceclient.APIClient) (err error) {
+	if opts.refVersion == "" {
+		_, _, err = dataAPI.MetadataApi.GetArtifactMetaData(opts.Context, opts.refGroup, opts.refArtifact).Execute()
+	} else {
+		_, _, err = dataAPI.MetadataApi.GetArtifactVersionMetaData(opts.Context, opts.refGroup, opts.refArtifact, opts.refVersion).Execute()
+	}
+	return registrycmdutil.TransformInstanceError(err)
+}
@@ -0,0 +1,137 @@
+package list
+
+import (
+	"context"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
+
+	"github.com/spf13/cobra"
+)
+
+// referenceRow is the details of an artifact reference needed to print to a table
+type referenceRow struct {
+	Name       string `json:"name" header:"Name"`
+	Group      string `json:"group" header:"Group"`
+	ArtifactID string `json:"artifactId" header:"Artifact ID"`
+	Version    string `json:"version" header:"Version"`
+}
+
+type options struct {
+	artifact     string
+	group        string
+	version      string
+	outputFormat string
+	registryID   string
+
+	IO         *iostreams.IOStreams
+	Connection factory.ConnectionFunc
+	Logger     logging.Logger
+	localizer  localize.Localizer
+	Context    context.Context
+}
+
+// NewListCommand creates a new command for listing the references of an artifact
+func NewListCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   f.Localizer.MustLocalize("artifact.cmd.references.list.description.short"),
+		Long:    f.Localizer.MustLocalize("artifact.cmd.references.list.description.long"),
+		Example: f.Localizer.MustLocalize("artifact.cmd.references.list.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			if opts.artifact == "" {
+				return f.Localizer.MustLocalizeError("artifact.common.message.artifactIdRequired")
+			}
+
+			if opts.registryID != "" {
+				return runList(opts)
+			}
+
+			registryInstance, err := contextutil.GetCurrentRegistryInstance(f)
+			if err != nil {
+				return err
+			}
+
+			opts.registryID = registryInstance.GetId()
+
+			return runList(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.artifact, "artifact-id", "", opts.localizer.MustLocalize("artifact.common.id"))
+	cmd.Flags().StringVarP(&opts.group, "group", "g", registrycmdutil.DefaultArtifactGroup, opts.localizer.MustLocalize("artifact.common.group"))
+	cmd.Flags().StringVar(&opts.version, "version", "", opts.localizer.MustLocalize("artifact.cmd.references.list.flag.version"))
+	cmd.Flags().StringVar(&opts.registryID, "instance-id", "", opts.localizer.MustLocalize("registry.common.flag.instance.id"))
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "", opts.localizer.MustLocalize("artifact.common.message.output.format"))
+
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+func runList(opts *options) error {
+	conn, err := opts.Connection()
+	if err != nil {
+		return err
+	}
+
+	dataAPI, _, err := conn.API().ServiceRegistryInstance(opts.registryID)
+	if err != nil {
+		return err
+	}
+
+	references, err := registrycmdutil.FetchReferences(opts.Context, dataAPI, opts.group, opts.artifact, opts.version)
+	if err != nil {
+		return err
+	}
+
+	if len(references) == 0 && opts.outputFormat == "" {
+		opts.Logger.Info(opts.localizer.MustLocalize("artifact.cmd.references.list.log.info.noReferences", localize.NewEntry("ArtifactID", opts.artifact)))
+		return nil
+	}
+
+	switch opts.outputFormat {
+	case dump.EmptyFormat:
+		dump.Table(opts.IO.Out, mapReferencesToRows(references))
+		opts.Logger.Info("")
+	default:
+		return dump.Formatted(opts.IO.Out, opts.outputFormat, references)
+	}
+
+	return nil
+}
+
+func mapReferencesToRows(references []registryinstanceclient.ArtifactReference) []referenceRow {
+	rows := make([]referenceRow, len(references))
+
+	for i, r := range references {
+		rows[i] = referenceRow{
+			Name:       r.GetName(),
+			Group:      r.GetGroupId(),
+			ArtifactID: r.GetArtifactId(),
+			Version:    dump.OrPlaceholder(r.GetVersion()),
+		}
+	}
+
+	return rows
+}
@@ -0,0 +1,26 @@
+package references
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/references/add"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/references/list"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+// NewReferencesCommand creates a new command for managing the references of an artifact to other artifacts
+func NewReferencesCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "references",
+		Short:   f.Localizer.MustLocalize("artifact.cmd.references.description.short"),
+		Long:    f.Localizer.MustLocalize("artifact.cmd.references.description.long"),
+		Example: f.Localizer.MustLocalize("artifact.cmd.references.example"),
+		Args:    cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		list.NewListCommand(f),
+		add.NewAddCommand(f),
+	)
+
+	return cmd
+}
@@ -0,0 +1,63 @@
+package util
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultDownloadLayout is the default path template of downloaded artifacts
+const DefaultDownloadLayout = "{group}/{id}/{version}.{ext}"
+
+var artifactFileExtensions = map[string]string{
+	"AVRO":     "avsc",
+	"PROTOBUF": "proto",
+	"JSON":     "json",
+	"OPENAPI":  "json",
+	"ASYNCAPI": "json",
+	"GRAPHQL":  "graphql",
+	"KCONNECT": "json",
+	"WSDL":     "wsdl",
+	"XSD":      "xsd",
+	"XML":      "xml",
+}
+
+// GetArtifactFileExtension returns the conventional file extension for the artifact type
+func GetArtifactFileExtension(artifactType string) string {
+	if ext, ok := artifactFileExtensions[strings.ToUpper(artifactType)]; ok {
+		return ext
+	}
+	return "txt"
+}
+
+// ValidateDownloadLayout checks that the layout identifies each artifact version by a distinct path
+func ValidateDownloadLayout(layout string) error {
+	if !strings.Contains(layout, "{id}") || !strings.Contains(layout, "{version}") {
+		return fmt.Errorf(`invalid layout "%v": layout must contain the "{id}" and "{version}" placeholders`, layout)
+	}
+	if filepath.IsAbs(layout) {
+		return fmt.Errorf(`invalid layout "%v": layout must be a relative path`, layout)
+	}
+	return nil
+}
+
+// GetArtifactFilePath renders the layout for an artifact version.
+// Values are sanitized so they cannot add directories to the path.
+func GetArtifactFilePath(layout string, group string, id string, version string, ext string) string {
+	replacer := strings.NewReplacer(
+		"{group}", sanitizePathElement(group),
+		"{id}", sanitizePathElement(id),
+		"{version}", sanitizePathElement(version),
+		"{ext}", sanitizePathElement(ext),
+	)
+
+	return filepath.FromSlash(replacer.Replace(layout))
+}
+
+func sanitizePathElement(value string) string {
+	value = strings.NewReplacer("/", "_", `\`, "_").Replace(value)
+	if value == "." || value == ".." {
+		return strings.Repeat("_", len(value))
+	}
+	return value
+}
@@ -0,0 +1,76 @@
+package util
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestGetArtifactFilePath(t *testing.T) {
+	tests := []struct {
+		name    string
+		layout  string
+		group   string
+		id      string
+		version string
+		ext     string
+		want    string
+	}{
+		{
+			name:    "Should render the default layout",
+			layout:  DefaultDownloadLayout,
+			group:   "my-group",
+			id:      "my-schema",
+			version: "2",
+			ext:     "avsc",
+			want:    "my-group/my-schema/2.avsc",
+		},
+		{
+			name:    "Should render a flat layout",
+			layout:  "{group}-{id}@{version}.{ext}",
+			group:   "default",
+			id:      "orders",
+			version: "1.0.0",
+			ext:     "json",
+			want:    "default-orders@1.0.0.json",
+		},
+		{
+			name:    "Should not allow values to add directories",
+			layout:  DefaultDownloadLayout,
+			group:   "..",
+			id:      "a/b",
+			version: "1",
+			ext:     "json",
+			want:    "__/a_b/1.json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GetArtifactFilePath(tt.layout, tt.group, tt.id, tt.version, tt.ext)
+			if got != filepath.FromSlash(tt.want) {
+				t.Errorf("GetArtifactFilePath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateDownloadLayout(t *testing.T) {
+	tests := []struct {
+		name    string
+		layout  string
+		wantErr bool
+	}{
+		{name: "Should accept the default layout", layout: DefaultDownloadLayout, wantErr: false},
+		{name: "Should reject layout without id", layout: "{group}/{version}.{ext}", wantErr: true},
+		{name: "Should reject layout without version", layout: "{group}/{id}.{ext}", wantErr: true},
+		{name: "Should reject absolute layout", layout: "/{group}/{id}/{version}.{ext}", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateDownloadLayout(tt.layout); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateDownloadLayout() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
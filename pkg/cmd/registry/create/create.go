@@ -3,13 +3,20 @@ package create
 import (
 	"context"
 	"fmt"
+	"os"
+	"os/signal"
+	"time"
 
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/httputil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/color"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/spinner"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
 	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
@@ -18,6 +25,7 @@ import (
 
 	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/hooks"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/remote"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/serviceregistryutil"
 
@@ -28,6 +36,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const defaultWaitTimeout = 10 * time.Minute
+
 type options struct {
 	name        string
 	description string
@@ -38,6 +48,9 @@ type options struct {
 	interactive      bool
 	bypassTermsCheck bool
 
+	wait    bool
+	timeout time.Duration
+
 	IO             *iostreams.IOStreams
 	Config         config.IConfig
 	Connection     factory.ConnectionFunc
@@ -45,6 +58,7 @@ type options struct {
 	localizer      localize.Localizer
 	Context        context.Context
 	ServiceContext servicecontext.IContext
+	hooks          *hooks.Runner
 }
 
 // NewCreateCommand creates a new command for creating registry.
@@ -57,6 +71,7 @@ func NewCreateCommand(f *factory.Factory) *cobra.Command {
 		localizer:      f.Localizer,
 		Context:        f.Context,
 		ServiceContext: f.ServiceContext,
+		hooks:          hooks.NewRunner(f),
 	}
 
 	cmd := &cobra.Command{
@@ -83,6 +98,10 @@ func NewCreateCommand(f *factory.Factory) *cobra.Command {
 				return flagutil.InvalidValueError("output", opts.outputFormat, validOutputFormats...)
 			}
 
+			if opts.timeout <= 0 {
+				return opts.localizer.MustLocalizeError("registry.cmd.create.error.invalidTimeout", localize.NewEntry("Timeout", opts.timeout))
+			}
+
 			return runCreate(opts)
 		},
 	}
@@ -94,6 +113,8 @@ func NewCreateCommand(f *factory.Factory) *cobra.Command {
 	flags.StringVar(&opts.description, "description", "", opts.localizer.MustLocalize("registry.cmd.create.flag.description.description"))
 	flags.BoolVar(&opts.autoUse, "use", true, opts.localizer.MustLocalize("registry.cmd.create.flag.use.description"))
 	flags.AddBypassTermsCheck(&opts.bypassTermsCheck)
+	flags.BoolVar(&opts.wait, "wait", false, opts.localizer.MustLocalize("registry.cmd.create.flag.wait.description"))
+	flags.DurationVar(&opts.timeout, "timeout", defaultWaitTimeout, opts.localizer.MustLocalize("registry.cmd.create.flag.timeout.description"))
 
 	flagutil.EnableOutputFlagCompletion(cmd)
 
@@ -161,6 +182,12 @@ func runCreate(opts *options) error {
 		return err
 	}
 
+	if opts.wait {
+		if registry, err = waitForRegistry(opts, conn, registry); err != nil {
+			return err
+		}
+	}
+
 	if err = dump.Formatted(opts.IO.Out, opts.outputFormat, registry); err != nil {
 		return err
 	}
@@ -190,9 +217,83 @@ func runCreate(opts *options) error {
 		opts.Logger.Debug("Auto-use is not set, skipping updating the current instance")
 	}
 
+	opts.hooks.Run(hooks.PostCreate, &hooks.Resource{
+		Type: hooks.ServiceRegistryResource,
+		ID:   registry.GetId(),
+		Name: registry.GetName(),
+		Data: registry,
+	})
+
 	return nil
 }
 
+// waitForRegistry polls the Service Registry instance until it is no longer being provisioned
+// and returns an error if it did not become ready before the timeout
+func waitForRegistry(opts *options, conn connection.Connection, registry *srsmgmtv1.Registry) (*srsmgmtv1.Registry, error) {
+	opts.Logger.Debug("--wait flag is enabled, waiting for Service Registry instance to finish creating")
+
+	nameEntry := localize.NewEntry("Name", registry.GetName())
+
+	s := spinner.New(opts.IO.ErrOut, opts.localizer)
+	s.SetLocalizedSuffix("registry.cmd.create.log.info.creating", nameEntry)
+	s.Start()
+
+	// when there is a SIGINT, display a message informing the user that this does not cancel the creation
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt)
+	defer signal.Stop(c)
+	go func() {
+		for range c {
+			opts.Logger.Info()
+			opts.Logger.Info(opts.localizer.MustLocalize("registry.cmd.create.log.info.creatingSigint"))
+			os.Exit(0)
+		}
+	}()
+
+	deadline := time.Now().Add(opts.timeout)
+	for isRegistryCreating(registry.GetStatus()) {
+		if time.Now().After(deadline) {
+			s.Stop()
+			return nil, opts.localizer.MustLocalizeError("registry.cmd.create.error.timeout",
+				nameEntry,
+				localize.NewEntry("Timeout", opts.timeout),
+				localize.NewEntry("Status", registry.GetStatus()),
+			)
+		}
+
+		time.Sleep(cmdutil.DefaultPollTime)
+
+		var err error
+		registry, _, err = serviceregistryutil.GetServiceRegistryByID(httputil.WithoutCache(opts.Context), conn.API().ServiceRegistryMgmt(), registry.GetId())
+		if err != nil {
+			s.Stop()
+			return nil, err
+		}
+		opts.Logger.Debug("Checking Service Registry status:", registry.GetStatus())
+
+		s.SetLocalizedSuffix("registry.cmd.create.log.info.creationInProgress",
+			nameEntry,
+			localize.NewEntry("Status", color.Status(string(registry.GetStatus()))),
+		)
+	}
+	s.Stop()
+
+	if registry.GetStatus() != srsmgmtv1.REGISTRYSTATUSVALUE_READY {
+		return nil, opts.localizer.MustLocalizeError("registry.cmd.create.error.notReady", nameEntry, localize.NewEntry("Status", registry.GetStatus()))
+	}
+
+	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("registry.cmd.create.info.ready",
+		nameEntry,
+		localize.NewEntry("RegistryURL", registry.GetRegistryUrl()),
+	))
+
+	return registry, nil
+}
+
+func isRegistryCreating(status srsmgmtv1.RegistryStatusValue) bool {
+	return status == srsmgmtv1.REGISTRYSTATUSVALUE_ACCEPTED || status == srsmgmtv1.REGISTRYSTATUSVALUE_PROVISIONING
+}
+
 func handleErrors(err error, opts *options) error {
 	if srsmgmtv1errors.IsAPIError(err, srsmgmtv1errors.ERROR_7) {
 		return opts.localizer.MustLocalizeError("registry.cmd.create.error.limitreached")
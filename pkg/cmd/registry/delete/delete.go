@@ -4,8 +4,9 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/confirm"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
@@ -13,33 +14,40 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/hooks"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/serviceregistryutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/servicespec"
 	"github.com/spf13/cobra"
 
 	srsmgmtv1client "github.com/redhat-developer/app-services-sdk-go/registrymgmt/apiv1/client"
 )
 
 type options struct {
-	id    string
-	name  string
-	force bool
+	id          string
+	name        string
+	skipConfirm bool
+	force       bool
 
 	IO             *iostreams.IOStreams
+	Config         config.IConfig
 	Connection     factory.ConnectionFunc
 	Logger         logging.Logger
 	localizer      localize.Localizer
 	Context        context.Context
 	ServiceContext servicecontext.IContext
+	hooks          *hooks.Runner
 }
 
 func NewDeleteCommand(f *factory.Factory) *cobra.Command {
 	opts := &options{
 		Connection:     f.Connection,
+		Config:         f.Config,
 		Logger:         f.Logger,
 		IO:             f.IOStreams,
 		localizer:      f.Localizer,
 		Context:        f.Context,
 		ServiceContext: f.ServiceContext,
+		hooks:          hooks.NewRunner(f),
 	}
 
 	cmd := &cobra.Command{
@@ -49,8 +57,8 @@ func NewDeleteCommand(f *factory.Factory) *cobra.Command {
 		Example: f.Localizer.MustLocalize("registry.cmd.delete.example"),
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if !opts.IO.CanPrompt() && !opts.force {
-				return flagutil.RequiredWhenNonInteractiveError("yes")
+			if err := confirm.ValidateNonInteractive(opts.IO, opts.localizer, opts.skipConfirm); err != nil {
+				return err
 			}
 
 			if opts.name != "" && opts.id != "" {
@@ -74,7 +82,9 @@ func NewDeleteCommand(f *factory.Factory) *cobra.Command {
 
 	cmd.Flags().StringVar(&opts.name, "name", "", opts.localizer.MustLocalize("registry.cmd.delete.flag.name.description"))
 	cmd.Flags().StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("registry.delete.flag.id"))
-	cmd.Flags().BoolVarP(&opts.force, "yes", "y", false, opts.localizer.MustLocalize("registry.delete.flag.yes"))
+	flags := flagutil.NewFlagSet(cmd, opts.localizer)
+	flags.AddYes(&opts.skipConfirm)
+	flags.BoolVar(&opts.force, "force", false, opts.localizer.MustLocalize("registry.delete.flag.force"))
 
 	return cmd
 }
@@ -112,23 +122,26 @@ func runDelete(opts *options) error {
 	}
 
 	registryName := registry.GetName()
-	opts.Logger.Info(opts.localizer.MustLocalize("registry.delete.log.info.deletingService", localize.NewEntry("Name", registryName)))
-	opts.Logger.Info("")
 
-	if !opts.force {
-		promptConfirmName := &survey.Input{
-			Message: opts.localizer.MustLocalize("registry.delete.input.confirmName.message"),
-		}
+	cfg, err := opts.Config.Load()
+	if err != nil {
+		return err
+	}
 
-		var confirmedName string
-		err = survey.AskOne(promptConfirmName, &confirmedName)
-		if err != nil {
-			return err
+	if cfg.IsProtected(servicespec.ServiceRegistryServiceName, registry.GetId()) {
+		if !opts.force {
+			return opts.localizer.MustLocalizeError("registry.delete.error.protected", localize.NewEntry("Name", registryName))
 		}
+		opts.Logger.Info(opts.localizer.MustLocalize("registry.delete.log.info.deletingProtected", localize.NewEntry("Name", registryName)))
+	}
 
-		if confirmedName != registryName {
-			opts.Logger.Info(opts.localizer.MustLocalize("registry.delete.log.info.incorrectNameConfirmation"))
-			return nil
+	opts.Logger.Info(opts.localizer.MustLocalize("registry.delete.log.info.deletingService", localize.NewEntry("Name", registryName)))
+	opts.Logger.Info("")
+
+	if !opts.skipConfirm {
+		message := opts.localizer.MustLocalize("registry.delete.input.confirmName.message", localize.NewEntry("Name", registryName))
+		if err = confirm.Name(opts.localizer, message, registryName); err != nil {
+			return err
 		}
 	}
 
@@ -143,6 +156,13 @@ func runDelete(opts *options) error {
 
 	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("registry.delete.log.info.deleteSuccess", localize.NewEntry("Name", registryName)))
 
+	opts.hooks.Run(hooks.PostDelete, &hooks.Resource{
+		Type: hooks.ServiceRegistryResource,
+		ID:   registry.GetId(),
+		Name: registryName,
+		Data: registry,
+	})
+
 	currentContextRegistry := currCtx.ServiceRegistryID
 	// this is not the current cluster, our work here is done
 	if currentContextRegistry != opts.id {
@@ -78,6 +78,8 @@ func NewDescribeCommand(f *factory.Factory) *cobra.Command {
 	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "json", opts.localizer.MustLocalize("registry.cmd.flag.output.description"))
 	cmd.Flags().StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("registry.describe.flag.id"))
 
+	flagutil.NewFlagSet(cmd, opts.localizer).AddPrintSchema(srsmgmtv1.Registry{})
+
 	flagutil.EnableOutputFlagCompletion(cmd)
 
 	return cmd
@@ -14,6 +14,8 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/searchutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/serviceregistryutil"
 	srsmgmtv1 "github.com/redhat-developer/app-services-sdk-go/registrymgmt/apiv1/client"
 
 	"github.com/spf13/cobra"
@@ -55,11 +57,12 @@ func NewListCommand(f *factory.Factory) *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:     "list",
-		Short:   f.Localizer.MustLocalize("registry.cmd.list.shortDescription"),
-		Long:    f.Localizer.MustLocalize("registry.cmd.list.longDescription"),
-		Example: f.Localizer.MustLocalize("registry.cmd.list.example"),
-		Args:    cobra.NoArgs,
+		Use:         "list",
+		Short:       f.Localizer.MustLocalize("registry.cmd.list.shortDescription"),
+		Long:        f.Localizer.MustLocalize("registry.cmd.list.longDescription"),
+		Example:     f.Localizer.MustLocalize("registry.cmd.list.example"),
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{cmdutil.PagerAnnotation: "true"},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
 				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
@@ -72,6 +75,12 @@ func NewListCommand(f *factory.Factory) *cobra.Command {
 				return opts.localizer.MustLocalizeError("common.validation.limit.error.invalid.minValue", localize.NewEntry("Limit", opts.limit))
 			}
 
+			if opts.search != "" {
+				if err := searchutil.ValidateSearchInput(opts.search); err != nil {
+					return err
+				}
+			}
+
 			return runList(opts)
 		},
 	}
@@ -79,6 +88,9 @@ func NewListCommand(f *factory.Factory) *cobra.Command {
 	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "", opts.localizer.MustLocalize("registry.cmd.flag.output.description"))
 	cmd.Flags().Int32VarP(&opts.page, "page", "", cmdutil.ConvertPageValueToInt32(build.DefaultPageNumber), opts.localizer.MustLocalize("registry.list.flag.page"))
 	cmd.Flags().Int32VarP(&opts.limit, "limit", "", 100, opts.localizer.MustLocalize("registry.list.flag.limit"))
+	cmd.Flags().StringVarP(&opts.search, "search", "", "", opts.localizer.MustLocalize("registry.list.flag.search"))
+
+	flagutil.NewFlagSet(cmd, opts.localizer).AddPrintSchema(srsmgmtv1.RegistryList{})
 
 	flagutil.EnableOutputFlagCompletion(cmd)
 
@@ -145,15 +157,15 @@ func mapResponseItemsToRows(registries *[]srsmgmtv1.Registry, selectedId string)
 
 	for i := range *registries {
 		k := (*registries)[i]
-		name := k.GetName()
+		name := dump.OrPlaceholder(k.GetName())
 		if k.Id == selectedId {
 			name = fmt.Sprintf("%s %s", name, icon.Emoji("✔", "(current)"))
 		}
 		row := RegistryRow{
-			ID:     fmt.Sprint(k.Id),
+			ID:     dump.OrPlaceholder(k.Id),
 			Name:   name,
-			Status: string(k.GetStatus()),
-			Owner:  k.GetOwner(),
+			Status: dump.OrPlaceholder(string(k.GetStatus())),
+			Owner:  dump.OrPlaceholder(k.GetOwner()),
 		}
 
 		rows[i] = row
@@ -163,10 +175,5 @@ func mapResponseItemsToRows(registries *[]srsmgmtv1.Registry, selectedId string)
 }
 
 func buildQuery(search string) string {
-	queryString := fmt.Sprintf(
-		"name=%v",
-		search,
-	)
-
-	return queryString
+	return serviceregistryutil.NameQuery(search)
 }
@@ -0,0 +1,57 @@
+package list
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory/fakes"
+)
+
+func TestListCommand_search(t *testing.T) {
+	tests := []struct {
+		name      string
+		search    string
+		wantQuery string
+		wantErr   string
+	}{
+		{name: "name", search: "my-registry", wantQuery: "name = 'my-registry'"},
+		{name: "quote is rejected", search: "x' or name like '%", wantErr: `illegal search value "x' or name like '%"`},
+		{name: "equals sign is rejected", search: "x=y", wantErr: `illegal search value "x=y"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := fakes.NewFactory(t)
+
+			var gotQuery string
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/serviceregistry_mgmt/v1/registries", func(w http.ResponseWriter, r *http.Request) {
+				gotQuery = r.URL.Query().Get("search")
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"kind": "RegistryList", "page": 1, "size": 1, "total": 1,
+					"items": []map[string]interface{}{{"id": "r1", "name": "my-registry", "status": "ready"}},
+				})
+			})
+			f.WithServer(t, mux)
+
+			cmd := NewListCommand(f.Factory)
+			cmd.SetArgs([]string{"--search", tt.search, "-o", "json"})
+			err := cmd.Execute()
+
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Execute() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if gotQuery != tt.wantQuery {
+				t.Errorf("search = %q, want %q", gotQuery, tt.wantQuery)
+			}
+		})
+	}
+}
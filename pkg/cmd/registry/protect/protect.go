@@ -0,0 +1,117 @@
+package protect
+
+import (
+	"net/http"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/serviceregistryutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/servicespec"
+	srsmgmtv1client "github.com/redhat-developer/app-services-sdk-go/registrymgmt/apiv1/client"
+
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	id     string
+	name   string
+	remove bool
+
+	f *factory.Factory
+}
+
+// NewProtectCommand creates a new command which protects a Service Registry instance from accidental deletion
+func NewProtectCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "protect",
+		Short:   f.Localizer.MustLocalize("registry.protect.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("registry.protect.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("registry.protect.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.name != "" && opts.id != "" {
+				return f.Localizer.MustLocalizeError("service.error.idAndNameCannotBeUsed")
+			}
+
+			if opts.id == "" && opts.name == "" {
+				registryInstance, err := contextutil.GetCurrentRegistryInstance(f)
+				if err != nil {
+					return err
+				}
+
+				opts.id = registryInstance.GetId()
+			}
+
+			return runProtect(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, f.Localizer)
+
+	flags.StringVar(&opts.id, "id", "", f.Localizer.MustLocalize("registry.protect.flag.id"))
+	flags.StringVar(&opts.name, "name", "", f.Localizer.MustLocalize("registry.protect.flag.name"))
+	flags.BoolVar(&opts.remove, "remove", false, f.Localizer.MustLocalize("registry.protect.flag.remove"))
+
+	return cmd
+}
+
+func runProtect(opts *options) error {
+	f := opts.f
+
+	conn, err := f.Connection()
+	if err != nil {
+		return err
+	}
+
+	api := conn.API().ServiceRegistryMgmt()
+
+	var registry *srsmgmtv1client.Registry
+	var httpRes *http.Response
+	if opts.name != "" {
+		registry, httpRes, err = serviceregistryutil.GetServiceRegistryByName(f.Context, api, opts.name)
+	} else {
+		registry, httpRes, err = serviceregistryutil.GetServiceRegistryByID(f.Context, api, opts.id)
+	}
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		return err
+	}
+
+	cfg, err := f.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	nameEntry := localize.NewEntry("Name", registry.GetName())
+
+	if opts.remove {
+		if !cfg.Unprotect(servicespec.ServiceRegistryServiceName, registry.GetId()) {
+			f.Logger.Info(f.Localizer.MustLocalize("registry.protect.log.info.notProtected", nameEntry))
+			return nil
+		}
+		if err = f.Config.Save(cfg); err != nil {
+			return err
+		}
+		f.Logger.Info(f.Localizer.MustLocalize("registry.protect.log.info.unprotected", nameEntry))
+		return nil
+	}
+
+	if !cfg.Protect(servicespec.ServiceRegistryServiceName, registry.GetId()) {
+		f.Logger.Info(f.Localizer.MustLocalize("registry.protect.log.info.alreadyProtected", nameEntry))
+		return nil
+	}
+	if err = f.Config.Save(cfg); err != nil {
+		return err
+	}
+	f.Logger.Info(f.Localizer.MustLocalize("registry.protect.log.info.protected", nameEntry))
+
+	return nil
+}
@@ -9,9 +9,13 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/delete"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/describe"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/list"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/protect"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/rule"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/setting"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/stats"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/url"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/use"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/waitfor"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
 	"github.com/spf13/cobra"
 )
@@ -32,12 +36,16 @@ func NewServiceRegistryCommand(f *factory.Factory) *cobra.Command {
 		create.NewCreateCommand(f),
 		describe.NewDescribeCommand(f),
 		delete.NewDeleteCommand(f),
+		protect.NewProtectCommand(f),
 		list.NewListCommand(f),
 		use.NewUseCommand(f),
 		artifact.NewArtifactsCommand(f),
 		role.NewRoleCommand(f),
 		rule.NewRuleCommand(f),
 		setting.NewSettingCommand(f),
+		stats.NewStatsCommand(f),
+		waitfor.NewWaitForCommand(f),
+		url.NewURLCommand(f),
 	)
 
 	return cmd
@@ -0,0 +1,46 @@
+package registrycmdutil
+
+import (
+	"context"
+
+	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
+)
+
+const artifactsPageSize = 100
+
+// FetchAllArtifacts fetches all artifacts of the Service Registry instance in the given group,
+// or in all groups when group is empty
+func FetchAllArtifacts(ctx context.Context, api *registryinstanceclient.APIClient, group string) ([]registryinstanceclient.SearchedArtifact, error) {
+	var artifacts []registryinstanceclient.SearchedArtifact
+
+	for offset := int32(0); ; offset += artifactsPageSize {
+		request := api.SearchApi.SearchArtifacts(ctx).
+			Offset(offset).
+			Limit(artifactsPageSize).
+			Orderby(registryinstanceclient.SORTBY_CREATED_ON).
+			Order(registryinstanceclient.SORTORDER_ASC)
+
+		if group != "" {
+			request = request.Group(group)
+		}
+
+		response, _, err := request.Execute()
+		if err != nil {
+			return nil, TransformInstanceError(err)
+		}
+
+		artifacts = append(artifacts, response.GetArtifacts()...)
+
+		if len(response.GetArtifacts()) < artifactsPageSize || int32(len(artifacts)) >= response.GetCount() {
+			return artifacts, nil
+		}
+	}
+}
+
+// ArtifactGroup returns the group of the artifact, or the default group if it has none
+func ArtifactGroup(artifact *registryinstanceclient.SearchedArtifact) string {
+	if group := artifact.GetGroupId(); group != "" {
+		return group
+	}
+	return DefaultArtifactGroup
+}
@@ -0,0 +1,130 @@
+package registrycmdutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+
+	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
+)
+
+// extendedContentType is the content type of version create requests which carry references along with the content.
+// The generated client always sends "application/json", which the registry stores as the artifact content.
+const extendedContentType = "application/create.extended+json"
+
+// FetchReferences returns the references of an artifact version, or of the latest version when version is empty
+func FetchReferences(ctx context.Context, api *registryinstanceclient.APIClient, group string, artifactID string, version string) ([]registryinstanceclient.ArtifactReference, error) {
+	var globalID int64
+	if version == "" {
+		metadata, _, err := api.MetadataApi.GetArtifactMetaData(ctx, group, artifactID).Execute()
+		if err != nil {
+			return nil, TransformInstanceError(err)
+		}
+		globalID = metadata.GetGlobalId()
+	} else {
+		metadata, _, err := api.MetadataApi.GetArtifactVersionMetaData(ctx, group, artifactID, version).Execute()
+		if err != nil {
+			return nil, TransformInstanceError(err)
+		}
+		globalID = metadata.GetGlobalId()
+	}
+
+	references, _, err := api.ArtifactsApi.ReferencesByGlobalId(ctx, globalID).Execute()
+	if err != nil {
+		return nil, TransformInstanceError(err)
+	}
+
+	return references, nil
+}
+
+// FetchLatestContent returns the content of the latest version of an artifact
+func FetchLatestContent(ctx context.Context, api *registryinstanceclient.APIClient, group string, artifactID string) (string, error) {
+	dataFile, _, err := api.ArtifactsApi.GetLatestArtifact(ctx, group, artifactID).Execute()
+	if err != nil {
+		return "", TransformInstanceError(err)
+	}
+	defer dataFile.Close()
+
+	content, err := os.ReadFile(dataFile.Name())
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
+
+// CreateVersionWithReferences creates a new version of an artifact with the given content and references
+func CreateVersionWithReferences(ctx context.Context, api *registryinstanceclient.APIClient, group string, artifactID string, content string, references []registryinstanceclient.ArtifactReference) (*registryinstanceclient.VersionMetaData, error) {
+	cfg := api.GetConfig()
+
+	baseURL, err := cfg.ServerURLWithContext(ctx, "VersionsApiService.CreateArtifactVersion")
+	if err != nil {
+		return nil, err
+	}
+	endpoint := fmt.Sprintf("%v/groups/%v/artifacts/%v/versions", baseURL, url.PathEscape(group), url.PathEscape(artifactID))
+
+	body, err := json.Marshal(registryinstanceclient.NewContentCreateRequest(content, references))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", extendedContentType)
+
+	data, err := doRequest(cfg, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var version registryinstanceclient.VersionMetaData
+	if err = json.Unmarshal(data, &version); err != nil {
+		return nil, err
+	}
+
+	return &version, nil
+}
+
+// doRequest sends a request which the generated client does not support with the configuration of the client,
+// and returns the body of the response
+func doRequest(cfg *registryinstanceclient.Configuration, req *http.Request) ([]byte, error) {
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", cfg.UserAgent)
+	for header, value := range cfg.DefaultHeader {
+		req.Header.Add(header, value)
+	}
+
+	client := cfg.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode >= http.StatusMultipleChoices {
+		var apiError registryinstanceclient.Error
+		if json.Unmarshal(data, &apiError) == nil && apiError.GetMessage() != "" {
+			return nil, errors.New(apiError.GetName() + ": " + apiError.GetMessage())
+		}
+		return nil, fmt.Errorf("%v: %v", res.Status, string(data))
+	}
+
+	return data, nil
+}
@@ -0,0 +1,45 @@
+package registrycmdutil
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
+)
+
+func TestCreateVersionWithReferences(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/groups/my%20group/artifacts/order/versions" {
+			t.Errorf("unexpected path %v", r.URL.EscapedPath())
+		}
+		if got := r.Header.Get("Content-Type"); got != extendedContentType {
+			t.Errorf("Content-Type = %v, want %v", got, extendedContentType)
+		}
+
+		var body registryinstanceclient.ContentCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if body.Content != "syntax = \"proto3\";" || len(body.References) != 1 || body.References[0].GetArtifactId() != "address" {
+			t.Errorf("unexpected body %+v", body)
+		}
+
+		_, _ = w.Write([]byte(`{"version":"3","globalId":42}`))
+	}))
+	defer server.Close()
+
+	cfg := registryinstanceclient.NewConfiguration()
+	cfg.Servers = registryinstanceclient.ServerConfigurations{{URL: server.URL}}
+
+	references := []registryinstanceclient.ArtifactReference{*registryinstanceclient.NewArtifactReference("common", "address", "address.proto")}
+	version, err := CreateVersionWithReferences(context.Background(), registryinstanceclient.NewAPIClient(cfg), "my group", "order", "syntax = \"proto3\";", references)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version.GetVersion() != "3" || version.GetGlobalId() != 42 {
+		t.Errorf("unexpected version %+v", version)
+	}
+}
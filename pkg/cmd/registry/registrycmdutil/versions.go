@@ -0,0 +1,69 @@
+package registrycmdutil
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+
+	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
+)
+
+const versionsPageSize = 100
+
+// FetchAllVersions fetches all versions of an artifact
+func FetchAllVersions(ctx context.Context, api *registryinstanceclient.APIClient, group string, artifactID string) ([]registryinstanceclient.SearchedVersion, error) {
+	var versions []registryinstanceclient.SearchedVersion
+
+	for offset := int32(0); ; offset += versionsPageSize {
+		response, _, err := api.VersionsApi.ListArtifactVersions(ctx, group, artifactID).
+			Offset(offset).
+			Limit(versionsPageSize).
+			Execute()
+		if err != nil {
+			return nil, TransformInstanceError(err)
+		}
+
+		versions = append(versions, response.GetVersions()...)
+
+		if len(response.GetVersions()) < versionsPageSize || int32(len(versions)) >= response.GetCount() {
+			return versions, nil
+		}
+	}
+}
+
+// VersionsToPrune returns the versions beyond the keep most recent ones, oldest first.
+// Versions are ordered by their global ID, which increases as versions are created.
+func VersionsToPrune(versions []registryinstanceclient.SearchedVersion, keep int) []registryinstanceclient.SearchedVersion {
+	if len(versions) <= keep {
+		return nil
+	}
+
+	sorted := make([]registryinstanceclient.SearchedVersion, len(versions))
+	copy(sorted, versions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].GetGlobalId() < sorted[j].GetGlobalId()
+	})
+
+	return sorted[:len(sorted)-keep]
+}
+
+// DeleteArtifactVersion deletes a single version of an artifact
+func DeleteArtifactVersion(ctx context.Context, api *registryinstanceclient.APIClient, group string, artifactID string, version string) error {
+	cfg := api.GetConfig()
+
+	baseURL, err := cfg.ServerURLWithContext(ctx, "VersionsApiService.GetArtifactVersion")
+	if err != nil {
+		return err
+	}
+	endpoint := fmt.Sprintf("%v/groups/%v/artifacts/%v/versions/%v", baseURL, url.PathEscape(group), url.PathEscape(artifactID), url.PathEscape(version))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, endpoint, http.NoBody)
+	if err != nil {
+		return err
+	}
+
+	_, err = doRequest(cfg, req)
+	return err
+}
@@ -0,0 +1,62 @@
+package registrycmdutil
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
+)
+
+func TestVersionsToPrune(t *testing.T) {
+	newVersion := func(version string, globalID int64) registryinstanceclient.SearchedVersion {
+		return registryinstanceclient.SearchedVersion{Version: version, GlobalId: globalID}
+	}
+	versions := []registryinstanceclient.SearchedVersion{
+		newVersion("3", 30), newVersion("1", 10), newVersion("4", 40), newVersion("2", 20),
+	}
+
+	tests := []struct {
+		name string
+		keep int
+		want []string
+	}{
+		{name: "keep the latest version", keep: 1, want: []string{"1", "2", "3"}},
+		{name: "keep the latest versions", keep: 3, want: []string{"1"}},
+		{name: "keep all versions", keep: 4, want: nil},
+		{name: "keep more versions than exist", keep: 10, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, v := range VersionsToPrune(versions, tt.keep) {
+				got = append(got, v.GetVersion())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("VersionsToPrune() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeleteArtifactVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("unexpected method %v", r.Method)
+		}
+		if r.URL.EscapedPath() != "/groups/my%20group/artifacts/order/versions/2" {
+			t.Errorf("unexpected path %v", r.URL.EscapedPath())
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	cfg := registryinstanceclient.NewConfiguration()
+	cfg.Servers = registryinstanceclient.ServerConfigurations{{URL: server.URL}}
+
+	if err := DeleteArtifactVersion(context.Background(), registryinstanceclient.NewAPIClient(cfg), "my group", "order", "2"); err != nil {
+		t.Fatal(err)
+	}
+}
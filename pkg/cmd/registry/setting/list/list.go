@@ -3,6 +3,7 @@ package list
 import (
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/rule/rulecmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
 	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
@@ -23,7 +24,8 @@ type settingRow struct {
 }
 
 type options struct {
-	registryID string
+	registryID   string
+	outputFormat string
 
 	f *factory.Factory
 }
@@ -42,6 +44,9 @@ func NewListCommand(f *factory.Factory) *cobra.Command {
 		Example: f.Localizer.MustLocalize("setting.list.cmd.example"),
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
 
 			if opts.registryID != "" {
 				return runList(opts)
@@ -61,6 +66,7 @@ func NewListCommand(f *factory.Factory) *cobra.Command {
 	flags := rulecmdutil.NewFlagSet(cmd, f)
 
 	flags.AddRegistryInstance(&opts.registryID)
+	flags.AddOutput(&opts.outputFormat)
 
 	return cmd
 
@@ -85,6 +91,10 @@ func runList(opts *options) error {
 		return registrycmdutil.TransformInstanceError(err)
 	}
 
+	if opts.outputFormat != dump.EmptyFormat {
+		return dump.Formatted(opts.f.IOStreams.Out, opts.outputFormat, response)
+	}
+
 	rows := mapResponseItemsToRows(response)
 
 	opts.f.Logger.Info("")
@@ -5,6 +5,7 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/rule/rulecmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
 	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
@@ -19,6 +20,8 @@ type options struct {
 	settingName    string
 	value          string
 	resetToDefault bool
+	dryRun         bool
+	outputFormat   string
 
 	f *factory.Factory
 }
@@ -37,6 +40,9 @@ func NewSetCommand(f *factory.Factory) *cobra.Command {
 		Example: f.Localizer.MustLocalize("setting.set.cmd.example"),
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
 
 			var missingFlags []string
 
@@ -80,6 +86,8 @@ func NewSetCommand(f *factory.Factory) *cobra.Command {
 	flags.StringVarP(&opts.settingName, "name", "n", "", f.Localizer.MustLocalize("setting.set.cmd.flag.settingName.description"))
 	flags.StringVar(&opts.value, "value", "", f.Localizer.MustLocalize("setting.set.cmd.flag.value.description"))
 	flags.BoolVar(&opts.resetToDefault, "default", false, f.Localizer.MustLocalize("setting.set.cmd.flag.default.description"))
+	flags.BoolVar(&opts.dryRun, "dry-run", false, f.Localizer.MustLocalize("setting.set.cmd.flag.dryRun.description"))
+	flags.AddOutput(&opts.outputFormat)
 
 	return cmd
 }
@@ -97,6 +105,10 @@ func runSet(opts *options) error {
 		return err
 	}
 
+	if opts.dryRun {
+		return printDryRun(opts, a)
+	}
+
 	if !opts.resetToDefault {
 		request := a.AdminApi.UpdateConfigProperty(opts.f.Context, opts.settingName)
 
@@ -125,6 +137,36 @@ func runSet(opts *options) error {
 	return nil
 }
 
+// printDryRun prints the change of the setting without applying it.
+// The value after a reset is null, as the default value is not known to the CLI.
+func printDryRun(opts *options, a *registryinstanceclient.APIClient) error {
+	current, _, err := a.AdminApi.GetConfigProperty(opts.f.Context, opts.settingName).Execute()
+	if err != nil {
+		return registrycmdutil.TransformInstanceError(err)
+	}
+
+	var after interface{} = opts.value
+	if opts.resetToDefault {
+		after = nil
+	}
+
+	diff, err := dump.NewDiff("ConfigurationProperty", opts.settingName,
+		map[string]interface{}{"value": current.GetValue()},
+		map[string]interface{}{"value": after},
+	)
+	if err != nil {
+		return err
+	}
+
+	if err = dump.PrintDiff(opts.f.IOStreams.Out, opts.outputFormat, diff); err != nil {
+		return err
+	}
+
+	opts.f.Logger.Info(icon.InfoPrefix(), opts.f.Localizer.MustLocalize("setting.set.log.info.dryRun"))
+
+	return nil
+}
+
 func runInteractivePrompt(opts *options, missingFlags []string) (err error) {
 
 	if slices.Contains(missingFlags, "name") {
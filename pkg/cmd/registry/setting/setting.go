@@ -11,6 +11,7 @@ import (
 func NewSettingCommand(f *factory.Factory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "setting",
+		Aliases: []string{"settings"},
 		Short:   f.Localizer.MustLocalize("setting.cmd.description.short"),
 		Long:    f.Localizer.MustLocalize("setting.cmd.description.long"),
 		Example: f.Localizer.MustLocalize("setting.cmd.example"),
@@ -0,0 +1,235 @@
+package stats
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
+
+	"github.com/spf13/cobra"
+)
+
+// groupStats is the usage of a single artifact group
+type groupStats struct {
+	Group     string `json:"group" yaml:"group" header:"Group"`
+	Artifacts int    `json:"artifacts" yaml:"artifacts" header:"Artifacts"`
+	Versions  int    `json:"versions" yaml:"versions" header:"Versions"`
+}
+
+// registryStats is the usage of a Service Registry instance compared to its limits
+type registryStats struct {
+	RegistryID   string       `json:"registry_id" yaml:"registry_id"`
+	Artifacts    int          `json:"artifacts" yaml:"artifacts"`
+	Versions     int          `json:"versions" yaml:"versions"`
+	MaxArtifacts int64        `json:"max_artifacts,omitempty" yaml:"max_artifacts,omitempty"`
+	MaxVersions  int64        `json:"max_versions,omitempty" yaml:"max_versions,omitempty"`
+	Groups       []groupStats `json:"groups" yaml:"groups"`
+}
+
+// defaultConcurrency is the default number of parallel requests counting the versions of the artifacts
+const defaultConcurrency = 4
+
+type options struct {
+	registryID   string
+	outputFormat string
+	concurrency  int
+
+	f *factory.Factory
+}
+
+// NewStatsCommand creates a new command for showing the usage statistics of a Service Registry instance
+func NewStatsCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "stats",
+		Short:   f.Localizer.MustLocalize("registry.stats.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("registry.stats.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("registry.stats.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			if opts.registryID == "" {
+				registryInstance, err := contextutil.GetCurrentRegistryInstance(f)
+				if err != nil {
+					return err
+				}
+
+				opts.registryID = registryInstance.GetId()
+			}
+
+			return runStats(opts)
+		},
+	}
+
+	flags := registrycmdutil.NewFlagSet(cmd, f)
+
+	flags.AddRegistryInstance(&opts.registryID)
+	flags.AddOutput(&opts.outputFormat)
+	flags.IntVar(&opts.concurrency, "concurrency", defaultConcurrency, f.Localizer.MustLocalize("registry.stats.flag.concurrency"))
+
+	return cmd
+}
+
+func runStats(opts *options) error {
+	f := opts.f
+
+	conn, err := f.Connection()
+	if err != nil {
+		return err
+	}
+
+	api, _, err := conn.API().ServiceRegistryInstance(opts.registryID)
+	if err != nil {
+		return err
+	}
+
+	artifacts, err := registrycmdutil.FetchAllArtifacts(f.Context, api, "")
+	if err != nil {
+		return err
+	}
+
+	stats := registryStats{
+		RegistryID: opts.registryID,
+		Artifacts:  len(artifacts),
+		Groups:     []groupStats{},
+	}
+
+	versions, err := countVersions(f.Context, artifacts, opts.concurrency, func(ctx context.Context, group string, id string) (int, error) {
+		versions, _, err := api.VersionsApi.ListArtifactVersions(ctx, group, id).Limit(1).Execute()
+		if err != nil {
+			return 0, registrycmdutil.TransformInstanceError(err)
+		}
+		return int(versions.GetCount()), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	groups := map[string]*groupStats{}
+	for i := range artifacts {
+		group := registrycmdutil.ArtifactGroup(&artifacts[i])
+
+		if _, ok := groups[group]; !ok {
+			groups[group] = &groupStats{Group: group}
+		}
+		groups[group].Artifacts++
+		groups[group].Versions += versions[i]
+		stats.Versions += versions[i]
+	}
+
+	for _, group := range groups {
+		stats.Groups = append(stats.Groups, *group)
+	}
+	sort.Slice(stats.Groups, func(i, j int) bool {
+		return stats.Groups[i].Group < stats.Groups[j].Group
+	})
+
+	limits, _, err := api.SystemApi.GetResourceLimits(f.Context).Execute()
+	if err != nil {
+		f.Logger.Debug("Could not fetch the limits of the Service Registry instance:", err)
+	} else {
+		stats.MaxArtifacts = limits.GetMaxArtifactsCount()
+		stats.MaxVersions = limits.GetMaxTotalSchemasCount()
+	}
+
+	if opts.outputFormat != dump.EmptyFormat {
+		return dump.Formatted(f.IOStreams.Out, opts.outputFormat, stats)
+	}
+
+	if len(stats.Groups) > 0 {
+		dump.Table(f.IOStreams.Out, stats.Groups)
+		f.Logger.Info("")
+	}
+
+	f.Logger.Info(formatUsage(opts, "registry.stats.log.info.artifacts", stats.Artifacts, stats.MaxArtifacts))
+	f.Logger.Info(formatUsage(opts, "registry.stats.log.info.versions", stats.Versions, stats.MaxVersions))
+
+	return nil
+}
+
+// countVersions counts the versions of each artifact with a pool of workers, the counts keep the order of the artifacts.
+// The registry has no total of the versions, so the number of parallel requests is bounded by concurrency
+// and the requests which are not started yet are cancelled when one of them fails.
+func countVersions(
+	ctx context.Context,
+	artifacts []registryinstanceclient.SearchedArtifact,
+	concurrency int,
+	count func(ctx context.Context, group string, id string) (int, error),
+) ([]int, error) {
+	counts := make([]int, len(artifacts))
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	jobs := make(chan int)
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				n, err := count(ctx, registrycmdutil.ArtifactGroup(&artifacts[i]), artifacts[i].GetId())
+				if err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					continue
+				}
+				counts[i] = n
+			}
+		}()
+	}
+
+	for i := range artifacts {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return counts, ctx.Err()
+}
+
+// formatUsage describes how much of a limit is used, omitting the limit when it is not known
+func formatUsage(opts *options, messageID string, used int, limit int64) string {
+	usedEntry := localize.NewEntry("Used", used)
+	if limit <= 0 {
+		return opts.f.Localizer.MustLocalize(messageID+".noLimit", usedEntry)
+	}
+
+	return opts.f.Localizer.MustLocalize(messageID+".withLimit",
+		usedEntry,
+		localize.NewEntry("Limit", limit),
+		localize.NewEntry("Percentage", int64(used)*100/limit),
+	)
+}
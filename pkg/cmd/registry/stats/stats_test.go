@@ -0,0 +1,88 @@
+package stats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	registryinstanceclient "github.com/redhat-developer/app-services-sdk-go/registryinstance/apiv1internal/client"
+)
+
+func artifacts(n int) []registryinstanceclient.SearchedArtifact {
+	artifacts := make([]registryinstanceclient.SearchedArtifact, n)
+	for i := range artifacts {
+		artifacts[i].Id = fmt.Sprint(i)
+	}
+	return artifacts
+}
+
+func Test_countVersions(t *testing.T) {
+	var (
+		mu          sync.Mutex
+		inFlight    int
+		maxInFlight int
+	)
+
+	counts, err := countVersions(context.Background(), artifacts(20), 3, func(ctx context.Context, group string, id string) (int, error) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		if group != "default" {
+			t.Errorf("group = %q, want the default group", group)
+		}
+
+		var n int
+		_, err := fmt.Sscan(id, &n)
+		return n + 1, err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := make([]int, 20)
+	for i := range want {
+		want[i] = i + 1
+	}
+	if !reflect.DeepEqual(counts, want) {
+		t.Errorf("countVersions() = %v, want the counts in the order of the artifacts %v", counts, want)
+	}
+	if maxInFlight > 3 {
+		t.Errorf("%v requests in parallel, want at most 3", maxInFlight)
+	}
+}
+
+func Test_countVersions_error(t *testing.T) {
+	boom := errors.New("boom")
+	var calls int32
+
+	_, err := countVersions(context.Background(), artifacts(100), 2, func(ctx context.Context, group string, id string) (int, error) {
+		atomic.AddInt32(&calls, 1)
+		if id == "0" {
+			return 0, boom
+		}
+		// the requests of the other workers see the cancellation
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+
+	if !errors.Is(err, boom) {
+		t.Errorf("countVersions() error = %v, want %v", err, boom)
+	}
+	if n := atomic.LoadInt32(&calls); n > 3 {
+		t.Errorf("%v requests were made after the failure, want the remaining artifacts to be skipped", n)
+	}
+}
@@ -0,0 +1,84 @@
+package url
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/serviceregistryutil"
+	srsmgmtv1 "github.com/redhat-developer/app-services-sdk-go/registrymgmt/apiv1/client"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	id string
+
+	IO         *iostreams.IOStreams
+	Connection factory.ConnectionFunc
+	localizer  localize.Localizer
+	Context    context.Context
+}
+
+// NewURLCommand creates a command which prints the REST API URL of a Service Registry instance
+func NewURLCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		IO:         f.IOStreams,
+		Connection: f.Connection,
+		localizer:  f.Localizer,
+		Context:    f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "url",
+		Short:   f.Localizer.MustLocalize("registry.url.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("registry.url.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("registry.url.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if opts.id != "" {
+				return runURL(opts)
+			}
+
+			registryInstance, err := contextutil.GetCurrentRegistryInstance(f)
+			if err != nil {
+				return err
+			}
+
+			return printURL(opts, registryInstance)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.id, "id", "", f.Localizer.MustLocalize("registry.url.flag.id"))
+
+	return cmd
+}
+
+func runURL(opts *options) error {
+	conn, err := opts.Connection()
+	if err != nil {
+		return err
+	}
+
+	registry, httpRes, err := serviceregistryutil.GetServiceRegistryByID(opts.Context, conn.API().ServiceRegistryMgmt(), opts.id)
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		return err
+	}
+
+	return printURL(opts, registry)
+}
+
+func printURL(opts *options, registry *srsmgmtv1.Registry) error {
+	registryURL, ok := registry.GetRegistryUrlOk()
+	if !ok || *registryURL == "" {
+		return opts.localizer.MustLocalizeError("registry.url.error.notAvailable", localize.NewEntry("Name", registry.GetName()))
+	}
+
+	fmt.Fprintln(opts.IO.Out, *registryURL)
+	return nil
+}
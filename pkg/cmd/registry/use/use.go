@@ -1,14 +1,7 @@
 package use
 
 import (
-	"context"
-	"fmt"
-
-	"github.com/redhat-developer/app-services-cli/pkg/core/config"
-	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
-	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
-	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
-	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
 
 	"github.com/redhat-developer/app-services-cli/pkg/shared/connection"
@@ -20,29 +13,26 @@ import (
 	"github.com/spf13/cobra"
 )
 
-type options struct {
-	id          string
-	name        string
-	interactive bool
-
-	IO             *iostreams.IOStreams
-	Config         config.IConfig
-	Connection     factory.ConnectionFunc
-	Logger         logging.Logger
-	localizer      localize.Localizer
-	Context        context.Context
-	ServiceContext servicecontext.IContext
-}
-
 func NewUseCommand(f *factory.Factory) *cobra.Command {
-	opts := &options{
-		Config:         f.Config,
-		Connection:     f.Connection,
-		Logger:         f.Logger,
-		IO:             f.IOStreams,
-		localizer:      f.Localizer,
-		Context:        f.Context,
-		ServiceContext: f.ServiceContext,
+	opts := &contextutil.UseOptions{
+		GetByID: func(conn connection.Connection, id string) (*contextutil.Resource, error) {
+			registry, _, err := serviceregistryutil.GetServiceRegistryByID(f.Context, conn.API().ServiceRegistryMgmt(), id)
+			return registryResource(registry), err
+		},
+		GetByName: func(conn connection.Connection, name string) (*contextutil.Resource, error) {
+			registry, _, err := serviceregistryutil.GetServiceRegistryByName(f.Context, conn.API().ServiceRegistryMgmt(), name)
+			return registryResource(registry), err
+		},
+		InteractiveSelect: func(conn connection.Connection) (*contextutil.Resource, error) {
+			registry, err := serviceregistryutil.InteractiveSelect(f.Context, conn, f.Logger)
+			return registryResource(registry), err
+		},
+		SetID: func(svcConfig *servicecontext.ServiceConfig, id string) {
+			svcConfig.ServiceRegistryID = id
+		},
+		IDOrNameRequiredError: "registry.use.error.idOrNameRequired",
+		SaveError:             "registry.use.error.saveError",
+		UseSuccess:            "registry.use.log.info.useSuccess",
 	}
 
 	cmd := &cobra.Command{
@@ -52,93 +42,21 @@ func NewUseCommand(f *factory.Factory) *cobra.Command {
 		Example: f.Localizer.MustLocalize("registry.cmd.use.example"),
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if opts.id == "" && opts.name == "" {
-				if !opts.IO.CanPrompt() {
-					return opts.localizer.MustLocalizeError("registry.use.error.idOrNameRequired")
-				}
-				opts.interactive = true
-			}
-
-			if opts.name != "" && opts.id != "" {
-				return opts.localizer.MustLocalizeError("service.error.idAndNameCannotBeUsed")
-			}
-
-			return runUse(opts)
+			return contextutil.Use(f, opts)
 		},
 	}
 
-	cmd.Flags().StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("registry.use.flag.id"))
-	cmd.Flags().StringVar(&opts.name, "name", "", opts.localizer.MustLocalize("registry.use.flag.name"))
-
-	return cmd
-}
-
-func runUse(opts *options) error {
-	svcContext, err := opts.ServiceContext.Load()
-	if err != nil {
-		return err
-	}
+	flags := flagutil.NewFlagSet(cmd, f.Localizer)
 
-	currCtx, err := contextutil.GetCurrentContext(svcContext, opts.localizer)
-	if err != nil {
-		return err
-	}
+	flags.StringVar(&opts.ID, "id", "", f.Localizer.MustLocalize("registry.use.flag.id"))
+	flags.StringVar(&opts.Name, "name", "", f.Localizer.MustLocalize("registry.use.flag.name"))
 
-	conn, err := opts.Connection()
-	if err != nil {
-		return err
-	}
-
-	if opts.interactive {
-		// run the use command interactively
-		err = runInteractivePrompt(opts, &conn)
-		if err != nil {
-			return err
-		}
-		// no service was selected, exit program
-		if opts.name == "" {
-			return nil
-		}
-	}
-
-	api := conn.API()
-
-	var registry *srsmgmtv1.Registry
-	if opts.name != "" {
-		registry, _, err = serviceregistryutil.GetServiceRegistryByName(opts.Context, api.ServiceRegistryMgmt(), opts.name)
-		if err != nil {
-			return err
-		}
-	} else {
-		registry, _, err = serviceregistryutil.GetServiceRegistryByID(opts.Context, api.ServiceRegistryMgmt(), opts.id)
-		if err != nil {
-			return err
-		}
-	}
-
-	nameTmplEntry := localize.NewEntry("Name", registry.GetName())
-	currCtx.ServiceRegistryID = registry.GetId()
-	svcContext.Contexts[svcContext.CurrentContext] = *currCtx
-
-	if err := opts.ServiceContext.Save(svcContext); err != nil {
-		saveErrMsg := opts.localizer.MustLocalize("registry.use.error.saveError", nameTmplEntry)
-		return fmt.Errorf("%v: %w", saveErrMsg, err)
-	}
-
-	opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("registry.use.log.info.useSuccess", nameTmplEntry))
-
-	return nil
+	return cmd
 }
 
-func runInteractivePrompt(opts *options, conn *connection.Connection) error {
-	opts.Logger.Debug(opts.localizer.MustLocalize("common.log.debug.startingInteractivePrompt"))
-
-	selectedRegistry, err := serviceregistryutil.InteractiveSelect(opts.Context, *conn, opts.Logger)
-	if err != nil {
-		return err
+func registryResource(registry *srsmgmtv1.Registry) *contextutil.Resource {
+	if registry == nil {
+		return nil
 	}
-
-	opts.name = selectedRegistry.GetName()
-
-	return nil
+	return &contextutil.Resource{ID: registry.GetId(), Name: registry.GetName()}
 }
@@ -0,0 +1,118 @@
+package waitfor
+
+import (
+	"context"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/jobs"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/serviceregistryutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/waitutil"
+	srsmgmtv1 "github.com/redhat-developer/app-services-sdk-go/registrymgmt/apiv1/client"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultCondition = "status=ready"
+	defaultTimeout   = 20 * time.Minute
+)
+
+type options struct {
+	id        string
+	condition string
+	timeout   time.Duration
+	async     bool
+
+	f *factory.Factory
+}
+
+// NewWaitForCommand creates a new command which waits until a Service Registry instance satisfies a condition
+func NewWaitForCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "wait-for",
+		Short:   f.Localizer.MustLocalize("registry.waitFor.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("registry.waitFor.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("registry.waitFor.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.timeout <= 0 {
+				return f.Localizer.MustLocalizeError("waitFor.error.invalidTimeout", localize.NewEntry("Timeout", opts.timeout))
+			}
+
+			if opts.async {
+				return jobs.RunAsync(f)
+			}
+
+			if opts.id == "" {
+				registryInstance, err := contextutil.GetCurrentRegistryInstance(f)
+				if err != nil {
+					return err
+				}
+
+				opts.id = registryInstance.GetId()
+			}
+
+			return runWaitFor(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, f.Localizer)
+
+	flags.StringVar(&opts.id, "id", "", f.Localizer.MustLocalize("registry.waitFor.flag.id.description"))
+	flags.StringVar(&opts.condition, "condition", defaultCondition, f.Localizer.MustLocalize("waitFor.flag.condition.description"))
+	flags.DurationVar(&opts.timeout, "timeout", defaultTimeout, f.Localizer.MustLocalize("waitFor.flag.timeout.description"))
+	flags.AddAsync(&opts.async)
+
+	return cmd
+}
+
+func runWaitFor(opts *options) error {
+	f := opts.f
+
+	condition, err := waitutil.ParseCondition(opts.condition)
+	if err != nil {
+		return err
+	}
+
+	conn, err := f.Connection()
+	if err != nil {
+		return err
+	}
+
+	api := conn.API().ServiceRegistryMgmt()
+
+	registry, _, err := serviceregistryutil.GetServiceRegistryByID(f.Context, api, opts.id)
+	if err != nil {
+		return err
+	}
+
+	fetch := func(ctx context.Context) (interface{}, error) {
+		registry, _, err := serviceregistryutil.GetServiceRegistryByID(ctx, api, opts.id)
+		return registry, err
+	}
+
+	abort := func(resource interface{}) error {
+		registry := resource.(*srsmgmtv1.Registry)
+		if registry.GetStatus() == srsmgmtv1.REGISTRYSTATUSVALUE_FAILED {
+			return f.Localizer.MustLocalizeError("registry.waitFor.error.failed", localize.NewEntry("Name", registry.GetName()))
+		}
+		return nil
+	}
+
+	_, err = waitutil.UntilWithSpinner(f, registry.GetName(), fetch, condition, waitutil.Options{
+		Interval: cmdutil.DefaultPollTime,
+		Timeout:  opts.timeout,
+		Abort:    abort,
+	})
+
+	return err
+}
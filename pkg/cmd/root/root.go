@@ -1,21 +1,33 @@
 package root
 
 import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/auth"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/cluster"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/completion"
 
+	cliconfig "github.com/redhat-developer/app-services-cli/pkg/cmd/config"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/connector"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/context"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/dashboard"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/debug"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/docs"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/examples"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/generate"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/job"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/login"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/logout"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/promptinfo"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/request"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/selftest"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/service"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/setup"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/status"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/telemetry"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/token"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/upgrade"
 	cliversion "github.com/redhat-developer/app-services-cli/pkg/cmd/version"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/whoami"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
@@ -39,6 +51,17 @@ func NewRootCommand(f *factory.Factory, version string) *cobra.Command {
 	var help bool
 
 	fs.BoolVarP(&help, "help", "h", false, f.Localizer.MustLocalize("root.cmd.flag.help.description"))
+	// the locale is applied when the localizer is created, the flag is registered so that it is accepted by all commands
+	fs.String(flagutil.LocaleFlagName, "", f.Localizer.MustLocalize("root.cmd.flag.locale.description"))
+	flagutil.NoTruncateFlag(fs, f.Localizer.MustLocalize("root.cmd.flag.noTruncate.description"))
+	flagutil.TimestampsFlag(fs, f.Localizer.MustLocalize("root.cmd.flag.timestamps.description"))
+	flagutil.NoPagerFlag(fs, f.Localizer.MustLocalize("root.cmd.flag.noPager.description"))
+	flagutil.NoColorFlag(fs, f.Localizer.MustLocalize("root.cmd.flag.noColor.description"))
+	flagutil.SaveReproducerFlag(fs, f.Localizer.MustLocalize("root.cmd.flag.saveReproducer.description"))
+	flagutil.UserAgentSuffixFlag(fs, f.Localizer.MustLocalize("root.cmd.flag.userAgentSuffix.description"))
+	flagutil.SkipVersionCheckFlag(fs, f.Localizer.MustLocalize("root.cmd.flag.skipVersionCheck.description"))
+	flagutil.TimeoutFlag(fs, f.Localizer.MustLocalize("root.cmd.flag.timeout.description"))
+	flagutil.ContextFileFlag(fs, f.Localizer.MustLocalize("root.cmd.flag.contextFile.description"))
 	cmd.Flags().Bool("version", false, f.Localizer.MustLocalize("root.cmd.flag.version.description"))
 
 	cmd.Version = version
@@ -46,6 +69,7 @@ func NewRootCommand(f *factory.Factory, version string) *cobra.Command {
 	// Child commands
 	cmd.AddCommand(login.NewLoginCmd(f))
 	cmd.AddCommand(logout.NewLogoutCommand(f))
+	cmd.AddCommand(auth.NewAuthCommand(f))
 	cmd.AddCommand(kafka.NewKafkaCommand(f))
 	cmd.AddCommand(serviceaccount.NewServiceAccountCommand(f))
 	cmd.AddCommand(cluster.NewClusterCommand(f))
@@ -53,14 +77,27 @@ func NewRootCommand(f *factory.Factory, version string) *cobra.Command {
 	cmd.AddCommand(generate.NewGenerateCommand(f))
 	cmd.AddCommand(completion.NewCompletionCommand(f))
 	cmd.AddCommand(whoami.NewWhoAmICmd(f))
+	cmd.AddCommand(promptinfo.NewPromptInfoCommand(f))
+	cmd.AddCommand(cliconfig.NewConfigCommand(f))
+	cmd.AddCommand(telemetry.NewTelemetryCommand(f))
 	cmd.AddCommand(cliversion.NewVersionCmd(f))
+	cmd.AddCommand(upgrade.NewUpgradeCommand(f))
 	cmd.AddCommand(token.NewAuthTokenCmd(f))
 	// Registry commands
 	cmd.AddCommand(registry.NewServiceRegistryCommand(f))
 	cmd.AddCommand(connector.NewConnectorsCommand(f))
+	cmd.AddCommand(service.NewServiceCommand(f))
+	cmd.AddCommand(setup.NewSetupCommand(f))
 	cmd.AddCommand(docs.NewDocsCmd(f))
+	cmd.AddCommand(examples.NewExamplesCommand(f))
+	cmd.AddCommand(job.NewJobCommand(f))
 	cmd.AddCommand(request.NewCallCmd(f))
 	cmd.AddCommand(context.NewContextCmd(f))
+	cmd.AddCommand(dashboard.NewDashboardCommand(f))
+	cmd.AddCommand(selftest.NewSelfTestCommand(f))
+	cmd.AddCommand(debug.NewDebugCommand(f, func(f *factory.Factory) *cobra.Command {
+		return NewRootCommand(f, version)
+	}))
 
 	return cmd
 }
@@ -0,0 +1,104 @@
+package selftest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+const (
+	kafkasPath     = "/api/kafkas_mgmt/v1/kafkas"
+	registriesPath = "/api/serviceregistry_mgmt/v1/registries"
+	tokenPath      = "/auth/realms/selftest/protocol/openid-connect/token"
+
+	// kafkaID is the ID of the only Kafka instance known by the mock backend
+	kafkaID = "cbk7qfs0ijjdml4kh1hg"
+)
+
+var kafka = map[string]interface{}{
+	"id":                       kafkaID,
+	"kind":                     "Kafka",
+	"href":                     kafkasPath + "/" + kafkaID,
+	"status":                   "ready",
+	"cloud_provider":           "aws",
+	"multi_az":                 true,
+	"region":                   "us-east-1",
+	"owner":                    "selftest-user",
+	"name":                     "selftest-kafka",
+	"bootstrap_server_host":    "selftest-kafka.kafka.example.com:443",
+	"admin_api_server_url":     "https://admin-server-selftest-kafka.example.com",
+	"created_at":               "2022-01-01T00:00:00Z",
+	"updated_at":               "2022-01-01T00:05:00Z",
+	"version":                  "3.3.1",
+	"instance_type":            "standard",
+	"reauthentication_enabled": true,
+	"kafka_storage_size":       "1000Gi",
+	"size_id":                  "x1",
+	"billing_model":            "standard",
+}
+
+// newBackend starts a server which answers the requests of the self-test cases with fixed responses,
+// so that the output of the commands only depends on the CLI itself.
+// Refreshing the tokens always returns the given token.
+func newBackend(token string) *httptest.Server {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc(tokenPath, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"access_token":  token,
+			"refresh_token": token,
+			"token_type":    "Bearer",
+			"expires_in":    3600,
+		})
+	})
+
+	mux.HandleFunc(kafkasPath, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, page("KafkaRequestList", kafka))
+	})
+
+	mux.HandleFunc(kafkasPath+"/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, kafkasPath+"/")
+		if id != kafkaID {
+			writeError(w, "KAFKAS-MGMT-7", fmt.Sprintf("Kafka instance with id '%v' not found", id))
+			return
+		}
+		writeJSON(w, http.StatusOK, kafka)
+	})
+
+	mux.HandleFunc(registriesPath, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, page("RegistryList"))
+	})
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, "SELFTEST-404", fmt.Sprintf("%v is not served by the self-test backend", r.URL.Path))
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func page(kind string, items ...interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"kind":  kind,
+		"page":  1,
+		"size":  len(items),
+		"total": len(items),
+		"items": append([]interface{}{}, items...),
+	}
+}
+
+func writeError(w http.ResponseWriter, code string, reason string) {
+	writeJSON(w, http.StatusNotFound, map[string]interface{}{
+		"kind":   "Error",
+		"id":     "404",
+		"code":   code,
+		"reason": reason,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,19 @@
+package selftest
+
+// testCase is a command line run against the mock backend,
+// its output is compared with the golden file of the same name
+type testCase struct {
+	name string
+	args []string
+}
+
+var testCases = []testCase{
+	{name: "kafka-list", args: []string{"kafka", "list"}},
+	{name: "kafka-list-json", args: []string{"kafka", "list", "-o", "json"}},
+	{name: "kafka-list-yaml", args: []string{"kafka", "list", "-o", "yaml"}},
+	{name: "kafka-describe", args: []string{"kafka", "describe", "--id", kafkaID}},
+	{name: "kafka-describe-not-found", args: []string{"kafka", "describe", "--id", "unknown"}},
+	{name: "registry-list-empty", args: []string{"service-registry", "list"}},
+	{name: "whoami", args: []string{"whoami"}},
+	{name: "unknown-flag", args: []string{"kafka", "list", "--unknown"}},
+}
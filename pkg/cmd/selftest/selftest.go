@@ -0,0 +1,199 @@
+package selftest
+
+import (
+	"bytes"
+	"embed"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/internal/telemetry"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+//go:embed golden/*.golden
+var golden embed.FS
+
+type options struct {
+	IO        *iostreams.IOStreams
+	localizer localize.Localizer
+
+	writeGolden string
+}
+
+// NewSelfTestCommand creates a hidden command which runs a set of commands of this executable
+// against a mock backend and compares their output with the golden files embedded in the build
+func NewSelfTestCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		IO:        f.IOStreams,
+		localizer: f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:         "selftest",
+		Short:       f.Localizer.MustLocalize("selftest.cmd.shortDescription"),
+		Long:        f.Localizer.MustLocalize("selftest.cmd.longDescription"),
+		Example:     f.Localizer.MustLocalize("selftest.cmd.example"),
+		Hidden:      true,
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{cmdutil.OfflineAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSelfTest(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, f.Localizer)
+	flags.StringVar(&opts.writeGolden, "write-golden", "", f.Localizer.MustLocalize("selftest.flag.writeGolden.description"))
+
+	return cmd
+}
+
+func runSelfTest(opts *options) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	dir, err := os.MkdirTemp("", "rhoas-selftest")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	token, err := unsignedToken(map[string]interface{}{
+		"exp":                4102444800,
+		"iat":                1640995200,
+		"preferred_username": "selftest-user",
+	})
+	if err != nil {
+		return err
+	}
+
+	backend := newBackend(token)
+	defer backend.Close()
+
+	env, err := prepareEnv(dir, backend.URL, token)
+	if err != nil {
+		return err
+	}
+
+	replacer := strings.NewReplacer(backend.URL, "<backend>", dir, "<dir>")
+
+	var failed int
+	for _, tc := range testCases {
+		// #nosec G204
+		c := exec.Command(executable, tc.args...)
+		c.Env = env
+		c.Dir = dir
+		var stdout, stderr bytes.Buffer
+		c.Stdout = &stdout
+		c.Stderr = &stderr
+
+		exitCode := 0
+		if err = c.Run(); err != nil {
+			var exitErr *exec.ExitError
+			if !errors.As(err, &exitErr) {
+				return err
+			}
+			exitCode = exitErr.ExitCode()
+		}
+
+		actual := replacer.Replace(fmt.Sprintf("$ rhoas %v\nexit status: %v\n--- stdout\n%v--- stderr\n%v",
+			strings.Join(tc.args, " "), exitCode, stdout.String(), stderr.String()))
+
+		if opts.writeGolden != "" {
+			if err = os.WriteFile(filepath.Join(opts.writeGolden, tc.name+".golden"), []byte(actual), 0o600); err != nil {
+				return err
+			}
+			fmt.Fprintf(opts.IO.Out, "%v %v\n", icon.SuccessPrefix(), tc.name)
+			continue
+		}
+
+		expected, err := golden.ReadFile("golden/" + tc.name + ".golden")
+		if err != nil {
+			return err
+		}
+		if line, want, got, ok := firstDifference(string(expected), actual); !ok {
+			failed++
+			fmt.Fprintf(opts.IO.Out, "%v %v\n", icon.ErrorPrefix(), tc.name)
+			fmt.Fprintln(opts.IO.Out, opts.localizer.MustLocalize("selftest.log.info.difference",
+				localize.NewEntry("Line", line), localize.NewEntry("Expected", want), localize.NewEntry("Actual", got)))
+			continue
+		}
+		fmt.Fprintf(opts.IO.Out, "%v %v\n", icon.SuccessPrefix(), tc.name)
+	}
+
+	if failed > 0 {
+		return opts.localizer.MustLocalizeError("selftest.error.failed", localize.NewEntry("Count", failed), localize.NewEntry("Total", len(testCases)))
+	}
+	return nil
+}
+
+// prepareEnv writes a configuration logged in to the backend and returns the environment of the test cases.
+// The language is fixed to English, which is the language of the golden files.
+func prepareEnv(dir string, backendURL string, token string) ([]string, error) {
+	cfg := config.Config{
+		AccessToken:  token,
+		RefreshToken: token,
+		APIUrl:       backendURL,
+		AuthURL:      backendURL + "/auth/realms/selftest",
+		ClientID:     "rhoas-cli-selftest",
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	cfgFile := filepath.Join(dir, "config.json")
+	if err = os.WriteFile(cfgFile, data, 0o600); err != nil {
+		return nil, err
+	}
+
+	return append(os.Environ(),
+		config.EnvName+"="+cfgFile,
+		servicecontext.ContextEnvName+"="+filepath.Join(dir, "contexts.json"),
+		servicecontext.ContextFileEnvName+"="+filepath.Join(dir, "contexts.json"),
+		localize.LanguageEnvName+"=en",
+		telemetry.ControlTelemetryEnv+"=false",
+	), nil
+}
+
+// unsignedToken creates a JWT with the given claims, the CLI reads the claims without verifying the signature
+func unsignedToken(claims map[string]interface{}) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	encode := base64.RawURLEncoding.EncodeToString
+	return encode([]byte(`{"alg":"none","typ":"JWT"}`)) + "." + encode(payload) + ".selftest", nil
+}
+
+// firstDifference returns the first line, counting from 1, where the expected and actual output differ
+func firstDifference(expected string, actual string) (line int, want string, got string, equal bool) {
+	if expected == actual {
+		return 0, "", "", true
+	}
+	wantLines := strings.Split(expected, "\n")
+	gotLines := strings.Split(actual, "\n")
+	for i := range wantLines {
+		if i >= len(gotLines) {
+			return i + 1, wantLines[i], "", false
+		}
+		if wantLines[i] != gotLines[i] {
+			return i + 1, wantLines[i], gotLines[i], false
+		}
+	}
+	return len(wantLines) + 1, "", gotLines[len(wantLines)], false
+}
@@ -0,0 +1,53 @@
+package selftest
+
+import "testing"
+
+func TestFirstDifference(t *testing.T) {
+	tests := []struct {
+		name      string
+		expected  string
+		actual    string
+		wantLine  int
+		wantWant  string
+		wantGot   string
+		wantEqual bool
+	}{
+		{
+			name:      "equal output",
+			expected:  "a\nb\n",
+			actual:    "a\nb\n",
+			wantEqual: true,
+		},
+		{
+			name:     "different line",
+			expected: "a\nb\nc",
+			actual:   "a\nx\nc",
+			wantLine: 2,
+			wantWant: "b",
+			wantGot:  "x",
+		},
+		{
+			name:     "missing lines",
+			expected: "a\nb",
+			actual:   "a",
+			wantLine: 2,
+			wantWant: "b",
+		},
+		{
+			name:     "extra lines",
+			expected: "a",
+			actual:   "a\nb",
+			wantLine: 2,
+			wantGot:  "b",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line, want, got, equal := firstDifference(tt.expected, tt.actual)
+			if line != tt.wantLine || want != tt.wantWant || got != tt.wantGot || equal != tt.wantEqual {
+				t.Errorf("firstDifference() = %v, %q, %q, %v, want %v, %q, %q, %v",
+					line, want, got, equal, tt.wantLine, tt.wantWant, tt.wantGot, tt.wantEqual)
+			}
+		})
+	}
+}
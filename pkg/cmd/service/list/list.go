@@ -0,0 +1,209 @@
+package list
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/auth/token"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/kafkautil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/serviceregistryutil"
+	connectormgmtclient "github.com/redhat-developer/app-services-sdk-go/connectormgmt/apiv1/client"
+
+	"github.com/spf13/cobra"
+)
+
+// namespacePageSize is the page size used to list connector namespaces
+const namespacePageSize = 100
+
+// Service types shown in the TYPE column
+const (
+	typeKafka     = "kafka"
+	typeRegistry  = "service-registry"
+	typeNamespace = "connector-namespace"
+)
+
+// serviceRow is the details of a service instance needed to print to a table
+type serviceRow struct {
+	Type   string `json:"type" header:"Type"`
+	ID     string `json:"id" header:"ID"`
+	Name   string `json:"name" header:"Name"`
+	Owner  string `json:"owner" header:"Owner"`
+	Status string `json:"status" header:"Status"`
+}
+
+type options struct {
+	outputFormat string
+	all          bool
+
+	f *factory.Factory
+}
+
+// NewListCommand creates a new command to list the service instances of all types
+func NewListCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   f.Localizer.MustLocalize("service.list.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("service.list.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("service.list.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			return runList(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, f.Localizer)
+	flags.AddOutput(&opts.outputFormat)
+	flags.BoolVar(&opts.all, "all", false, f.Localizer.MustLocalize("service.list.flag.all"))
+
+	return cmd
+}
+
+func runList(opts *options) error {
+	f := opts.f
+
+	conn, err := f.Connection()
+	if err != nil {
+		return err
+	}
+
+	owner := ""
+	if !opts.all {
+		cfg, err := f.Config.Load()
+		if err != nil {
+			return err
+		}
+		owner, _ = token.GetUsername(cfg.AccessToken)
+	}
+
+	api := conn.API()
+	warn := func(service string, err error) {
+		f.Logger.Info(f.Localizer.MustLocalize("service.list.log.info.unavailable",
+			localize.NewEntry("Service", service),
+			localize.NewEntry("Error", err),
+		))
+	}
+
+	var rows []serviceRow
+
+	if kafkas, err := kafkautil.ListKafkas(f.Context, api.KafkaMgmt(), ""); err != nil {
+		warn("Kafka", err)
+	} else {
+		for i := range kafkas {
+			k := kafkas[i]
+			rows = append(rows, serviceRow{
+				Type:   typeKafka,
+				ID:     k.GetId(),
+				Name:   k.GetName(),
+				Owner:  k.GetOwner(),
+				Status: k.GetStatus(),
+			})
+		}
+	}
+
+	if registries, err := serviceregistryutil.ListServiceRegistries(f.Context, api.ServiceRegistryMgmt(), ""); err != nil {
+		warn("Service Registry", err)
+	} else {
+		for i := range registries {
+			r := registries[i]
+			rows = append(rows, serviceRow{
+				Type:   typeRegistry,
+				ID:     r.GetId(),
+				Name:   r.GetName(),
+				Owner:  r.GetOwner(),
+				Status: string(r.GetStatus()),
+			})
+		}
+	}
+
+	if namespaces, err := listNamespaces(f, api.ConnectorsMgmt().ConnectorNamespacesApi); err != nil {
+		warn("Connectors", err)
+	} else {
+		for i := range namespaces {
+			n := namespaces[i]
+			status := n.GetStatus()
+			rows = append(rows, serviceRow{
+				Type:   typeNamespace,
+				ID:     n.GetId(),
+				Name:   n.GetName(),
+				Owner:  n.GetOwner(),
+				Status: string(status.GetState()),
+			})
+		}
+	}
+
+	rows = filterRows(rows, owner)
+
+	if len(rows) == 0 && opts.outputFormat == "" {
+		f.Logger.Info(f.Localizer.MustLocalize("service.list.log.info.noServices"))
+		return nil
+	}
+
+	switch opts.outputFormat {
+	case dump.EmptyFormat:
+		for i := range rows {
+			rows[i].Name = dump.OrPlaceholder(rows[i].Name)
+			rows[i].Owner = dump.OrPlaceholder(rows[i].Owner)
+			rows[i].Status = dump.OrPlaceholder(rows[i].Status)
+		}
+		dump.Table(f.IOStreams.Out, rows)
+		f.Logger.Info("")
+	default:
+		return dump.Formatted(f.IOStreams.Out, opts.outputFormat, rows)
+	}
+
+	return nil
+}
+
+// listNamespaces returns all connector namespaces visible to the user
+func listNamespaces(f *factory.Factory, api connectormgmtclient.ConnectorNamespacesApi) ([]connectormgmtclient.ConnectorNamespace, error) {
+	var namespaces []connectormgmtclient.ConnectorNamespace
+	for page := 1; ; page++ {
+		list, httpRes, err := api.ListConnectorNamespaces(f.Context).
+			Page(strconv.Itoa(page)).
+			Size(strconv.Itoa(namespacePageSize)).
+			Execute()
+		if httpRes != nil {
+			httpRes.Body.Close()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		namespaces = append(namespaces, list.GetItems()...)
+		if len(list.GetItems()) < namespacePageSize || len(namespaces) >= int(list.GetTotal()) {
+			return namespaces, nil
+		}
+	}
+}
+
+// filterRows keeps the services owned by owner, or all of them when owner is empty,
+// and sorts them by type and name
+func filterRows(rows []serviceRow, owner string) []serviceRow {
+	filtered := make([]serviceRow, 0, len(rows))
+	for _, row := range rows {
+		if owner == "" || row.Owner == owner {
+			filtered = append(filtered, row)
+		}
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if filtered[i].Type != filtered[j].Type {
+			return filtered[i].Type < filtered[j].Type
+		}
+		return filtered[i].Name < filtered[j].Name
+	})
+
+	return filtered
+}
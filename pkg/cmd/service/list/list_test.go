@@ -0,0 +1,88 @@
+package list
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory/fakes"
+)
+
+func Test_filterRows(t *testing.T) {
+	rows := []serviceRow{
+		{Type: typeRegistry, Name: "registry", Owner: "alice"},
+		{Type: typeKafka, Name: "kafka-b", Owner: "alice"},
+		{Type: typeKafka, Name: "kafka-a", Owner: "bob"},
+		{Type: typeNamespace, Name: "namespace", Owner: "alice"},
+	}
+
+	tests := []struct {
+		name  string
+		owner string
+		want  []string
+	}{
+		{name: "all services", owner: "", want: []string{"namespace", "kafka-a", "kafka-b", "registry"}},
+		{name: "services of one owner", owner: "alice", want: []string{"namespace", "kafka-b", "registry"}},
+		{name: "unknown owner", owner: "carol", want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, row := range filterRows(rows, tt.owner) {
+				got = append(got, row.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filterRows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListCommand(t *testing.T) {
+	f := fakes.NewFactory(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/kafkas_mgmt/v1/kafkas", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{
+			"kind": "KafkaRequestList", "page": 1, "size": 1, "total": 1,
+			"items": []map[string]interface{}{{"id": "k1", "name": "kafka", "owner": "alice", "status": "ready"}},
+		})
+	})
+	mux.HandleFunc("/api/serviceregistry_mgmt/v1/registries", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{
+			"kind": "RegistryList", "page": 1, "size": 1, "total": 1,
+			"items": []map[string]interface{}{{"id": "r1", "name": "registry", "owner": "bob", "status": "ready"}},
+		})
+	})
+	mux.HandleFunc("/api/connector_mgmt/v1/kafka_connector_namespaces", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{
+			"kind": "ConnectorNamespaceList", "page": 1, "size": 0, "total": 0,
+			"items": []interface{}{},
+		})
+	})
+	f.WithServer(t, mux)
+
+	cmd := NewListCommand(f.Factory)
+	cmd.SetArgs([]string{"--all", "-o", "json"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() error = %v, stderr: %v", err, f.ErrOut.String())
+	}
+
+	var got []serviceRow
+	if err := json.Unmarshal(f.Out.Bytes(), &got); err != nil {
+		t.Fatalf("invalid output %q: %v", f.Out.String(), err)
+	}
+	want := []serviceRow{
+		{Type: typeKafka, ID: "k1", Name: "kafka", Owner: "alice", Status: "ready"},
+		{Type: typeRegistry, ID: "r1", Name: "registry", Owner: "bob", Status: "ready"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("list = %+v, want %+v", got, want)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
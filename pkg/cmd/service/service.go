@@ -0,0 +1,24 @@
+package service
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/service/list"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+// NewServiceCommand creates a new command group for working with all application services at once
+func NewServiceCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "service",
+		Short:   f.Localizer.MustLocalize("service.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("service.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("service.cmd.example"),
+		Args:    cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		list.NewListCommand(f),
+	)
+
+	return cmd
+}
@@ -0,0 +1,167 @@
+package create
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/svcaccountcmdutil/credentials"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/svcaccountcmdutil/validation"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/color"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/connection"
+
+	svcacctmgmtclient "github.com/redhat-developer/app-services-sdk-go/serviceaccountmgmt/apiv1/client"
+	svcacctmgmterrors "github.com/redhat-developer/app-services-sdk-go/serviceaccountmgmt/apiv1/error"
+)
+
+// bulkResult is the outcome of creating one service account from the roster
+type bulkResult struct {
+	entry    rosterEntry
+	filePath string
+	clientID string
+	err      error
+}
+
+// runBulkCreate creates every service account in the roster file
+func runBulkCreate(opts *options) error {
+	entries, err := readRoster(opts.fromFile)
+	if err != nil {
+		return opts.localizer.MustLocalizeError("serviceAccount.create.error.invalidRoster", localize.NewEntry("File", opts.fromFile), localize.NewEntry("Error", err))
+	}
+	if len(entries) == 0 {
+		return opts.localizer.MustLocalizeError("serviceAccount.create.error.emptyRoster", localize.NewEntry("File", opts.fromFile))
+	}
+
+	validator := &validation.Validator{
+		Localizer: opts.localizer,
+	}
+
+	// validate the whole roster before creating anything
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		if err = validator.ValidateShortDescription(entry.Name); err != nil {
+			return err
+		}
+		if seen[entry.Name] {
+			return opts.localizer.MustLocalizeError("serviceAccount.create.error.duplicateName", localize.NewEntry("Name", entry.Name))
+		}
+		seen[entry.Name] = true
+
+		filePath := bundlePath(opts.outputDir, entry.Name, opts.fileFormat)
+		if _, err = os.Stat(filePath); err == nil && !opts.overwrite {
+			return opts.localizer.MustLocalizeError("serviceAccount.common.error.credentialsFileAlreadyExists", localize.NewEntry("FilePath", filePath))
+		}
+	}
+
+	if err = os.MkdirAll(opts.outputDir, 0o700); err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection()
+	if err != nil {
+		return err
+	}
+
+	opts.Logger.Info(opts.localizer.MustLocalize("serviceAccount.create.log.info.creatingBulk", localize.NewEntry("Count", len(entries))))
+
+	results := createAll(opts, conn, entries)
+
+	var failed int
+	for _, result := range results {
+		if result.err != nil {
+			failed++
+			opts.Logger.Info(icon.ErrorPrefix(), opts.localizer.MustLocalize("serviceAccount.create.log.info.bulkFailed",
+				localize.NewEntry("Name", result.entry.Name),
+				localize.NewEntry("Error", result.err),
+			))
+			continue
+		}
+		opts.Logger.Info(icon.SuccessPrefix(), opts.localizer.MustLocalize("serviceAccount.create.log.info.bulkCreated",
+			localize.NewEntry("Name", result.entry.Name),
+			localize.NewEntry("ClientID", color.Success(result.clientID)),
+			localize.NewEntry("FilePath", color.CodeSnippet(result.filePath)),
+		))
+	}
+
+	if failed > 0 {
+		return opts.localizer.MustLocalizeError("serviceAccount.create.error.bulkFailed", localize.NewEntry("Count", failed), localize.NewEntry("Total", len(results)))
+	}
+
+	return nil
+}
+
+// createAll creates the service accounts with a pool of workers, the results keep the roster order
+func createAll(opts *options, conn connection.Connection, entries []rosterEntry) []bulkResult {
+	results := make([]bulkResult, len(entries))
+	tokenURL := conn.API().GetConfig().AuthURL.String() + "/protocol/openid-connect/token"
+
+	concurrency := opts.concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = createOne(opts, conn, entries[i], tokenURL)
+			}
+		}()
+	}
+
+	for i := range entries {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+func createOne(opts *options, conn connection.Connection, entry rosterEntry, tokenURL string) bulkResult {
+	result := bulkResult{
+		entry:    entry,
+		filePath: bundlePath(opts.outputDir, entry.Name, opts.fileFormat),
+	}
+
+	payload := svcacctmgmtclient.ServiceAccountCreateRequestData{Name: entry.Name}
+	if entry.Description != "" {
+		payload.SetDescription(entry.Description)
+	}
+
+	serviceacct, httpRes, err := conn.API().
+		ServiceAccountMgmt().
+		CreateServiceAccount(opts.Context).
+		ServiceAccountCreateRequestData(payload).
+		Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+
+	if apiErr := svcacctmgmterrors.GetAPIError(err); apiErr != nil && apiErr.GetError() == "service_account_limit_exceeded" {
+		result.err = opts.localizer.MustLocalizeError("serviceAccount.common.error.limitExceeded")
+		return result
+	}
+	if err != nil {
+		result.err = err
+		return result
+	}
+
+	result.clientID = serviceacct.GetClientId()
+
+	creds := &credentials.Credentials{
+		ClientID:     serviceacct.GetClientId(),
+		ClientSecret: serviceacct.GetSecret(),
+		TokenURL:     tokenURL,
+	}
+	if err = credentials.Write(opts.fileFormat, result.filePath, creds); err != nil {
+		result.err = fmt.Errorf("%v: %w", opts.localizer.MustLocalize("serviceAccount.common.error.couldNotSaveCredentialsFile"), err)
+	}
+
+	return result
+}
@@ -37,6 +37,9 @@ type options struct {
 	overwrite        bool
 	shortDescription string
 	filename         string
+	fromFile         string
+	outputDir        string
+	concurrency      int
 
 	interactive bool
 }
@@ -59,6 +62,20 @@ func NewCreateCommand(f *factory.Factory) *cobra.Command {
 		Example: opts.localizer.MustLocalize("serviceAccount.create.cmd.example"),
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			if opts.fromFile != "" {
+				if opts.shortDescription != "" || opts.filename != "" {
+					return opts.localizer.MustLocalizeError("serviceAccount.create.error.fromFileCannotBeCombined")
+				}
+				if opts.fileFormat == "" {
+					return opts.localizer.MustLocalizeError("flag.error.requiredWhenNonInteractive", localize.NewEntry("Flag", "file-format"))
+				}
+				if !flagutil.IsValidInput(opts.fileFormat, svcaccountcmdutil.CredentialsOutputFormats...) {
+					return flagutil.InvalidValueError("file-format", opts.fileFormat, svcaccountcmdutil.CredentialsOutputFormats...)
+				}
+
+				return runBulkCreate(opts)
+			}
+
 			if !opts.IO.CanPrompt() && opts.shortDescription == "" {
 				return opts.localizer.MustLocalizeError("flag.error.requiredWhenNonInteractive", localize.NewEntry("Flag", "short-description"))
 			} else if opts.shortDescription == "" {
@@ -93,6 +110,9 @@ func NewCreateCommand(f *factory.Factory) *cobra.Command {
 	cmd.Flags().BoolVar(&opts.overwrite, "overwrite", false, opts.localizer.MustLocalize("serviceAccount.common.flag.overwrite.description"))
 	cmd.Flags().StringVar(&opts.filename, "output-file", "", opts.localizer.MustLocalize("serviceAccount.common.flag.fileLocation.description"))
 	cmd.Flags().StringVar(&opts.fileFormat, "file-format", "", opts.localizer.MustLocalize("serviceAccount.common.flag.fileFormat.description"))
+	cmd.Flags().StringVar(&opts.fromFile, "from-file", "", opts.localizer.MustLocalize("serviceAccount.create.flag.fromFile.description"))
+	cmd.Flags().StringVar(&opts.outputDir, "output-dir", ".", opts.localizer.MustLocalize("serviceAccount.create.flag.outputDir.description"))
+	cmd.Flags().IntVar(&opts.concurrency, "concurrency", 4, opts.localizer.MustLocalize("serviceAccount.create.flag.concurrency.description"))
 
 	flagutil.EnableStaticFlagCompletion(cmd, "file-format", svcaccountcmdutil.CredentialsOutputFormats)
 
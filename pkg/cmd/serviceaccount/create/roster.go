@@ -0,0 +1,91 @@
+package create
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/svcaccountcmdutil/credentials"
+
+	"gopkg.in/yaml.v2"
+)
+
+// rosterEntry is a service account to create in bulk
+type rosterEntry struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+}
+
+// readRoster reads the service accounts to create from a CSV, YAML or JSON file
+func readRoster(path string) ([]rosterEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return parseCSVRoster(f)
+	}
+	return parseYAMLRoster(f)
+}
+
+// parseYAMLRoster parses a list of service accounts, JSON documents are valid YAML
+func parseYAMLRoster(r io.Reader) ([]rosterEntry, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []rosterEntry
+	if err = yaml.UnmarshalStrict(data, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// parseCSVRoster parses a CSV file with a header row containing a "name" and an optional "description" column
+func parseCSVRoster(r io.Reader) ([]rosterEntry, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	nameCol, descriptionCol := -1, -1
+	for i, column := range records[0] {
+		switch strings.ToLower(strings.TrimSpace(column)) {
+		case "name":
+			nameCol = i
+		case "description":
+			descriptionCol = i
+		}
+	}
+	if nameCol < 0 {
+		return nil, fmt.Errorf(`the header row has no "name" column`)
+	}
+
+	entries := make([]rosterEntry, 0, len(records)-1)
+	for _, record := range records[1:] {
+		entry := rosterEntry{Name: record[nameCol]}
+		if descriptionCol >= 0 {
+			entry.Description = record[descriptionCol]
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// bundlePath returns the path of the credentials file of a service account
+func bundlePath(dir string, name string, fileFormat string) string {
+	ext := filepath.Ext(credentials.GetDefaultPath(fileFormat))
+	return filepath.Join(dir, name+ext)
+}
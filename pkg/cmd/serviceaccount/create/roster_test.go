@@ -0,0 +1,71 @@
+package create
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_parseCSVRoster(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []rosterEntry
+		wantErr bool
+	}{
+		{
+			name:  "name and description",
+			input: "description,name\nOrders application,orders-app\n,billing-app\n",
+			want: []rosterEntry{
+				{Name: "orders-app", Description: "Orders application"},
+				{Name: "billing-app"},
+			},
+		},
+		{
+			name:  "name only",
+			input: "Name\norders-app\n",
+			want:  []rosterEntry{{Name: "orders-app"}},
+		},
+		{
+			name:    "missing name column",
+			input:   "description\nOrders application\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCSVRoster(strings.NewReader(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseCSVRoster() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseCSVRoster() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_parseYAMLRoster(t *testing.T) {
+	want := []rosterEntry{
+		{Name: "orders-app", Description: "Orders application"},
+		{Name: "billing-app"},
+	}
+
+	inputs := map[string]string{
+		"yaml": "- name: orders-app\n  description: Orders application\n- name: billing-app\n",
+		"json": `[{"name": "orders-app", "description": "Orders application"}, {"name": "billing-app"}]`,
+	}
+	for format, input := range inputs {
+		got, err := parseYAMLRoster(strings.NewReader(input))
+		if err != nil {
+			t.Fatalf("parseYAMLRoster(%v) error = %v", format, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("parseYAMLRoster(%v) = %v, want %v", format, got, want)
+		}
+	}
+
+	if _, err := parseYAMLRoster(strings.NewReader("- name: orders-app\n  secret: x\n")); err == nil {
+		t.Error("parseYAMLRoster() expected an error for unknown fields")
+	}
+}
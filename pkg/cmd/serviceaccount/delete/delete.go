@@ -3,18 +3,20 @@ package delete
 import (
 	"context"
 
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/svcaccountcmdutil/usage"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/svcaccountcmdutil/validation"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/confirm"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/config"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/connection"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
 
 	svcacctmgmterrors "github.com/redhat-developer/app-services-sdk-go/serviceaccountmgmt/apiv1/error"
 
-	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
 )
 
@@ -26,8 +28,9 @@ type options struct {
 	localizer  localize.Localizer
 	Context    context.Context
 
-	id    string
-	force bool
+	id          string
+	skipConfirm bool
+	force       bool
 }
 
 // NewDeleteCommand creates a new command to delete a service account
@@ -48,8 +51,8 @@ func NewDeleteCommand(f *factory.Factory) *cobra.Command {
 		Example: opts.localizer.MustLocalize("serviceAccount.delete.cmd.example"),
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			if !opts.IO.CanPrompt() && !opts.force {
-				return flagutil.RequiredWhenNonInteractiveError("yes")
+			if err := confirm.ValidateNonInteractive(opts.IO, opts.localizer, opts.skipConfirm); err != nil {
+				return err
 			}
 
 			validator := &validation.Validator{
@@ -66,7 +69,9 @@ func NewDeleteCommand(f *factory.Factory) *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("serviceAccount.delete.flag.id.description"))
-	cmd.Flags().BoolVarP(&opts.force, "yes", "y", false, opts.localizer.MustLocalize("serviceAccount.delete.flag.yes.description"))
+	flags := flagutil.NewFlagSet(cmd, opts.localizer)
+	flags.AddYes(&opts.skipConfirm)
+	flags.BoolVar(&opts.force, "force", false, opts.localizer.MustLocalize("serviceAccount.delete.flag.force.description"))
 
 	_ = cmd.MarkFlagRequired("id")
 
@@ -79,7 +84,7 @@ func runDelete(opts *options) (err error) {
 		return err
 	}
 
-	_, httpRes, err := conn.API().ServiceAccountMgmt().GetServiceAccount(opts.Context, opts.id).Execute()
+	serviceAccount, httpRes, err := conn.API().ServiceAccountMgmt().GetServiceAccount(opts.Context, opts.id).Execute()
 	if httpRes != nil {
 		defer httpRes.Body.Close()
 	}
@@ -93,24 +98,58 @@ func runDelete(opts *options) (err error) {
 		}
 	}
 
-	if !opts.force {
-		var confirmDelete bool
-		promptConfirmDelete := &survey.Confirm{
-			Message: opts.localizer.MustLocalize("serviceAccount.delete.input.confirmDelete.message", localize.NewEntry("ID", opts.id)),
-		}
+	if err = checkUsage(opts, conn, serviceAccount.GetClientId()); err != nil {
+		return err
+	}
 
-		err = survey.AskOne(promptConfirmDelete, &confirmDelete)
-		if err != nil {
+	if !opts.skipConfirm {
+		message := opts.localizer.MustLocalize("serviceAccount.delete.input.confirmName.message",
+			localize.NewEntry("ID", opts.id),
+			localize.NewEntry("Name", serviceAccount.GetName()),
+		)
+		if err = confirm.Name(opts.localizer, message, serviceAccount.GetName()); err != nil {
 			return err
 		}
+	}
+
+	return deleteServiceAccount(opts)
+}
+
+// checkUsage warns about the Kafka ACLs and Service Registry roles which still reference the service account,
+// as applications using it will stop working. Deleting a service account which is in use requires --force.
+func checkUsage(opts *options, conn connection.Connection, clientID string) error {
+	finder := &usage.Finder{
+		Context:    opts.Context,
+		Connection: conn,
+		Logger:     opts.Logger,
+		Localizer:  opts.localizer,
+	}
+	instanceUsage, err := finder.Find(clientID)
+	if err != nil {
+		return err
+	}
+	if len(instanceUsage) == 0 {
+		return nil
+	}
 
-		if !confirmDelete {
-			opts.Logger.Debug(opts.localizer.MustLocalize("serviceAccount.delete.log.debug.deleteNotConfirmed"))
-			return nil
+	opts.Logger.Info(icon.InfoPrefix(), opts.localizer.MustLocalize("serviceAccount.delete.log.info.inUse", localize.NewEntry("ClientID", clientID)))
+	for _, u := range instanceUsage {
+		messageID := "serviceAccount.delete.log.info.kafkaUsage"
+		if u.ServiceType == usage.ServiceTypeRegistry {
+			messageID = "serviceAccount.delete.log.info.registryUsage"
 		}
+		opts.Logger.Info(opts.localizer.MustLocalizePlural(messageID, len(u.Permissions),
+			localize.NewEntry("Name", u.InstanceName),
+			localize.NewEntry("ID", u.InstanceID),
+			localize.NewEntry("Count", len(u.Permissions)),
+		))
 	}
+	opts.Logger.Info()
 
-	return deleteServiceAccount(opts)
+	if !opts.force {
+		return opts.localizer.MustLocalizeError("serviceAccount.delete.error.inUse", localize.NewEntry("ClientID", clientID))
+	}
+	return nil
 }
 
 func deleteServiceAccount(opts *options) error {
@@ -3,12 +3,15 @@ package describe
 import (
 	"context"
 
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount/svcaccountcmdutil/usage"
+
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/config"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	svcacctmgmtclient "github.com/redhat-developer/app-services-sdk-go/serviceaccountmgmt/apiv1/client"
 	svcacctmgmterrors "github.com/redhat-developer/app-services-sdk-go/serviceaccountmgmt/apiv1/error"
 
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
@@ -16,10 +19,17 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// serviceAccountUsage is a service account and the service instances in which it is used
+type serviceAccountUsage struct {
+	ServiceAccount *svcacctmgmtclient.ServiceAccountData `json:"service_account" yaml:"service_account"`
+	Usage          []usage.InstanceUsage                 `json:"usage" yaml:"usage"`
+}
+
 type options struct {
 	id           string
 	outputFormat string
 	enableAuthV2 bool
+	showUsage    bool
 
 	IO         *iostreams.IOStreams
 	Config     config.IConfig
@@ -57,10 +67,18 @@ func NewDescribeCommand(f *factory.Factory) *cobra.Command {
 
 	cmd.Flags().StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("serviceAccount.describe.flag.id.description"))
 	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "json", opts.localizer.MustLocalize("serviceAccount.common.flag.output.description"))
+	cmd.Flags().BoolVar(&opts.showUsage, "show-usage", false, opts.localizer.MustLocalize("serviceAccount.describe.flag.showUsage.description"))
 	cmd.Flags().BoolVar(&opts.enableAuthV2, "enable-auth-v2", false, opts.localizer.MustLocalize("serviceAccount.common.flag.enableAuthV2"))
 
 	_ = cmd.MarkFlagRequired("id")
 
+	flagutil.NewFlagSet(cmd, opts.localizer).AddPrintSchemaFunc(func() interface{} {
+		if opts.showUsage {
+			return serviceAccountUsage{}
+		}
+		return svcacctmgmtclient.ServiceAccountData{}
+	})
+
 	_ = cmd.Flags().MarkDeprecated("enable-auth-v2", opts.localizer.MustLocalize("serviceAccount.common.flag.deprecated.enableAuthV2"))
 
 	flagutil.EnableOutputFlagCompletion(cmd)
@@ -90,5 +108,33 @@ func runDescribe(opts *options) error {
 		}
 	}
 
-	return dump.Formatted(opts.IO.Out, opts.outputFormat, res)
+	if !opts.showUsage {
+		return dump.Formatted(opts.IO.Out, opts.outputFormat, res)
+	}
+
+	finder := &usage.Finder{
+		Context:    opts.Context,
+		Connection: conn,
+		Logger:     opts.Logger,
+		Localizer:  opts.localizer,
+	}
+	instanceUsage, err := finder.Find(res.GetClientId())
+	if err != nil {
+		return err
+	}
+
+	result := serviceAccountUsage{
+		ServiceAccount: &res,
+		Usage:          instanceUsage,
+	}
+
+	if err = dump.Formatted(opts.IO.Out, opts.outputFormat, result); err != nil {
+		return err
+	}
+
+	if len(result.Usage) == 0 {
+		opts.Logger.Info(opts.localizer.MustLocalize("serviceAccount.describe.log.info.notUsed", localize.NewEntry("ClientID", res.GetClientId())))
+	}
+
+	return nil
 }
@@ -57,11 +57,12 @@ func NewListCommand(f *factory.Factory) *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:     "list",
-		Short:   opts.localizer.MustLocalize("serviceAccount.list.cmd.shortDescription"),
-		Long:    opts.localizer.MustLocalize("serviceAccount.list.cmd.longDescription"),
-		Example: opts.localizer.MustLocalize("serviceAccount.list.cmd.example"),
-		Args:    cobra.NoArgs,
+		Use:         "list",
+		Short:       opts.localizer.MustLocalize("serviceAccount.list.cmd.shortDescription"),
+		Long:        opts.localizer.MustLocalize("serviceAccount.list.cmd.longDescription"),
+		Example:     opts.localizer.MustLocalize("serviceAccount.list.cmd.example"),
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{cmdutil.PagerAnnotation: "true"},
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if opts.output != "" && !flagutil.IsValidInput(opts.output, flagutil.ValidOutputFormats...) {
 				return flagutil.InvalidValueError("output", opts.output, flagutil.ValidOutputFormats...)
@@ -92,6 +93,8 @@ func NewListCommand(f *factory.Factory) *cobra.Command {
 	// Default has been set to 100 to preserve how list worked before
 	cmd.Flags().Int32VarP(&opts.size, "size", "", 100, opts.localizer.MustLocalize("serviceAccount.list.flag.size.description"))
 
+	flagutil.NewFlagSet(cmd, opts.localizer).AddPrintSchema([]svcacctmgmtclient.ServiceAccountData{})
+
 	flagutil.EnableOutputFlagCompletion(cmd)
 
 	return cmd
@@ -138,10 +141,10 @@ func mapResponseItemsToRows(svcAccts []svcacctmgmtclient.ServiceAccountData) []s
 	for i, sa := range svcAccts {
 
 		row := svcAcctRow{
-			ID:        sa.GetId(),
-			Name:      sa.GetName(),
-			ClientID:  sa.GetClientId(),
-			Owner:     sa.GetCreatedBy(),
+			ID:        dump.OrPlaceholder(sa.GetId()),
+			Name:      dump.OrPlaceholder(sa.GetName()),
+			ClientID:  dump.OrPlaceholder(sa.GetClientId()),
+			Owner:     dump.OrPlaceholder(sa.GetCreatedBy()),
 			CreatedAt: unixTimestampToUTC(sa.GetCreatedAt()),
 		}
 
@@ -0,0 +1,159 @@
+// Package usage finds the service instances in which a service account is granted permissions
+package usage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/acl/aclcmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/util"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/registrycmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/kafkautil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/serviceregistryutil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/svcstatus"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1/client"
+	srsmgmtv1 "github.com/redhat-developer/app-services-sdk-go/registrymgmt/apiv1/client"
+)
+
+const (
+	ServiceTypeKafka    = "kafka"
+	ServiceTypeRegistry = "service-registry"
+
+	aclPageSize = 100
+)
+
+// InstanceUsage is the access granted to a service account on a service instance
+type InstanceUsage struct {
+	ServiceType  string   `json:"service_type" yaml:"service_type"`
+	InstanceID   string   `json:"instance_id" yaml:"instance_id"`
+	InstanceName string   `json:"instance_name" yaml:"instance_name"`
+	Permissions  []string `json:"permissions" yaml:"permissions"`
+}
+
+// Finder looks up the usage of service accounts in the Kafka and Service Registry instances of the user
+type Finder struct {
+	Context    context.Context
+	Connection connection.Connection
+	Logger     logging.Logger
+	Localizer  localize.Localizer
+}
+
+// Find returns the Kafka and Service Registry instances in which the service account with the given client ID is used
+func (f *Finder) Find(clientID string) ([]InstanceUsage, error) {
+	kafkaUsage, err := f.KafkaUsage(clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	registryUsage, err := f.RegistryUsage(clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(kafkaUsage, registryUsage...), nil
+}
+
+// KafkaUsage returns the Kafka instances which have ACL rules for the service account.
+// Instances which are not ready or whose ACLs cannot be read by the user are skipped.
+func (f *Finder) KafkaUsage(clientID string) ([]InstanceUsage, error) {
+	kafkas, err := kafkautil.ListKafkas(f.Context, f.Connection.API().KafkaMgmt(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	principal := aclcmdutil.FormatPrincipal(clientID)
+	usage := []InstanceUsage{}
+
+	for i := range kafkas {
+		kafka := kafkas[i]
+		if kafka.GetStatus() != svcstatus.StatusReady {
+			continue
+		}
+
+		api, _, err := f.Connection.API().KafkaAdmin(kafka.GetId())
+		if err != nil {
+			f.Logger.Info(f.Localizer.MustLocalize("serviceAccount.common.log.info.kafkaSkipped", localize.NewEntry("Name", kafka.GetName()), localize.NewEntry("Error", err)))
+			continue
+		}
+
+		req := api.AclsApi.GetAcls(f.Context).Principal(principal)
+		bindings, httpRes, err := aclcmdutil.FetchAllACLs(req, aclPageSize)
+		if err = aclcmdutil.ValidateAPIError(httpRes, f.Localizer, err, "list", kafka.GetName()); err != nil {
+			f.Logger.Info(f.Localizer.MustLocalize("serviceAccount.common.log.info.kafkaSkipped", localize.NewEntry("Name", kafka.GetName()), localize.NewEntry("Error", err)))
+			continue
+		}
+
+		// rules for all accounts also match the principal filter, but do not depend on the service account
+		var accountBindings []kafkainstanceclient.AclBinding
+		for _, binding := range bindings {
+			if binding.GetPrincipal() == principal {
+				accountBindings = append(accountBindings, binding)
+			}
+		}
+		if len(accountBindings) == 0 {
+			continue
+		}
+
+		permissions := make([]string, 0, len(accountBindings))
+		for _, row := range aclcmdutil.MapACLsToTableRows(accountBindings, f.Localizer) {
+			permissions = append(permissions, fmt.Sprintf("%s %s %s", row.Permission, row.Operation, row.Description))
+		}
+
+		usage = append(usage, InstanceUsage{
+			ServiceType:  ServiceTypeKafka,
+			InstanceID:   kafka.GetId(),
+			InstanceName: kafka.GetName(),
+			Permissions:  permissions,
+		})
+	}
+
+	return usage, nil
+}
+
+// RegistryUsage returns the Service Registry instances which have a role mapping for the service account.
+// Instances which are not ready or whose role mappings cannot be read by the user are skipped.
+func (f *Finder) RegistryUsage(clientID string) ([]InstanceUsage, error) {
+	registries, err := serviceregistryutil.ListServiceRegistries(f.Context, f.Connection.API().ServiceRegistryMgmt(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	usage := []InstanceUsage{}
+
+	for i := range registries {
+		registry := registries[i]
+		if registry.GetStatus() != srsmgmtv1.REGISTRYSTATUSVALUE_READY {
+			continue
+		}
+
+		api, _, err := f.Connection.API().ServiceRegistryInstance(registry.GetId())
+		if err != nil {
+			f.Logger.Info(f.Localizer.MustLocalize("serviceAccount.common.log.info.registrySkipped", localize.NewEntry("Name", registry.GetName()), localize.NewEntry("Error", err)))
+			continue
+		}
+
+		mappings, _, err := api.AdminApi.ListRoleMappings(f.Context).Execute()
+		if err != nil {
+			err = registrycmdutil.TransformInstanceError(err)
+			f.Logger.Info(f.Localizer.MustLocalize("serviceAccount.common.log.info.registrySkipped", localize.NewEntry("Name", registry.GetName()), localize.NewEntry("Error", err)))
+			continue
+		}
+
+		for _, mapping := range mappings {
+			if mapping.GetPrincipalId() != clientID {
+				continue
+			}
+			usage = append(usage, InstanceUsage{
+				ServiceType:  ServiceTypeRegistry,
+				InstanceID:   registry.GetId(),
+				InstanceName: registry.GetName(),
+				Permissions:  []string{util.GetRoleLabel(mapping.GetRole())},
+			})
+		}
+	}
+
+	return usage, nil
+}
@@ -0,0 +1,186 @@
+package setup
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+
+	contextcreate "github.com/redhat-developer/app-services-cli/pkg/cmd/context/create"
+	kafkacreate "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/create"
+	kafkause "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/use"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/login"
+	registrycreate "github.com/redhat-developer/app-services-cli/pkg/cmd/registry/create"
+	registryuse "github.com/redhat-developer/app-services-cli/pkg/cmd/registry/use"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+)
+
+// Choices offered for each service
+const (
+	choiceUse = iota
+	choiceCreate
+	choiceSkip
+)
+
+type options struct {
+	f *factory.Factory
+}
+
+// NewSetupCommand creates a new command to set up the CLI with a guided flow
+func NewSetupCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		f: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "setup",
+		Short:   f.Localizer.MustLocalize("setup.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("setup.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("setup.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !f.IOStreams.CanPrompt() {
+				return f.Localizer.MustLocalizeError("setup.error.nonInteractive")
+			}
+
+			return runSetup(opts)
+		},
+	}
+
+	return cmd
+}
+
+// Offer asks whether to run the guided setup, for use on the first run of the CLI
+func Offer(f *factory.Factory) (bool, error) {
+	f.Logger.Info(f.Localizer.MustLocalize("setup.log.info.welcome"))
+
+	prompt := &survey.Confirm{
+		Message: f.Localizer.MustLocalize("setup.input.offer.message"),
+		Default: true,
+	}
+
+	var accepted bool
+	if err := survey.AskOne(prompt, &accepted); err != nil {
+		return false, err
+	}
+
+	if !accepted {
+		f.Logger.Info(f.Localizer.MustLocalize("setup.log.info.declined"))
+	}
+
+	return accepted, nil
+}
+
+func runSetup(opts *options) error {
+	f := opts.f
+
+	if err := setupLogin(f); err != nil {
+		return err
+	}
+
+	if err := setupContext(f); err != nil {
+		return err
+	}
+
+	if err := setupService(f, "setup.input.kafka.message", kafkause.NewUseCommand(f), kafkacreate.NewCreateCommand(f)); err != nil {
+		return err
+	}
+
+	if err := setupService(f, "setup.input.registry.message", registryuse.NewUseCommand(f), registrycreate.NewCreateCommand(f)); err != nil {
+		return err
+	}
+
+	f.Logger.Info()
+	f.Logger.Info(icon.SuccessPrefix(), f.Localizer.MustLocalize("setup.log.info.done"))
+	f.Logger.Info(f.Localizer.MustLocalize("setup.log.info.nextSteps"))
+
+	return nil
+}
+
+// setupLogin logs in unless the user is already logged in
+func setupLogin(f *factory.Factory) error {
+	cfg, err := f.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	if cfg.AccessToken != "" || cfg.RefreshToken != "" {
+		f.Logger.Info(icon.SuccessPrefix(), f.Localizer.MustLocalize("setup.log.info.loggedIn"))
+		return nil
+	}
+
+	f.Logger.Info(f.Localizer.MustLocalize("setup.log.info.login"))
+
+	return execute(f, login.NewLoginCmd(f))
+}
+
+// setupContext selects the context in which the services are stored,
+// creating it when it does not exist
+func setupContext(f *factory.Factory) error {
+	svcContext, err := f.ServiceContext.Load()
+	if err != nil {
+		return err
+	}
+
+	prompt := &survey.Input{
+		Message: f.Localizer.MustLocalize("setup.input.context.message"),
+		Help:    f.Localizer.MustLocalize("setup.input.context.help"),
+		Default: svcContext.CurrentContext,
+	}
+
+	var name string
+	if err = survey.AskOne(prompt, &name, survey.WithValidator(survey.Required)); err != nil {
+		return err
+	}
+
+	if _, ok := svcContext.Contexts[name]; !ok {
+		return execute(f, contextcreate.NewCreateCommand(f), "--name", name)
+	}
+
+	if svcContext.CurrentContext != name {
+		svcContext.CurrentContext = name
+		if err = f.ServiceContext.Save(svcContext); err != nil {
+			return err
+		}
+	}
+
+	f.Logger.Info(icon.SuccessPrefix(), f.Localizer.MustLocalize("setup.log.info.usingContext", localize.NewEntry("Name", name)))
+
+	return nil
+}
+
+// setupService asks whether to use an existing instance of a service or to create a new one,
+// and runs the matching command which prompts for the details
+func setupService(f *factory.Factory, messageID string, useCmd *cobra.Command, createCmd *cobra.Command) error {
+	prompt := &survey.Select{
+		Message: f.Localizer.MustLocalize(messageID),
+		Options: []string{
+			f.Localizer.MustLocalize("setup.input.option.use"),
+			f.Localizer.MustLocalize("setup.input.option.create"),
+			f.Localizer.MustLocalize("setup.input.option.skip"),
+		},
+	}
+
+	var choice int
+	if err := survey.AskOne(prompt, &choice); err != nil {
+		return err
+	}
+
+	switch choice {
+	case choiceUse:
+		return execute(f, useCmd)
+	case choiceCreate:
+		return execute(f, createCmd)
+	default:
+		return nil
+	}
+}
+
+// execute runs a command of the CLI as a step of the setup
+func execute(f *factory.Factory, cmd *cobra.Command, args ...string) error {
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+	cmd.SetArgs(append([]string{}, args...))
+
+	return cmd.ExecuteContext(f.Context)
+}
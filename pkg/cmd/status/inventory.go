@@ -0,0 +1,211 @@
+package status
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/kafkautil"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/serviceregistryutil"
+	connectormgmtclient "github.com/redhat-developer/app-services-sdk-go/connectormgmt/apiv1/client"
+
+	"github.com/openconfig/goyang/pkg/indent"
+)
+
+// inventoryPageSize is the page size used to list connectors and service accounts
+const inventoryPageSize = 100
+
+// inventory is a summary of all the application services instances of the organization
+type inventory struct {
+	Kafka           *kafkaInventory     `json:"kafka,omitempty" yaml:"kafka,omitempty"`
+	Registry        *registryInventory  `json:"registry,omitempty" yaml:"registry,omitempty"`
+	Connector       *connectorInventory `json:"connector,omitempty" yaml:"connector,omitempty"`
+	ServiceAccounts *int                `json:"service_accounts,omitempty" yaml:"service_accounts,omitempty"`
+}
+
+type kafkaInventory struct {
+	Total    int            `json:"total" yaml:"total"`
+	ByStatus map[string]int `json:"by_status" yaml:"by_status"`
+	ByRegion map[string]int `json:"by_region" yaml:"by_region"`
+}
+
+type registryInventory struct {
+	Total    int            `json:"total" yaml:"total"`
+	ByStatus map[string]int `json:"by_status" yaml:"by_status"`
+}
+
+type connectorInventory struct {
+	Total   int            `json:"total" yaml:"total"`
+	ByState map[string]int `json:"by_state" yaml:"by_state"`
+}
+
+// buildInventory counts the instances of each service visible to the user.
+// A service which cannot be listed is left out of the inventory.
+func buildInventory(f *factory.Factory) (*inventory, error) {
+	conn, err := f.Connection()
+	if err != nil {
+		return nil, err
+	}
+
+	api := conn.API()
+	inv := &inventory{}
+	warn := func(service string, err error) {
+		f.Logger.Info(f.Localizer.MustLocalize("status.log.info.inventoryUnavailable",
+			localize.NewEntry("Service", service),
+			localize.NewEntry("Error", err),
+		))
+	}
+
+	if kafkas, err := kafkautil.ListKafkas(f.Context, api.KafkaMgmt(), ""); err != nil {
+		warn("Kafka", err)
+	} else {
+		inv.Kafka = &kafkaInventory{Total: len(kafkas), ByStatus: map[string]int{}, ByRegion: map[string]int{}}
+		for i := range kafkas {
+			inv.Kafka.ByStatus[kafkas[i].GetStatus()]++
+			inv.Kafka.ByRegion[kafkas[i].GetCloudProvider()+"/"+kafkas[i].GetRegion()]++
+		}
+	}
+
+	if registries, err := serviceregistryutil.ListServiceRegistries(f.Context, api.ServiceRegistryMgmt(), ""); err != nil {
+		warn("Service Registry", err)
+	} else {
+		inv.Registry = &registryInventory{Total: len(registries), ByStatus: map[string]int{}}
+		for i := range registries {
+			inv.Registry.ByStatus[string(registries[i].GetStatus())]++
+		}
+	}
+
+	if connectors, err := listConnectors(f, api.ConnectorsMgmt().ConnectorsApi); err != nil {
+		warn("Connectors", err)
+	} else {
+		inv.Connector = &connectorInventory{Total: len(connectors), ByState: map[string]int{}}
+		for i := range connectors {
+			status := connectors[i].GetStatus()
+			inv.Connector.ByState[string(status.GetState())]++
+		}
+	}
+
+	if count, err := countServiceAccounts(f); err != nil {
+		warn("Service Accounts", err)
+	} else {
+		inv.ServiceAccounts = &count
+	}
+
+	return inv, nil
+}
+
+// listConnectors returns all connectors visible to the user
+func listConnectors(f *factory.Factory, api connectormgmtclient.ConnectorsApi) ([]connectormgmtclient.Connector, error) {
+	var connectors []connectormgmtclient.Connector
+	for page := 1; ; page++ {
+		list, httpRes, err := api.ListConnectors(f.Context).
+			Page(strconv.Itoa(page)).
+			Size(strconv.Itoa(inventoryPageSize)).
+			Execute()
+		if httpRes != nil {
+			httpRes.Body.Close()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		connectors = append(connectors, list.GetItems()...)
+		if len(list.GetItems()) < inventoryPageSize || len(connectors) >= int(list.GetTotal()) {
+			return connectors, nil
+		}
+	}
+}
+
+// countServiceAccounts returns the number of service accounts visible to the user
+func countServiceAccounts(f *factory.Factory) (int, error) {
+	conn, err := f.Connection()
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for first := int32(0); ; first += inventoryPageSize {
+		serviceAccounts, httpRes, err := conn.API().ServiceAccountMgmt().GetServiceAccounts(f.Context).
+			First(first).
+			Max(inventoryPageSize).
+			Execute()
+		if httpRes != nil {
+			httpRes.Body.Close()
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		count += len(serviceAccounts)
+		if len(serviceAccounts) < inventoryPageSize {
+			return count, nil
+		}
+	}
+}
+
+// printInventory prints the inventory of the organization
+func printInventory(w io.Writer, inv *inventory) {
+	if inv.Kafka != nil {
+		printInventorySection(w, "Kafka", inv.Kafka.Total, map[string]map[string]int{
+			"Status": inv.Kafka.ByStatus,
+			"Region": inv.Kafka.ByRegion,
+		})
+	}
+	if inv.Registry != nil {
+		printInventorySection(w, "Service Registry", inv.Registry.Total, map[string]map[string]int{
+			"Status": inv.Registry.ByStatus,
+		})
+	}
+	if inv.Connector != nil {
+		printInventorySection(w, "Connector", inv.Connector.Total, map[string]map[string]int{
+			"State": inv.Connector.ByState,
+		})
+	}
+	if inv.ServiceAccounts != nil {
+		printInventorySection(w, "Service Accounts", *inv.ServiceAccounts, nil)
+	}
+}
+
+// print the total and the counts of each group of a service
+func printInventorySection(w io.Writer, name string, total int, groups map[string]map[string]int) {
+	indentWriter := indent.NewWriter(w, "  ")
+
+	padding := 5
+	tw := tabwriter.NewWriter(indentWriter, 0, 0, padding, ' ', tabwriter.TabIndent)
+
+	maxRowLen, _ := fmt.Fprintf(tw, "%v:\t\t%v\n", "Total", total)
+
+	groupNames := make([]string, 0, len(groups))
+	for groupName := range groups {
+		groupNames = append(groupNames, groupName)
+	}
+	sort.Strings(groupNames)
+
+	for _, groupName := range groupNames {
+		for _, key := range sortedKeys(groups[groupName]) {
+			charLen, _ := fmt.Fprintf(tw, "%v %v:\t\t%v\n", groupName, key, groups[groupName][key])
+			if charLen > maxRowLen {
+				maxRowLen = charLen
+			}
+		}
+	}
+
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(indentWriter, name)
+	fmt.Fprintln(indentWriter, createDivider(maxRowLen+padding))
+
+	tw.Flush()
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -18,6 +18,7 @@ type options struct {
 	outputFormat string
 	name         string
 	services     []string
+	all          bool
 }
 
 func NewStatusCommand(f *factory.Factory) *cobra.Command {
@@ -33,6 +34,18 @@ func NewStatusCommand(f *factory.Factory) *cobra.Command {
 		ValidArgs: servicespec.AllServiceLabels,
 		Args:      cobra.RangeArgs(0, len(servicespec.AllServiceLabels)),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			validOutputFormats := flagutil.ValidOutputFormats
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, validOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, validOutputFormats...)
+			}
+
+			if opts.all {
+				if len(args) > 0 || opts.name != "" {
+					return f.Localizer.MustLocalizeError("status.error.allCannotBeCombined")
+				}
+				return runInventory(opts)
+			}
+
 			if len(args) > 0 {
 				for _, s := range args {
 					if !flagutil.IsValidInput(s, servicespec.AllServiceLabels...) {
@@ -45,11 +58,6 @@ func NewStatusCommand(f *factory.Factory) *cobra.Command {
 				opts.services = servicespec.AllServiceLabels
 			}
 
-			validOutputFormats := flagutil.ValidOutputFormats
-			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, validOutputFormats...) {
-				return flagutil.InvalidValueError("output", opts.outputFormat, validOutputFormats...)
-			}
-
 			return runStatus(opts)
 		},
 	}
@@ -57,6 +65,7 @@ func NewStatusCommand(f *factory.Factory) *cobra.Command {
 	flags := flagutil.NewFlagSet(cmd, f.Localizer)
 
 	flags.StringVar(&opts.name, "name", "", f.Localizer.MustLocalize("context.common.flag.name"))
+	flags.BoolVar(&opts.all, "all", false, f.Localizer.MustLocalize("status.flag.all.description"))
 	flags.AddOutput(&opts.outputFormat)
 
 	flagutil.EnableOutputFlagCompletion(cmd)
@@ -119,3 +128,19 @@ func runStatus(opts *options) error {
 
 	return nil
 }
+
+func runInventory(opts *options) error {
+	inv, err := buildInventory(opts.f)
+	if err != nil {
+		return err
+	}
+
+	stdout := opts.f.IOStreams.Out
+	if opts.outputFormat != "" {
+		return dump.Formatted(stdout, opts.outputFormat, inv)
+	}
+
+	printInventory(stdout, inv)
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"text/tabwriter"
 
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/color"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/contextutil"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/servicespec"
@@ -241,8 +242,14 @@ func printServiceStatus(w io.Writer, name string, v reflect.Value) {
 		title := getTitle(&fieldType)
 
 		if !getOmitEmpty(&fieldType) || !fieldValue.IsZero() {
-			// print the row and take note of its character length
-			charLen, _ := fmt.Fprintf(tw, "%v:\t\t%v\n", title, fieldValue)
+			value := fmt.Sprint(fieldValue)
+			printed := value
+			if fieldType.Name == "Status" {
+				printed = color.Status(value)
+			}
+			// print the row and take note of its character length, without the color codes
+			charLen, _ := fmt.Fprintf(tw, "%v:\t\t%v\n", title, printed)
+			charLen -= len(printed) - len(value)
 			if charLen > maxRowLen {
 				maxRowLen = charLen
 			}
@@ -0,0 +1,89 @@
+package show
+
+import (
+	"os"
+
+	"github.com/redhat-developer/app-services-cli/internal/telemetry"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	Config    config.IConfig
+	IO        *iostreams.IOStreams
+	Logger    logging.Logger
+	localizer localize.Localizer
+
+	outputFormat string
+}
+
+// NewShowCommand creates a new command to print the telemetry events sent by the CLI
+func NewShowCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		Config:    f.Config,
+		IO:        f.IOStreams,
+		Logger:    f.Logger,
+		localizer: f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:         "show",
+		Short:       f.Localizer.MustLocalize("telemetry.show.cmd.shortDescription"),
+		Long:        f.Localizer.MustLocalize("telemetry.show.cmd.longDescription"),
+		Example:     f.Localizer.MustLocalize("telemetry.show.cmd.example"),
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{cmdutil.OfflineAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			return runShow(opts)
+		},
+	}
+
+	flags := flagutil.NewFlagSet(cmd, f.Localizer)
+	flags.AddOutput(&opts.outputFormat)
+
+	return cmd
+}
+
+func runShow(opts *options) error {
+	cfg, err := opts.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case os.Getenv(telemetry.ControlTelemetryEnv) != "":
+		opts.Logger.Info(opts.localizer.MustLocalize("telemetry.show.log.info.env", localize.NewEntry("Env", telemetry.ControlTelemetryEnv), localize.NewEntry("Value", os.Getenv(telemetry.ControlTelemetryEnv))))
+	case cfg.Telemetry == telemetry.ConfigEnabled:
+		opts.Logger.Info(opts.localizer.MustLocalize("telemetry.show.log.info.enabled"))
+	default:
+		opts.Logger.Info(opts.localizer.MustLocalize("telemetry.show.log.info.disabled"))
+	}
+
+	path, err := telemetry.EventsFilePath(opts.Config)
+	if err != nil {
+		return err
+	}
+
+	events, err := telemetry.LoadEvents(path)
+	if err != nil {
+		return err
+	}
+
+	if len(events) == 0 && opts.outputFormat == dump.EmptyFormat {
+		opts.Logger.Info(opts.localizer.MustLocalize("telemetry.show.log.info.noEvents"))
+		return nil
+	}
+
+	return dump.Formatted(opts.IO.Out, opts.outputFormat, events)
+}
@@ -0,0 +1,22 @@
+package telemetry
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/telemetry/show"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+)
+
+// NewTelemetryCommand creates a new command to inspect the usage data sent by the CLI
+func NewTelemetryCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "telemetry",
+		Short:   f.Localizer.MustLocalize("telemetry.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("telemetry.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("telemetry.cmd.example"),
+		Args:    cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(show.NewShowCommand(f))
+
+	return cmd
+}
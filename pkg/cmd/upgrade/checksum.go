@@ -0,0 +1,65 @@
+package upgrade
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// checksumsFile is the name of the checksum file published with each release
+const checksumsFile = "checksums.txt"
+
+// checksumsURL returns the URL of the checksum file of the release the asset belongs to
+func checksumsURL(assetURL string) string {
+	return assetURL[:strings.LastIndex(assetURL, "/")+1] + checksumsFile
+}
+
+// verifyChecksum checks the SHA256 sum of the asset against its entry in the checksum file.
+// The checksum file has one "<sha256>  <file name>" entry per line.
+func verifyChecksum(checksums []byte, assetName string, asset []byte) error {
+	scanner := bufio.NewScanner(bytes.NewReader(checksums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || strings.TrimPrefix(fields[1], "*") != assetName {
+			continue
+		}
+
+		sum := sha256.Sum256(asset)
+		if !strings.EqualFold(fields[0], hex.EncodeToString(sum[:])) {
+			return fmt.Errorf("checksum of %q does not match the published checksum", assetName)
+		}
+		return nil
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	return fmt.Errorf("no checksum was published for %q", assetName)
+}
+
+// download reads the file at the given URL
+func download(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/octet-stream")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("downloading %s failed with status %d", url, res.StatusCode)
+	}
+
+	return io.ReadAll(res.Body)
+}
@@ -0,0 +1,68 @@
+package upgrade
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+)
+
+func Test_verifyChecksum(t *testing.T) {
+	asset := []byte("rhoas binary")
+	sum := sha256.Sum256(asset)
+	assetSum := hex.EncodeToString(sum[:])
+
+	checksums := []byte(
+		"0000000000000000000000000000000000000000000000000000000000000000  rhoas_0.40.0_linux_arm64.tar.gz\n" +
+			assetSum + "  rhoas_0.40.0_linux_amd64.tar.gz\n",
+	)
+
+	tests := []struct {
+		name      string
+		checksums []byte
+		assetName string
+		asset     []byte
+		wantErr   bool
+	}{
+		{
+			name:      "matching checksum",
+			checksums: checksums,
+			assetName: "rhoas_0.40.0_linux_amd64.tar.gz",
+			asset:     asset,
+		},
+		{
+			name:      "binary mode marker",
+			checksums: []byte(assetSum + " *rhoas_0.40.0_linux_amd64.tar.gz\n"),
+			assetName: "rhoas_0.40.0_linux_amd64.tar.gz",
+			asset:     asset,
+		},
+		{
+			name:      "modified asset",
+			checksums: checksums,
+			assetName: "rhoas_0.40.0_linux_amd64.tar.gz",
+			asset:     []byte("tampered binary"),
+			wantErr:   true,
+		},
+		{
+			name:      "missing entry",
+			checksums: checksums,
+			assetName: "rhoas_0.40.0_windows_amd64.zip",
+			asset:     asset,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := verifyChecksum(tt.checksums, tt.assetName, tt.asset); (err != nil) != tt.wantErr {
+				t.Errorf("verifyChecksum() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_checksumsURL(t *testing.T) {
+	got := checksumsURL("https://github.com/redhat-developer/app-services-cli/releases/download/v0.40.0/rhoas_0.40.0_linux_amd64.tar.gz")
+	want := "https://github.com/redhat-developer/app-services-cli/releases/download/v0.40.0/checksums.txt"
+	if got != want {
+		t.Errorf("checksumsURL() = %v, want %v", got, want)
+	}
+}
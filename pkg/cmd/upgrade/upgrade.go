@@ -0,0 +1,121 @@
+package upgrade
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/inconshreveable/go-update"
+	"github.com/redhat-developer/app-services-cli/internal/build"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/color"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/spf13/cobra"
+	"github.com/wtrocki/go-github-selfupdate/selfupdate"
+)
+
+type options struct {
+	Logger    logging.Logger
+	localizer localize.Localizer
+	Context   context.Context
+
+	checkOnly bool
+}
+
+// NewUpgradeCommand creates a command which replaces the CLI binary with the latest release
+func NewUpgradeCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		Logger:    f.Logger,
+		localizer: f.Localizer,
+		Context:   f.Context,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "upgrade",
+		Short:   f.Localizer.MustLocalize("upgrade.cmd.shortDescription"),
+		Long:    f.Localizer.MustLocalize("upgrade.cmd.longDescription"),
+		Example: f.Localizer.MustLocalize("upgrade.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runUpgrade(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.checkOnly, "check-only", false, f.Localizer.MustLocalize("upgrade.flag.checkOnly.description"))
+
+	return cmd
+}
+
+func runUpgrade(opts *options) error {
+	if build.IsDevBuild() {
+		return opts.localizer.MustLocalizeError("upgrade.error.devBuild")
+	}
+
+	release, found, err := cmdutil.LatestRelease()
+	if err != nil {
+		return opts.localizer.MustLocalizeError("upgrade.error.checkFailed", localize.NewEntry("Error", err))
+	}
+
+	if !found || !cmdutil.IsNewerRelease(release, build.Version) {
+		opts.Logger.Info(opts.localizer.MustLocalize("upgrade.log.info.upToDate", localize.NewEntry("Version", build.Version)))
+		return nil
+	}
+
+	opts.Logger.Info(opts.localizer.MustLocalize("upgrade.log.info.available",
+		localize.NewEntry("Version", color.CodeSnippet(release.Version.String())),
+		localize.NewEntry("CurrentVersion", build.Version)))
+	opts.Logger.Info(color.Info(release.URL))
+
+	if opts.checkOnly {
+		return nil
+	}
+
+	// binaries from other sources, such as package managers, are upgraded by those package managers
+	if build.BuildSource != string(build.GithubBuildSource) {
+		return opts.localizer.MustLocalizeError("upgrade.error.notGithubBuild")
+	}
+
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	if executable, err = filepath.EvalSymlinks(executable); err != nil {
+		return err
+	}
+
+	if err = replaceExecutable(opts.Context, release, executable); err != nil {
+		return opts.localizer.MustLocalizeError("upgrade.error.upgradeFailed", localize.NewEntry("Error", err))
+	}
+
+	opts.Logger.Info(opts.localizer.MustLocalize("upgrade.log.info.success", localize.NewEntry("Version", release.Version.String())))
+	return nil
+}
+
+// replaceExecutable downloads the release archive, verifies it against the published
+// checksums and replaces the executable with the binary from the archive
+func replaceExecutable(ctx context.Context, release *selfupdate.Release, executable string) error {
+	asset, err := download(ctx, release.AssetURL)
+	if err != nil {
+		return err
+	}
+
+	checksums, err := download(ctx, checksumsURL(release.AssetURL))
+	if err != nil {
+		return err
+	}
+
+	if err = verifyChecksum(checksums, path.Base(release.AssetURL), asset); err != nil {
+		return err
+	}
+
+	binary, err := selfupdate.UncompressCommand(bytes.NewReader(asset), release.AssetURL, filepath.Base(executable))
+	if err != nil {
+		return err
+	}
+
+	return update.Apply(binary, update.Options{TargetPath: executable})
+}
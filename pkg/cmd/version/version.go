@@ -6,6 +6,7 @@ import (
 
 	"github.com/redhat-developer/app-services-cli/internal/build"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
@@ -18,6 +19,9 @@ type options struct {
 	Logger    logging.Logger
 	localizer localize.Localizer
 	Context   context.Context
+
+	buildInfo    bool
+	outputFormat string
 }
 
 func NewVersionCmd(f *factory.Factory) *cobra.Command {
@@ -29,15 +33,30 @@ func NewVersionCmd(f *factory.Factory) *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:    "version",
-		Short:  opts.localizer.MustLocalize("version.cmd.shortDescription"),
-		Hidden: true,
-		Args:   cobra.NoArgs,
+		Use:     "version",
+		Short:   opts.localizer.MustLocalize("version.cmd.shortDescription"),
+		Example: opts.localizer.MustLocalize("version.cmd.example"),
+		Hidden:  true,
+		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			if opts.outputFormat != "" && !opts.buildInfo {
+				return opts.localizer.MustLocalizeError("version.error.outputRequiresBuildInfo")
+			}
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flagutil.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			if opts.buildInfo {
+				return printBuildInfo(opts)
+			}
 			return runCmd(opts)
 		},
 	}
 
+	flags := flagutil.NewFlagSet(cmd, opts.localizer)
+	flags.BoolVar(&opts.buildInfo, "build-info", false, opts.localizer.MustLocalize("version.flag.buildInfo.description"))
+	flags.AddOutput(&opts.outputFormat)
+
 	return cmd
 }
 
@@ -51,3 +70,24 @@ func runCmd(opts *options) (err error) {
 	}
 	return nil
 }
+
+// printBuildInfo prints the metadata of the binary, it does not check for updates so that the output can be parsed
+func printBuildInfo(opts *options) error {
+	info := build.GetInfo()
+
+	if opts.outputFormat != "" {
+		return dump.Formatted(opts.IO.Out, opts.outputFormat, info)
+	}
+
+	fmt.Fprintln(opts.IO.Out, opts.localizer.MustLocalize("version.buildInfo.outputText",
+		localize.NewEntry("Version", info.Version),
+		localize.NewEntry("Commit", dump.OrPlaceholder(info.Commit)),
+		localize.NewEntry("Date", dump.OrPlaceholder(info.Date)),
+		localize.NewEntry("GoVersion", info.GoVersion),
+		localize.NewEntry("OS", info.OS),
+		localize.NewEntry("Arch", info.Arch),
+		localize.NewEntry("CGOEnabled", info.CGOEnabled),
+		localize.NewEntry("BuildSource", info.BuildSource),
+	))
+	return nil
+}
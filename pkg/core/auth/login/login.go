@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	"github.com/redhat-developer/app-services-cli/internal/build"
 	"github.com/redhat-developer/app-services-cli/pkg/core/auth/pkce"
@@ -126,7 +128,7 @@ func (a *AuthorizationCodeGrant) loginSSO(ctx context.Context, cfg *SSOConfig) e
 	a.openBrowser(authCodeURL, redirectURL)
 
 	// start the local server
-	a.startServer(clientCtx, &server)
+	a.startServer(clientCtx, &server, redirectURLPort)
 
 	return nil
 }
@@ -145,11 +147,26 @@ func (a *AuthorizationCodeGrant) openBrowser(authCodeURL string, redirectURL *ur
 	}
 }
 
-// starts the local HTTP webserver to handle redirect from the Auth server
-func (a *AuthorizationCodeGrant) startServer(ctx context.Context, server *http.Server) {
+// starts the local HTTP webserver to handle redirect from the Auth server.
+// Browsers may resolve localhost to either the IPv4 or the IPv6 loopback address,
+// for example on Windows and in WSL, so the server listens on both when IPv6 is available.
+func (a *AuthorizationCodeGrant) startServer(ctx context.Context, server *http.Server, port int) {
+	listener, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+	if err != nil {
+		log.Fatal(err)
+	}
 	go func() {
-		log.Fatal(server.ListenAndServe())
+		log.Fatal(server.Serve(listener))
 	}()
+
+	if listener6, err := net.Listen("tcp", net.JoinHostPort("::1", strconv.Itoa(port))); err == nil {
+		go func() {
+			log.Fatal(server.Serve(listener6))
+		}()
+	} else {
+		a.Logger.Debug("Not listening on the IPv6 loopback address:", err)
+	}
+
 	<-ctx.Done()
 }
 
@@ -1,21 +1,87 @@
 package browser
 
 import (
+	"errors"
 	"fmt"
+	"os"
 	"os/exec"
 	"runtime"
+	"strings"
 )
 
 // Open opens the URL in the default browser
 func Open(url string) error {
 	switch runtime.GOOS {
 	case "linux":
+		if browser := os.Getenv("BROWSER"); browser != "" {
+			return exec.Command(browser, url).Run()
+		}
+		if isWSL() {
+			// the browser runs on the Windows host, which is reached through the WSL interop
+			return runFirst(wslLaunchers(url))
+		}
 		return exec.Command("xdg-open", url).Run()
 	case "windows":
-		return exec.Command("rundll32", "url.dll,FileProtocolHandler", url).Run()
+		return runFirst(windowsLaunchers(url))
 	case "darwin":
 		return exec.Command("open", url).Run()
 	default:
 		return fmt.Errorf("unsupported operating system: %v", runtime.GOOS)
 	}
 }
+
+func wslLaunchers(url string) [][]string {
+	return append([][]string{{"wslview", url}}, withExe(windowsLaunchers(url))...)
+}
+
+func windowsLaunchers(url string) [][]string {
+	return [][]string{
+		{"rundll32", "url.dll,FileProtocolHandler", url},
+		{"powershell", "-NoProfile", "-NonInteractive", "-Command", "Start-Process " + powershellQuote(url)},
+	}
+}
+
+// withExe adds the .exe extension to the Windows launchers, which is required to run them from WSL
+func withExe(launchers [][]string) [][]string {
+	for _, launcher := range launchers {
+		launcher[0] += ".exe"
+	}
+	return launchers
+}
+
+// runFirst runs the first of the commands which is installed and succeeds
+func runFirst(commands [][]string) error {
+	err := errors.New("no program to open the browser was found")
+	for _, command := range commands {
+		path, lookErr := exec.LookPath(command[0])
+		if lookErr != nil {
+			continue
+		}
+		// #nosec G204
+		if err = exec.Command(path, command[1:]...).Run(); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+func powershellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// isWSL returns true when running in the Windows Subsystem for Linux
+func isWSL() bool {
+	if os.Getenv("WSL_DISTRO_NAME") != "" {
+		return true
+	}
+	release, err := os.ReadFile("/proc/sys/kernel/osrelease")
+	if err != nil {
+		return false
+	}
+	return isWSLRelease(string(release))
+}
+
+// isWSLRelease returns true for the kernel releases of WSL 1 ("4.4.0-19041-Microsoft") and WSL 2 ("5.15.90.1-microsoft-standard-WSL2")
+func isWSLRelease(release string) bool {
+	return strings.Contains(strings.ToLower(release), "microsoft")
+}
@@ -0,0 +1,35 @@
+package browser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsWSLRelease(t *testing.T) {
+	tests := []struct {
+		release string
+		want    bool
+	}{
+		{release: "4.4.0-19041-Microsoft\n", want: true},
+		{release: "5.15.90.1-microsoft-standard-WSL2\n", want: true},
+		{release: "6.2.9-300.fc38.x86_64\n", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.release, func(t *testing.T) {
+			if got := isWSLRelease(tt.release); got != tt.want {
+				t.Errorf("isWSLRelease() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWSLLaunchers(t *testing.T) {
+	want := [][]string{
+		{"wslview", "http://localhost:1234"},
+		{"rundll32.exe", "url.dll,FileProtocolHandler", "http://localhost:1234"},
+		{"powershell.exe", "-NoProfile", "-NonInteractive", "-Command", "Start-Process 'http://localhost:1234'"},
+	}
+	if got := wslLaunchers("http://localhost:1234"); !reflect.DeepEqual(got, want) {
+		t.Errorf("wslLaunchers() = %v, want %v", got, want)
+	}
+}
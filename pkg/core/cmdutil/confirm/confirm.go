@@ -0,0 +1,49 @@
+// Package confirm contains the confirmation prompts shared by destructive commands
+package confirm
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+)
+
+// EnvYes is the environment variable which skips all confirmation prompts when set to true
+const EnvYes = "RHOAS_YES"
+
+// DefaultYes returns the default value of the "--yes" flag, which is enabled by setting RHOAS_YES
+func DefaultYes() bool {
+	yes, err := strconv.ParseBool(os.Getenv(EnvYes))
+	return err == nil && yes
+}
+
+// ValidateNonInteractive returns an error when the action has not been confirmed
+// with "--yes" and the user cannot be prompted for confirmation
+func ValidateNonInteractive(io *iostreams.IOStreams, localizer localize.Localizer, yes bool) error {
+	if yes || io.CanPrompt() {
+		return nil
+	}
+
+	return localizer.MustLocalizeError("confirm.error.requiredWhenNonInteractive", localize.NewEntry("EnvYes", EnvYes))
+}
+
+// Name asks the user to type the name of the resource they are about to delete.
+// An error is returned when the typed name does not match.
+func Name(localizer localize.Localizer, message string, name string) error {
+	var confirmedName string
+	if err := survey.AskOne(&survey.Input{Message: message}, &confirmedName); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(confirmedName) != name {
+		return localizer.MustLocalizeError("confirm.error.nameMismatch",
+			localize.NewEntry("ConfirmedName", confirmedName),
+			localize.NewEntry("Name", name),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package confirm
+
+import "testing"
+
+func TestDefaultYes(t *testing.T) {
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{value: "", want: false},
+		{value: "true", want: true},
+		{value: "1", want: true},
+		{value: "false", want: false},
+		{value: "yes", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Setenv(EnvYes, tt.value)
+			if got := DefaultYes(); got != tt.want {
+				t.Errorf("DefaultYes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
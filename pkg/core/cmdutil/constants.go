@@ -8,3 +8,11 @@ const (
 	// DefaultPollTime is the default interval to wait when polling a network request
 	DefaultPollTime = time.Millisecond * 5000
 )
+
+// OfflineAnnotation marks commands which only read local files.
+// The update check and telemetry are skipped for these commands.
+const OfflineAnnotation = "rhoas_offline"
+
+// PagerAnnotation marks commands whose output is paged.
+// The output of the other commands is never paged.
+const PagerAnnotation = "rhoas_pager"
@@ -0,0 +1,81 @@
+package cmdutil
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// DefaultScenario is the scenario of examples which do not select an output format
+const DefaultScenario = "default"
+
+// Example is a single runnable example of a command
+type Example struct {
+	// Scenario tags the example, it is the output format selected by the example or DefaultScenario
+	Scenario    string `json:"scenario"`
+	Description string `json:"description,omitempty"`
+	Command     string `json:"command"`
+}
+
+// Examples returns the runnable examples registered in the example text of the command
+func Examples(cmd *cobra.Command) []Example {
+	return ParseExamples(cmd.Example)
+}
+
+// ParseExamples extracts the examples from the example text of a command.
+// Each "$ " line is an example, described by the "#" comment lines above it.
+// Lines ending with a backslash are joined with the next line.
+func ParseExamples(text string) []Example {
+	var examples []Example
+	var description []string
+	describedExamples := 0
+
+	lines := strings.Split(text, "\n")
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+
+		switch {
+		case line == "":
+			description = nil
+		case strings.HasPrefix(line, "#"):
+			if describedExamples > 0 {
+				description = nil
+				describedExamples = 0
+			}
+			description = append(description, strings.TrimSpace(strings.TrimPrefix(line, "#")))
+		case strings.HasPrefix(line, "$"):
+			command := strings.TrimSpace(strings.TrimPrefix(line, "$"))
+			for strings.HasSuffix(command, "\\") && i+1 < len(lines) {
+				i++
+				command = strings.TrimSpace(strings.TrimSuffix(command, "\\")) + " " + strings.TrimSpace(lines[i])
+			}
+
+			examples = append(examples, Example{
+				Scenario:    exampleScenario(command),
+				Description: strings.Join(description, " "),
+				Command:     command,
+			})
+			describedExamples++
+		}
+	}
+
+	return examples
+}
+
+// exampleScenario returns the output format selected by the command, or DefaultScenario
+func exampleScenario(command string) string {
+	fields := strings.Fields(command)
+	for i, field := range fields {
+		switch {
+		case (field == "-o" || field == "--output") && i+1 < len(fields):
+			return fields[i+1]
+		case strings.HasPrefix(field, "--output="):
+			return strings.TrimPrefix(field, "--output=")
+		case strings.HasPrefix(field, "-o="):
+			return strings.TrimPrefix(field, "-o=")
+		case strings.HasPrefix(field, "-o") && len(field) > 2 && !strings.HasPrefix(field, "--"):
+			return strings.TrimPrefix(field, "-o")
+		}
+	}
+	return DefaultScenario
+}
@@ -0,0 +1,65 @@
+package cmdutil
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseExamples(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []Example
+	}{
+		{
+			name: "described examples",
+			text: `
+# List all Kafka instances
+$ rhoas kafka list
+
+# List all Kafka instances in JSON format
+$ rhoas kafka list -o json
+`,
+			want: []Example{
+				{Scenario: DefaultScenario, Description: "List all Kafka instances", Command: "rhoas kafka list"},
+				{Scenario: "json", Description: "List all Kafka instances in JSON format", Command: "rhoas kafka list -o json"},
+			},
+		},
+		{
+			name: "multi-line description and continuation",
+			text: `
+# Create a topic
+# with three partitions
+$ rhoas kafka topic create --name orders \
+    --partitions 3 --output=yaml
+`,
+			want: []Example{
+				{Scenario: "yaml", Description: "Create a topic with three partitions", Command: "rhoas kafka topic create --name orders --partitions 3 --output=yaml"},
+			},
+		},
+		{
+			name: "commands sharing a description",
+			text: `
+# Switch context
+$ rhoas context use --name dev
+$ rhoas context use --name prod -oyml
+`,
+			want: []Example{
+				{Scenario: DefaultScenario, Description: "Switch context", Command: "rhoas context use --name dev"},
+				{Scenario: "yml", Description: "Switch context", Command: "rhoas context use --name prod -oyml"},
+			},
+		},
+		{
+			name: "no examples",
+			text: "",
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseExamples(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseExamples() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package flagutil
+
+import "github.com/spf13/pflag"
+
+// NoColorFlagName is the name of the flag used to disable colored output
+const NoColorFlagName = "no-color"
+
+var colorDisabled bool
+
+// NoColorFlag adds the no-color flag to the given set of command line flags
+func NoColorFlag(flags *pflag.FlagSet, usage string) {
+	flags.BoolVar(&colorDisabled, NoColorFlagName, false, usage)
+}
+
+// ColorDisabled returns a boolean flag that indicates if colored output is disabled
+func ColorDisabled() bool {
+	return colorDisabled
+}
@@ -13,8 +13,12 @@ var (
 	cachedServiceAccounts []string
 )
 
+// ValidValuesAnnotation is the flag annotation which holds the predefined valid values of a flag
+const ValidValuesAnnotation = "rhoas_valid_values"
+
 // EnableStaticFlagCompletion enables autocompletion for flags with predefined valid values
 func EnableStaticFlagCompletion(cmd *cobra.Command, flagName string, validValues []string) {
+	_ = cmd.Flags().SetAnnotation(flagName, ValidValuesAnnotation, validValues)
 	_ = cmd.RegisterFlagCompletionFunc(flagName, func(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 		return validValues, cobra.ShellCompDirectiveNoSpace
 	})
@@ -22,6 +26,7 @@ func EnableStaticFlagCompletion(cmd *cobra.Command, flagName string, validValues
 
 // EnableOutputFlagCompletion enables autocompletion for output flag
 func EnableOutputFlagCompletion(cmd *cobra.Command) {
+	_ = cmd.Flags().SetAnnotation("output", ValidValuesAnnotation, ValidOutputFormats)
 	_ = cmd.RegisterFlagCompletionFunc("output", func(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 		return ValidOutputFormats, cobra.ShellCompDirectiveNoSpace
 	})
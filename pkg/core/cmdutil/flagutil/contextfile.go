@@ -0,0 +1,20 @@
+// This file contains functions used to implement the '--context-file' command line option.
+
+package flagutil
+
+import "github.com/spf13/pflag"
+
+// ContextFileFlagName is the name of the flag used to load service contexts from another file
+const ContextFileFlagName = "context-file"
+
+// ContextFileFlag adds the context-file flag to the given set of command line flags.
+// The flag is only registered so that it is accepted by all commands, its value is read with ContextFileFromArgs.
+func ContextFileFlag(flags *pflag.FlagSet, usage string) {
+	flags.String(ContextFileFlagName, "", usage)
+}
+
+// ContextFileFromArgs returns the value of the context file flag from the command line arguments.
+// The context file is loaded before the command line is parsed, so the flag must be read directly from the arguments.
+func ContextFileFromArgs(args []string) string {
+	return valueFromArgs(args, ContextFileFlagName)
+}
@@ -0,0 +1,24 @@
+package flagutil
+
+import "testing"
+
+func TestContextFileFromArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{name: "not set", args: []string{"kafka", "list"}, want: ""},
+		{name: "separate value", args: []string{"kafka", "list", "--context-file", "./rhoas.json"}, want: "./rhoas.json"},
+		{name: "inline value", args: []string{"--context-file=./rhoas.json", "kafka", "list"}, want: "./rhoas.json"},
+		{name: "missing value", args: []string{"kafka", "list", "--context-file"}, want: ""},
+		{name: "after terminator", args: []string{"request", "--", "--context-file", "./rhoas.json"}, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ContextFileFromArgs(tt.args); got != tt.want {
+				t.Errorf("ContextFileFromArgs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
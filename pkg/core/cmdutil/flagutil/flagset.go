@@ -4,6 +4,7 @@ package flagutil
 import (
 	"github.com/redhat-developer/app-services-cli/internal/build"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/confirm"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/spf13/cobra"
@@ -31,6 +32,11 @@ func NewFlagSet(cmd *cobra.Command, localizer localize.Localizer) *FlagSet {
 
 // AddOutput adds an output flag to the command
 func (fs *FlagSet) AddOutput(output *string) {
+	fs.AddOutputFormats(output, ValidOutputFormats...)
+}
+
+// AddOutputFormats adds an output flag accepting the given formats to the command
+func (fs *FlagSet) AddOutputFormats(output *string, formats ...string) {
 	flagName := "output"
 
 	fs.StringVarP(
@@ -38,14 +44,66 @@ func (fs *FlagSet) AddOutput(output *string) {
 		flagName,
 		"o",
 		dump.EmptyFormat,
-		FlagDescription(fs.localizer, "flag.common.output.description", ValidOutputFormats...),
+		FlagDescription(fs.localizer, "flag.common.output.description", formats...),
 	)
 
+	_ = fs.SetAnnotation(flagName, ValidValuesAnnotation, formats)
 	_ = fs.cmd.RegisterFlagCompletionFunc(flagName, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-		return ValidOutputFormats, cobra.ShellCompDirectiveNoSpace
+		return formats, cobra.ShellCompDirectiveNoSpace
 	})
 }
 
+// AddPrintSchema adds a "print-schema" flag which prints the JSON Schema
+// of the structured output of the command instead of running it.
+// model is a value of the type printed by the "output" flag.
+// It must be called after the run functions of the command are set.
+func (fs *FlagSet) AddPrintSchema(model interface{}) {
+	fs.AddPrintSchemaFunc(func() interface{} {
+		return model
+	})
+}
+
+// AddPrintSchemaFunc is like AddPrintSchema for commands whose output
+// depends on their other flags. model is called after the flags are parsed.
+func (fs *FlagSet) AddPrintSchemaFunc(model func() interface{}) {
+	flagName := "print-schema"
+
+	var printSchema bool
+	fs.BoolVar(
+		&printSchema,
+		flagName,
+		false,
+		FlagDescription(fs.localizer, "flag.common.printSchema.description"),
+	)
+
+	preRunE, preRun := fs.cmd.PreRunE, fs.cmd.PreRun
+	fs.cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if printSchema {
+			// the schema is printed without running the command,
+			// so its required flags do not have to be set
+			cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+				delete(flag.Annotations, cobra.BashCompOneRequiredFlag)
+			})
+			return nil
+		}
+		if preRunE != nil {
+			return preRunE(cmd, args)
+		}
+		if preRun != nil {
+			preRun(cmd, args)
+		}
+		return nil
+	}
+
+	runE := fs.cmd.RunE
+	fs.cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if printSchema {
+			return dump.PrintSchema(cmd.OutOrStdout(), model())
+		}
+		return runE(cmd, args)
+	}
+}
+
 // AddYes adds a "yes" flag to the command
 func (fs *FlagSet) AddYes(yes *bool) {
 	flagName := "yes"
@@ -54,7 +112,7 @@ func (fs *FlagSet) AddYes(yes *bool) {
 		yes,
 		flagName,
 		"y",
-		false,
+		confirm.DefaultYes(),
 		FlagDescription(fs.localizer, "flag.common.yes.description"),
 	)
 
@@ -63,6 +121,16 @@ func (fs *FlagSet) AddYes(yes *bool) {
 	})
 }
 
+// AddResume adds a "resume" flag to a bulk command
+func (fs *FlagSet) AddResume(resume *bool) {
+	fs.BoolVar(
+		resume,
+		"resume",
+		false,
+		FlagDescription(fs.localizer, "flag.common.resume.description"),
+	)
+}
+
 // AddPage adds a "page" flag to the command
 func (fs *FlagSet) AddPage(page *int32) {
 	flagName := "page"
@@ -88,6 +156,16 @@ func (fs *FlagSet) AddSize(page *int32) {
 	)
 }
 
+// AddAsync adds an "async" flag which runs the command as a background job
+func (fs *FlagSet) AddAsync(async *bool) {
+	fs.BoolVar(
+		async,
+		"async",
+		false,
+		FlagDescription(fs.localizer, "flag.common.async.description"),
+	)
+}
+
 // AddBypassTermsCheck adds a flag to allow bypassing
 // of the terms check before creating an instance
 func (fs *FlagSet) AddBypassTermsCheck(bypass *bool) {
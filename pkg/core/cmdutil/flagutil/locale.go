@@ -0,0 +1,32 @@
+// This file contains functions used to implement the '--locale' command line option.
+
+package flagutil
+
+import "strings"
+
+// LocaleFlagName is the name of the flag used to override the language of the CLI
+const LocaleFlagName = "locale"
+
+// LocaleFromArgs returns the value of the locale flag from the command line arguments.
+// The localizer is created before the command line is parsed, so the flag must be read directly from the arguments.
+func LocaleFromArgs(args []string) string {
+	return valueFromArgs(args, LocaleFlagName)
+}
+
+// valueFromArgs returns the value of a long flag from the command line arguments,
+// stopping at the "--" terminator
+func valueFromArgs(args []string, name string) string {
+	longFlag := "--" + name
+	for i, arg := range args {
+		if arg == "--" {
+			return ""
+		}
+		if arg == longFlag && i+1 < len(args) {
+			return args[i+1]
+		}
+		if strings.HasPrefix(arg, longFlag+"=") {
+			return strings.TrimPrefix(arg, longFlag+"=")
+		}
+	}
+	return ""
+}
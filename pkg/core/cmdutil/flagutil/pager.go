@@ -0,0 +1,18 @@
+package flagutil
+
+import "github.com/spf13/pflag"
+
+// NoPagerFlagName is the name of the flag used to disable the pager
+const NoPagerFlagName = "no-pager"
+
+var pagerDisabled bool
+
+// NoPagerFlag adds the no-pager flag to the given set of command line flags
+func NoPagerFlag(flags *pflag.FlagSet, usage string) {
+	flags.BoolVar(&pagerDisabled, NoPagerFlagName, false, usage)
+}
+
+// PagerDisabled returns a boolean flag that indicates if the pager is disabled
+func PagerDisabled() bool {
+	return pagerDisabled
+}
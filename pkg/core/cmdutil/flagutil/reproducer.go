@@ -0,0 +1,18 @@
+package flagutil
+
+import "github.com/spf13/pflag"
+
+// SaveReproducerFlagName is the name of the flag used to save a reproducer bundle when a command fails
+const SaveReproducerFlagName = "save-reproducer"
+
+var reproducerFile string
+
+// SaveReproducerFlag adds the save-reproducer flag to the given set of command line flags
+func SaveReproducerFlag(flags *pflag.FlagSet, usage string) {
+	flags.StringVar(&reproducerFile, SaveReproducerFlagName, "", usage)
+}
+
+// ReproducerFile returns the path where the reproducer bundle is saved, empty when it is not requested
+func ReproducerFile() string {
+	return reproducerFile
+}
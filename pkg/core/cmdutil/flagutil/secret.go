@@ -0,0 +1,86 @@
+package flagutil
+
+import (
+	"io"
+	"os"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// SecretFromStdin is the value of a secret flag which reads the secret from the standard input
+	SecretFromStdin = "-"
+	// SecretFilePrefix prefixes the path of a file to read the secret from
+	SecretFilePrefix = "@"
+	// SecretAnnotation marks the flags holding a secret, so that their values can be redacted
+	SecretAnnotation = "rhoas_secret"
+)
+
+// AddSecret adds a flag accepting a secret, which can also be read from
+// the given input with "-" or from a file with "@path", so that it does not
+// show up in process listings or the shell history.
+// The value is resolved before the command runs, so that parsing the flags
+// for completions or examples does not read it.
+// It must be called after the run functions of the command are set.
+func (fs *FlagSet) AddSecret(secret *string, name string, shorthand string, in io.Reader, description string) {
+	fs.StringVarP(
+		secret,
+		name,
+		shorthand,
+		"",
+		strings.TrimRight(description, ". \n")+". "+fs.localizer.MustLocalize("flag.common.secret.description"),
+	)
+	_ = fs.SetAnnotation(name, SecretAnnotation, []string{"true"})
+
+	preRunE, preRun := fs.cmd.PreRunE, fs.cmd.PreRun
+	fs.cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		value, err := ReadSecret(*secret, in, fs.localizer)
+		if err != nil {
+			return err
+		}
+		*secret = value
+
+		if preRunE != nil {
+			return preRunE(cmd, args)
+		}
+		if preRun != nil {
+			preRun(cmd, args)
+		}
+		return nil
+	}
+}
+
+// ReadSecret resolves the value of a secret flag.
+// "-" reads the secret from in and "@path" from the file at path,
+// without their trailing line break. Other values are the secret itself.
+func ReadSecret(val string, in io.Reader, localizer localize.Localizer) (string, error) {
+	var (
+		data []byte
+		err  error
+	)
+
+	switch {
+	case val == SecretFromStdin:
+		data, err = io.ReadAll(in)
+		if err != nil {
+			return "", localizer.MustLocalizeError("flag.error.secret.readStdin", localize.NewEntry("Error", err))
+		}
+	case strings.HasPrefix(val, SecretFilePrefix):
+		path := strings.TrimPrefix(val, SecretFilePrefix)
+		// #nosec G304
+		data, err = os.ReadFile(path)
+		if err != nil {
+			return "", localizer.MustLocalizeError("flag.error.secret.readFile", localize.NewEntry("Path", path), localize.NewEntry("Error", err))
+		}
+	default:
+		return val, nil
+	}
+
+	secret := strings.TrimRight(string(data), "\r\n")
+	if secret == "" {
+		return "", localizer.MustLocalizeError("flag.error.secret.empty")
+	}
+	return secret, nil
+}
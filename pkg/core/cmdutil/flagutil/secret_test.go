@@ -0,0 +1,44 @@
+package flagutil
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize/goi18n"
+)
+
+func TestReadSecret(t *testing.T) {
+	localizer, _ := goi18n.New(nil)
+
+	secretFile := filepath.Join(t.TempDir(), "secret")
+	if err := os.WriteFile(secretFile, []byte("from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		val     string
+		stdin   string
+		want    string
+		wantErr bool
+	}{
+		{name: "literal value", val: "s3cr3t", want: "s3cr3t"},
+		{name: "standard input", val: "-", stdin: "from-stdin\r\n", want: "from-stdin"},
+		{name: "file", val: "@" + secretFile, want: "from-file"},
+		{name: "missing file", val: "@" + secretFile + ".missing", wantErr: true},
+		{name: "empty standard input", val: "-", stdin: "\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadSecret(tt.val, strings.NewReader(tt.stdin), localizer)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadSecret() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ReadSecret() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package flagutil
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
+	"github.com/spf13/pflag"
+)
+
+// NoTruncateFlagName is the name of the flag used to print tables without truncating their cells
+const NoTruncateFlagName = "no-truncate"
+
+// NoTruncateFlag adds the no-truncate flag to the given set of command line flags
+func NoTruncateFlag(flags *pflag.FlagSet, usage string) {
+	flags.BoolVar(&dump.NoTruncate, NoTruncateFlagName, false, usage)
+}
+
+// TimestampsFlagName is the name of the flag used to print absolute times in tables
+const TimestampsFlagName = "timestamps"
+
+// TimestampsFlag adds the timestamps flag to the given set of command line flags
+func TimestampsFlag(flags *pflag.FlagSet, usage string) {
+	flags.BoolVar(&dump.Timestamps, TimestampsFlagName, false, usage)
+}
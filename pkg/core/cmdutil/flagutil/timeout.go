@@ -0,0 +1,23 @@
+package flagutil
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// TimeoutFlagName is the name of the flag used to limit the duration of a command.
+// It differs from the --timeout flag of the commands which wait for a resource, so that both can be set.
+const TimeoutFlagName = "command-timeout"
+
+var commandTimeout time.Duration
+
+// TimeoutFlag adds the timeout flag to the given set of command line flags
+func TimeoutFlag(flags *pflag.FlagSet, usage string) {
+	flags.DurationVar(&commandTimeout, TimeoutFlagName, 0, usage)
+}
+
+// CommandTimeout returns the maximum duration of the command, zero when it is not limited
+func CommandTimeout() time.Duration {
+	return commandTimeout
+}
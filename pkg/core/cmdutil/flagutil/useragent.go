@@ -0,0 +1,18 @@
+package flagutil
+
+import "github.com/spf13/pflag"
+
+// UserAgentSuffixFlagName is the name of the flag used to append text to the User-Agent of the API requests
+const UserAgentSuffixFlagName = "user-agent-suffix"
+
+var userAgentSuffix string
+
+// UserAgentSuffixFlag adds the user-agent-suffix flag to the given set of command line flags
+func UserAgentSuffixFlag(flags *pflag.FlagSet, usage string) {
+	flags.StringVar(&userAgentSuffix, UserAgentSuffixFlagName, "", usage)
+}
+
+// UserAgentSuffix returns the text appended to the User-Agent of the API requests
+func UserAgentSuffix() string {
+	return userAgentSuffix
+}
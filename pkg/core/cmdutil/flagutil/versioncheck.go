@@ -0,0 +1,18 @@
+package flagutil
+
+import "github.com/spf13/pflag"
+
+// SkipVersionCheckFlagName is the name of the flag used to silence the warnings about unsupported CLI versions
+const SkipVersionCheckFlagName = "skip-version-check"
+
+var versionCheckSkipped bool
+
+// SkipVersionCheckFlag adds the skip-version-check flag to the given set of command line flags
+func SkipVersionCheckFlag(flags *pflag.FlagSet, usage string) {
+	flags.BoolVar(&versionCheckSkipped, SkipVersionCheckFlagName, false, usage)
+}
+
+// VersionCheckSkipped returns a boolean flag that indicates if the version check is skipped
+func VersionCheckSkipped() bool {
+	return versionCheckSkipped
+}
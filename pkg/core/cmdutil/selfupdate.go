@@ -3,90 +3,57 @@ package cmdutil
 import (
 	"time"
 
-	"github.com/AlecAivazis/survey/v2"
 	"github.com/blang/semver"
 	"github.com/redhat-developer/app-services-cli/internal/build"
-	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
 	"github.com/wtrocki/go-github-selfupdate/selfupdate"
 )
 
-// DoSelfUpdate checks for updates and prompts the user to update if there is a newer version available
-func DoSelfUpdate(f *factory.Factory) (bool, error) {
-	version := build.Version
+// LatestRelease returns the latest published release of the CLI for the current platform
+func LatestRelease() (*selfupdate.Release, bool, error) {
 	slug := build.RepositoryOwner + "/" + build.RepositoryName
+	return selfupdate.DetectLatest(slug)
+}
 
-	v := semver.MustParse(version)
-	versionToUpdate, found, err := selfupdate.DefaultUpdater().DetectLatest(slug)
-	if err != nil {
-		return false, err
-	}
-	if found && versionToUpdate.Version.Equals(v) {
-		// latest version is the same as current version. It means current binary is up to date.
-		f.Logger.Debug("Current binary is the latest version", version)
-		return false, nil
-	}
-
-	promptConfirmName := &survey.Confirm{
-		Message: f.Localizer.MustLocalize("common.selfupdate.confirm", localize.NewEntry("Version", versionToUpdate.Version.String())),
-	}
-
-	var confirmUpdate bool
-	err = survey.AskOne(promptConfirmName, &confirmUpdate)
-	if err != nil {
-		return false, err
-	}
-
-	if !confirmUpdate {
-		return false, nil
-	}
-
-	latest, err := selfupdate.UpdateSelf(v, slug)
+// IsNewerRelease returns true if the release is newer than the given version.
+// Versions which are not valid semantic versions, such as dev builds, are never upgraded.
+func IsNewerRelease(release *selfupdate.Release, version string) bool {
+	current, err := semver.ParseTolerant(version)
 	if err != nil {
-		return false, err
+		return false
 	}
-
-	f.Logger.Info(f.Localizer.MustLocalize("common.selfupdate.success", localize.NewEntry("Version", latest.Version)))
-	return true, err
-
+	return release.Version.GT(current)
 }
 
-// DoSelfUpdate checks for updates once per day and prompts the user to update if there is a newer version available
-func DoSelfUpdateOnceADay(f *factory.Factory) (bool, error) {
-
-	if build.BuildSource != string(build.GithubBuildSource) {
-		return false, nil
-	}
-
-	if !f.IOStreams.CanPrompt() {
-		// Do not prompt if we are not in interactive mode
-		return false, nil
-	}
+// CheckForUpdateOnceADay looks up the latest release in the background, at most once per day.
+// The returned channel receives the latest release when the check was done, even if it is not newer,
+// and is closed without a value when the check was skipped or failed.
+func CheckForUpdateOnceADay(f *factory.Factory) <-chan *selfupdate.Release {
+	releases := make(chan *selfupdate.Release, 1)
 
-	if build.IsDevBuild() {
-		return false, nil
+	if build.BuildSource != string(build.GithubBuildSource) || build.IsDevBuild() || !f.IOStreams.CanPrompt() {
+		close(releases)
+		return releases
 	}
 
 	cfg, err := f.Config.Load()
-
-	logger := f.Logger
-
-	if err != nil {
-		return false, err
+	if err != nil || cfg.LastUpdated >= time.Now().AddDate(0, 0, -1).UnixMilli() {
+		close(releases)
+		return releases
 	}
-	logger.Debug("Checking for updates. Last check was done:", cfg.LastUpdated)
+	f.Logger.Debug("Checking for updates. Last check was done:", cfg.LastUpdated)
 
-	if cfg.LastUpdated < time.Now().AddDate(0, 0, -1).UnixMilli() {
-		updated, err := DoSelfUpdate(f)
+	go func() {
+		defer close(releases)
+		release, found, err := LatestRelease()
 		if err != nil {
-			return false, err
+			f.Logger.Debug("Could not check for updates:", err)
+			return
 		}
-		cfg.LastUpdated = time.Now().UnixMilli()
-		err = f.Config.Save(cfg)
-		if err != nil {
-			return false, err
+		if found {
+			releases <- release
 		}
-		return updated, nil
-	}
-	return false, nil
+	}()
+
+	return releases
 }
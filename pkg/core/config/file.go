@@ -123,3 +123,17 @@ func DefaultDir() (string, error) {
 	}
 	return filepath.Join(userCfgDir, "rhoas"), nil
 }
+
+// CacheDir returns the directory of the files which can be recreated at any time, such as cached API responses.
+// It is in the user cache directory, which on Windows is in %LocalAppData% so that it does not roam with the
+// user profile, or next to the config file when the config file has a custom location.
+func CacheDir() (string, error) {
+	if HasCustomLocation() {
+		return filepath.Join(filepath.Dir(os.Getenv(EnvName)), "cache"), nil
+	}
+	userCacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(userCacheDir, "rhoas"), nil
+}
@@ -13,16 +13,60 @@ type IConfig interface {
 
 // Config is a type which describes the properties which can be in the config
 type Config struct {
-	AccessToken  string           `json:"access_token,omitempty" doc:"Bearer access token."`
-	RefreshToken string           `json:"refresh_token,omitempty" doc:"Offline or refresh token."`
-	Services     ServiceConfigMap `json:"services,omitempty"`
-	APIUrl       string           `json:"api_url,omitempty" doc:"URL of the API gateway. The value can be the complete URL or an alias. The valid aliases are 'production', 'staging' and 'integration'."`
-	AuthURL      string           `json:"auth_url,omitempty" doc:"URL of the authentication server"`
-	ClientID     string           `json:"client_id,omitempty" doc:"OpenID client identifier."`
-	Insecure     bool             `json:"insecure,omitempty" doc:"Enables insecure communication with the server. This disables verification of TLS certificates and host names."`
-	Scopes       []string         `json:"scopes,omitempty" doc:"OpenID scope. If this option is used it will replace completely the default scopes. Can be repeated multiple times to specify multiple scopes."`
-	Telemetry    string           `json:"telemetry,omitempty" doc:"Flag used to enable telemetry for user."`
-	LastUpdated  int64            `json:"last_updated,omitempty" doc:"Timestamp of the last update cli"`
+	AccessToken           string                       `json:"access_token,omitempty" doc:"Bearer access token."`
+	RefreshToken          string                       `json:"refresh_token,omitempty" doc:"Offline or refresh token."`
+	Services              ServiceConfigMap             `json:"services,omitempty"`
+	APIUrl                string                       `json:"api_url,omitempty" doc:"URL of the API gateway. The value can be the complete URL or an alias. The valid aliases are 'production', 'staging' and 'integration'."`
+	AuthURL               string                       `json:"auth_url,omitempty" doc:"URL of the authentication server"`
+	ClientID              string                       `json:"client_id,omitempty" doc:"OpenID client identifier."`
+	Insecure              bool                         `json:"insecure,omitempty" doc:"Enables insecure communication with the server. This disables verification of TLS certificates and host names."`
+	Scopes                []string                     `json:"scopes,omitempty" doc:"OpenID scope. If this option is used it will replace completely the default scopes. Can be repeated multiple times to specify multiple scopes."`
+	Telemetry             string                       `json:"telemetry,omitempty" doc:"Set to 'enabled' to send anonymous usage data. Change it with 'rhoas config set telemetry on|off'."`
+	LastUpdated           int64                        `json:"last_updated,omitempty" doc:"Timestamp of the last update cli"`
+	Hooks                 *HooksConfig                 `json:"hooks,omitempty" doc:"Shell commands to run after service instances are created or deleted."`
+	Environments          map[string]EnvironmentConfig `json:"environments,omitempty" doc:"Environment presets used by 'rhoas login --env'. Presets with the name of a built-in environment override its values."`
+	Protected             map[string][]string          `json:"protected,omitempty" doc:"IDs of the service instances which cannot be deleted without the '--force' flag, by service."`
+	Pager                 string                       `json:"pager,omitempty" doc:"Command used to page long output. Overrides the PAGER environment variable. Set to 'cat' to disable paging."`
+	VersionCheck          *VersionCheckConfig          `json:"version_check,omitempty" doc:"Minimum CLI version supported by the API, as of the last daily check."`
+	HTTPCache             string                       `json:"http_cache,omitempty" doc:"Set to 'on' to keep API responses on disk for a short time, so they are shared between commands. Change it with 'rhoas config set http-cache on|off'."`
+	ColorTheme            string                       `json:"color_theme,omitempty" doc:"Colors of the statuses. Set to 'light' for terminals with a light background. Change it with 'rhoas config set color-theme dark|light'."`
+	TopicNamePolicy       string                       `json:"topic_name_policy,omitempty" doc:"Regular expression which the names of new Kafka topics must match, or an http(s) URL which returns it. Change it with 'rhoas config set topic-name-policy <value>'."`
+	MaxConcurrentRequests int                          `json:"max_concurrent_requests,omitempty" doc:"Maximum number of API requests sent in parallel. Change it with 'rhoas config set max-concurrent-requests <number>'."`
+}
+
+// DefaultMaxConcurrentRequests is the maximum number of API requests sent in parallel when it is not configured
+const DefaultMaxConcurrentRequests = 10
+
+// GetMaxConcurrentRequests returns the maximum number of API requests sent in parallel
+func (c *Config) GetMaxConcurrentRequests() int {
+	if c.MaxConcurrentRequests < 1 {
+		return DefaultMaxConcurrentRequests
+	}
+	return c.MaxConcurrentRequests
+}
+
+// Values of the HTTPCache setting
+const (
+	HTTPCacheOn  = "on"
+	HTTPCacheOff = "off"
+)
+
+// VersionCheckConfig is the cached result of the daily check of the CLI version supported by the API
+type VersionCheckConfig struct {
+	CheckedAt  int64  `json:"checked_at" doc:"Timestamp of the last check."`
+	MinVersion string `json:"min_version,omitempty" doc:"Oldest CLI version which is known to work with the API."`
+}
+
+// EnvironmentConfig is the set of URLs used to log in to an environment
+type EnvironmentConfig struct {
+	APIUrl  string `json:"api_url,omitempty" doc:"URL of the API gateway."`
+	AuthURL string `json:"auth_url,omitempty" doc:"URL of the authentication server, including the SSO realm."`
+}
+
+// HooksConfig is the shell commands run after the lifecycle events of service instances
+type HooksConfig struct {
+	PostCreate []string `json:"post_create,omitempty" doc:"Shell commands to run after a service instance is created."`
+	PostDelete []string `json:"post_delete,omitempty" doc:"Shell commands to run after a service instance is deleted."`
 }
 
 // ServiceConfigMap is a map of configs for the application services
@@ -58,3 +102,48 @@ func (c *Config) GetServiceRegistryIdOk() (string, bool) {
 
 	return "", false
 }
+
+// IsProtected returns whether the service instance is protected from deletion
+func (c *Config) IsProtected(service string, id string) bool {
+	for _, protectedID := range c.Protected[service] {
+		if protectedID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Protect marks the service instance as protected from deletion.
+// Returns false if the instance was already protected.
+func (c *Config) Protect(service string, id string) bool {
+	if c.IsProtected(service, id) {
+		return false
+	}
+
+	if c.Protected == nil {
+		c.Protected = map[string][]string{}
+	}
+	c.Protected[service] = append(c.Protected[service], id)
+
+	return true
+}
+
+// Unprotect removes the deletion protection of the service instance.
+// Returns false if the instance was not protected.
+func (c *Config) Unprotect(service string, id string) bool {
+	ids := c.Protected[service]
+	for i, protectedID := range ids {
+		if protectedID != id {
+			continue
+		}
+
+		c.Protected[service] = append(ids[:i], ids[i+1:]...)
+		if len(c.Protected[service]) == 0 {
+			delete(c.Protected, service)
+		}
+		return true
+	}
+
+	return false
+}
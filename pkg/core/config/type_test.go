@@ -0,0 +1,83 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestConfig_Protect(t *testing.T) {
+	cfg := &Config{}
+
+	if cfg.IsProtected("kafka", "a") {
+		t.Fatalf("IsProtected() = true before Protect()")
+	}
+
+	if !cfg.Protect("kafka", "a") {
+		t.Errorf("Protect() = false, want true for a new instance")
+	}
+	if cfg.Protect("kafka", "a") {
+		t.Errorf("Protect() = true, want false for an already protected instance")
+	}
+	cfg.Protect("kafka", "b")
+	cfg.Protect("service-registry", "a")
+
+	want := map[string][]string{
+		"kafka":            {"a", "b"},
+		"service-registry": {"a"},
+	}
+	if !reflect.DeepEqual(cfg.Protected, want) {
+		t.Errorf("Protected = %v, want %v", cfg.Protected, want)
+	}
+
+	tests := []struct {
+		service string
+		id      string
+		want    bool
+	}{
+		{service: "kafka", id: "a", want: true},
+		{service: "kafka", id: "b", want: true},
+		{service: "kafka", id: "c", want: false},
+		{service: "service-registry", id: "a", want: true},
+		{service: "service-registry", id: "b", want: false},
+		{service: "connector", id: "a", want: false},
+	}
+	for _, tt := range tests {
+		if got := cfg.IsProtected(tt.service, tt.id); got != tt.want {
+			t.Errorf("IsProtected(%q, %q) = %v, want %v", tt.service, tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestConfig_Unprotect(t *testing.T) {
+	cfg := &Config{
+		Protected: map[string][]string{
+			"kafka":            {"a", "b", "c"},
+			"service-registry": {"a"},
+		},
+	}
+
+	if cfg.Unprotect("kafka", "d") {
+		t.Errorf("Unprotect() = true, want false for an instance which is not protected")
+	}
+	if cfg.Unprotect("connector", "a") {
+		t.Errorf("Unprotect() = true, want false for a service without protected instances")
+	}
+
+	if !cfg.Unprotect("kafka", "b") {
+		t.Errorf("Unprotect() = false, want true for a protected instance")
+	}
+	if cfg.IsProtected("kafka", "b") {
+		t.Errorf("IsProtected() = true after Unprotect()")
+	}
+	if !cfg.IsProtected("kafka", "a") || !cfg.IsProtected("kafka", "c") {
+		t.Errorf("Unprotect() removed other instances: %v", cfg.Protected["kafka"])
+	}
+
+	// the service entry is removed with its last instance, so it is omitted from the config file
+	if !cfg.Unprotect("service-registry", "a") {
+		t.Errorf("Unprotect() = false, want true for a protected instance")
+	}
+	if _, ok := cfg.Protected["service-registry"]; ok {
+		t.Errorf("Protected still has an entry for service-registry: %v", cfg.Protected)
+	}
+}
@@ -0,0 +1,263 @@
+package httputil
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxCachedBodySize is the size of the largest response body which is cached, larger responses such as downloads are not
+const maxCachedBodySize = 1024 * 1024
+
+// maxStaleAge is how long a response is kept on disk to be revalidated with its ETag, older responses are deleted
+const maxStaleAge = 24 * time.Hour
+
+// cachedAPIPaths are the control plane APIs whose responses are cached.
+// The data plane APIs, such as the Kafka records or the Service Registry artifact content, are never cached.
+var cachedAPIPaths = []string{
+	"/api/kafkas_mgmt/",
+	"/api/serviceregistry_mgmt/",
+	"/api/connector_mgmt/",
+	"/api/accounts_mgmt/",
+	"/apis/service_accounts/",
+}
+
+type noCacheKey struct{}
+
+// WithoutCache returns a context whose requests are never answered from the cache,
+// for example when polling a resource until it changes
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noCacheKey{}, true)
+}
+
+// cacheable reports whether the response of the request may be cached
+func cacheable(r *http.Request) bool {
+	if skip, _ := r.Context().Value(noCacheKey{}).(bool); skip {
+		return false
+	}
+	for _, path := range cachedAPIPaths {
+		if strings.Contains(r.URL.Path, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// cachedResponse is a successful GET response kept by the ResponseCache
+type cachedResponse struct {
+	ETag     string      `json:"etag,omitempty"`
+	Header   http.Header `json:"header"`
+	Body     []byte      `json:"body"`
+	StoredAt time.Time   `json:"stored_at"`
+}
+
+// ResponseCache keeps the responses of GET requests for a short time, so that identical requests
+// made by the same command are not sent again. Responses with an ETag are revalidated with the
+// server once they are stale, and only downloaded again when they have changed.
+//
+// When Dir is set the responses are also kept on disk and shared between commands.
+type ResponseCache struct {
+	// TTL is how long a response is used without contacting the server
+	TTL time.Duration
+	// Dir is the directory which keeps the responses on disk, they are only kept in memory when it is empty
+	Dir string
+
+	mu      sync.Mutex
+	entries map[string]*cachedResponse
+	pruned  bool
+}
+
+func (c *ResponseCache) get(key string) *cachedResponse {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if entry, ok := c.entries[key]; ok {
+		return entry
+	}
+	if c.Dir == "" {
+		return nil
+	}
+	c.prune()
+
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil
+	}
+	var entry cachedResponse
+	if json.Unmarshal(data, &entry) != nil {
+		_ = os.Remove(c.path(key))
+		return nil
+	}
+	// a stale response without an ETag cannot be revalidated
+	if entry.ETag == "" && time.Since(entry.StoredAt) >= c.TTL {
+		_ = os.Remove(c.path(key))
+		return nil
+	}
+	c.store(key, &entry)
+	return &entry
+}
+
+// prune deletes the responses on disk which are too old to be revalidated, once per cache
+func (c *ResponseCache) prune() {
+	if c.pruned {
+		return
+	}
+	c.pruned = true
+
+	files, err := os.ReadDir(c.Dir)
+	if err != nil {
+		return
+	}
+	for _, file := range files {
+		info, err := file.Info()
+		if err != nil || file.IsDir() || time.Since(info.ModTime()) < maxStaleAge {
+			continue
+		}
+		_ = os.Remove(filepath.Join(c.Dir, file.Name()))
+	}
+}
+
+func (c *ResponseCache) put(key string, entry *cachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.store(key, entry)
+	if c.Dir == "" {
+		return
+	}
+
+	// the disk cache is best effort, a response which cannot be written is only kept in memory
+	if data, err := json.Marshal(entry); err == nil && os.MkdirAll(c.Dir, 0o700) == nil {
+		_ = os.WriteFile(c.path(key), data, 0o600)
+	}
+}
+
+// Clear removes all cached responses
+func (c *ResponseCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = nil
+	if c.Dir != "" {
+		_ = os.RemoveAll(c.Dir)
+	}
+}
+
+func (c *ResponseCache) store(key string, entry *cachedResponse) {
+	if c.entries == nil {
+		c.entries = map[string]*cachedResponse{}
+	}
+	c.entries[key] = entry
+}
+
+func (c *ResponseCache) path(key string) string {
+	return filepath.Join(c.Dir, key+".json")
+}
+
+// CachingRoundTripper implements http.RoundTripper. It answers GET requests to the control plane APIs
+// from the ResponseCache when possible, unless the request context was created with WithoutCache.
+// Any other request clears the cache, as it may change the resources of the cached responses.
+type CachingRoundTripper struct {
+	Proxied http.RoundTripper
+	Cache   *ResponseCache
+}
+
+// RoundTrip returns the cached response of the request, or executes it and caches the response
+func (c CachingRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		c.Cache.Clear()
+		return c.Proxied.RoundTrip(r)
+	}
+	if r.Method != http.MethodGet || !cacheable(r) || r.Header.Get("Range") != "" || r.Header.Get("If-None-Match") != "" {
+		return c.Proxied.RoundTrip(r)
+	}
+
+	key := cacheKey(r)
+	entry := c.Cache.get(key)
+
+	if entry != nil && time.Since(entry.StoredAt) < c.Cache.TTL && !strings.Contains(r.Header.Get("Cache-Control"), "no-cache") {
+		return entry.response(r), nil
+	}
+
+	if entry != nil && entry.ETag != "" {
+		r = r.Clone(r.Context())
+		r.Header.Set("If-None-Match", entry.ETag)
+	}
+
+	resp, err := c.Proxied.RoundTrip(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && entry != nil && entry.ETag != "" {
+		resp.Body.Close()
+		c.Cache.put(key, &cachedResponse{ETag: entry.ETag, Header: entry.Header, Body: entry.Body, StoredAt: time.Now()})
+		return entry.response(r), nil
+	}
+
+	if resp.StatusCode != http.StatusOK || strings.Contains(resp.Header.Get("Cache-Control"), "no-store") {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxCachedBodySize+1))
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	if len(body) > maxCachedBodySize {
+		resp.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(body), resp.Body), Closer: resp.Body}
+		return resp, nil
+	}
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	c.Cache.put(key, &cachedResponse{
+		ETag:     resp.Header.Get("ETag"),
+		Header:   resp.Header.Clone(),
+		Body:     body,
+		StoredAt: time.Now(),
+	})
+
+	return resp, nil
+}
+
+// response creates a new response to the request from the cached response
+func (e *cachedResponse) response(r *http.Request) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       r,
+	}
+}
+
+// cacheKey identifies the response of a request. It includes the credentials of the request,
+// so that the responses for an account are never returned to another account.
+func cacheKey(r *http.Request) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.URL.String()))
+	hash.Write([]byte{0})
+	hash.Write([]byte(r.Header.Get("Authorization")))
+	hash.Write([]byte{0})
+	hash.Write([]byte(r.Header.Get("Accept")))
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,145 @@
+package httputil
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCachingRoundTripper(t *testing.T) {
+	var requests, notModified int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path == "/api/kafkas_mgmt/v1/no-etag" {
+			_, _ = w.Write([]byte("plain"))
+			return
+		}
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte("kafka"))
+	}))
+	defer server.Close()
+
+	cache := &ResponseCache{TTL: time.Minute}
+	client := &http.Client{Transport: CachingRoundTripper{Proxied: http.DefaultTransport, Cache: cache}}
+
+	get := func(path string) string {
+		t.Helper()
+		resp, err := client.Get(server.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unexpected status %v", resp.StatusCode)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		return string(body)
+	}
+
+	if body := get("/api/kafkas_mgmt/v1/kafkas/1"); body != "kafka" || requests != 1 {
+		t.Fatalf("first request: body %q, %v requests", body, requests)
+	}
+	if body := get("/api/kafkas_mgmt/v1/kafkas/1"); body != "kafka" || requests != 1 {
+		t.Errorf("fresh response: body %q, %v requests, want it served from the cache", body, requests)
+	}
+
+	// stale responses with an ETag are revalidated
+	cache.TTL = 0
+	if body := get("/api/kafkas_mgmt/v1/kafkas/1"); body != "kafka" || requests != 2 || notModified != 1 {
+		t.Errorf("stale response: body %q, %v requests, %v not modified", body, requests, notModified)
+	}
+
+	// stale responses without an ETag are downloaded again
+	get("/api/kafkas_mgmt/v1/no-etag")
+	get("/api/kafkas_mgmt/v1/no-etag")
+	if requests != 4 {
+		t.Errorf("%v requests, want 4", requests)
+	}
+
+	// other requests clear the cache
+	cache.TTL = time.Minute
+	if _, err := client.Post(server.URL+"/api/kafkas_mgmt/v1/kafkas", "application/json", nil); err != nil {
+		t.Fatal(err)
+	}
+	get("/api/kafkas_mgmt/v1/kafkas/1")
+	if requests != 6 || notModified != 1 {
+		t.Errorf("after clearing the cache: %v requests, %v not modified, want 6 and 1", requests, notModified)
+	}
+}
+
+func TestCachingRoundTripperSkipsDataPlaneAndPolling(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte("data"))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: CachingRoundTripper{Proxied: http.DefaultTransport, Cache: &ResponseCache{TTL: time.Minute}}}
+
+	get := func(ctx context.Context, path string) {
+		t.Helper()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	// Kafka records and registry artifact content are data plane requests
+	get(context.Background(), "/rest/api/v1/topics/orders/records")
+	get(context.Background(), "/rest/api/v1/topics/orders/records")
+	get(context.Background(), "/apis/registry/v2/groups/default/artifacts/schema")
+	get(context.Background(), "/apis/registry/v2/groups/default/artifacts/schema")
+	if requests != 4 {
+		t.Errorf("data plane: %v requests, want 4", requests)
+	}
+
+	// polling requests always contact the server
+	polling := WithoutCache(context.Background())
+	get(polling, "/api/kafkas_mgmt/v1/kafkas/1")
+	get(polling, "/api/kafkas_mgmt/v1/kafkas/1")
+	if requests != 6 {
+		t.Errorf("polling: %v requests, want 6", requests)
+	}
+}
+
+func TestResponseCacheDeletesExpiredEntries(t *testing.T) {
+	dir := t.TempDir()
+	cache := &ResponseCache{TTL: time.Minute, Dir: dir}
+
+	cache.put("fresh", &cachedResponse{ETag: `"v1"`, StoredAt: time.Now()})
+	cache.put("stale", &cachedResponse{StoredAt: time.Now().Add(-time.Hour)})
+	cache.put("old", &cachedResponse{ETag: `"v1"`, StoredAt: time.Now()})
+	old := time.Now().Add(-2 * maxStaleAge)
+	if err := os.Chtimes(filepath.Join(dir, "old.json"), old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded := &ResponseCache{TTL: time.Minute, Dir: dir}
+	if reloaded.get("fresh") == nil {
+		t.Error("fresh entry was not loaded")
+	}
+	if reloaded.get("stale") != nil {
+		t.Error("stale entry without an ETag was loaded")
+	}
+	for _, key := range []string{"stale", "old"} {
+		if _, err := os.Stat(filepath.Join(dir, key+".json")); !os.IsNotExist(err) {
+			t.Errorf("entry %q was not deleted", key)
+		}
+	}
+}
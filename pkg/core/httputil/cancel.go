@@ -0,0 +1,51 @@
+package httputil
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// CancelRoundTripper implements http.RoundTripper. It ties every request to the context of the command,
+// so that requests created with another context are interrupted when the command is cancelled.
+type CancelRoundTripper struct {
+	Proxied http.RoundTripper
+	Context context.Context
+}
+
+// RoundTrip executes the request, cancelling it when either its own context or the command context is done
+func (c CancelRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	if err := c.Context.Err(); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	go func() {
+		select {
+		case <-c.Context.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	resp, err := c.Proxied.RoundTrip(r.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// the request context is kept until the body has been read
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,52 @@
+package httputil
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCancelRoundTripper(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/hang" {
+			select {
+			case <-release:
+			case <-r.Context().Done():
+			}
+			return
+		}
+		_, _ = w.Write([]byte("kafka"))
+	}))
+	defer server.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client := &http.Client{Transport: CancelRoundTripper{Proxied: http.DefaultTransport, Context: ctx}}
+
+	resp, err := client.Get(server.URL + "/ok")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil || string(body) != "kafka" {
+		t.Fatalf("unexpected body %q, error %v", body, err)
+	}
+
+	// requests created without the command context are cancelled with it
+	time.AfterFunc(50*time.Millisecond, cancel)
+	_, err = client.Get(server.URL + "/hang")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the request to be cancelled, got %v", err)
+	}
+
+	_, err = client.Get(server.URL + "/ok")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected requests to fail once the command is cancelled, got %v", err)
+	}
+}
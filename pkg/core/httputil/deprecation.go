@@ -0,0 +1,67 @@
+package httputil
+
+import (
+	"net/http"
+	"sync"
+)
+
+// Deprecation is an API endpoint which the server reported as deprecated
+// through the Deprecation and Sunset response headers (RFC 8594)
+type Deprecation struct {
+	Method string
+	Path   string
+	// Sunset is the date after which the endpoint might stop responding, empty when not announced
+	Sunset string
+}
+
+// Deprecations keeps the deprecated endpoints called by a command
+type Deprecations struct {
+	mu        sync.Mutex
+	endpoints []Deprecation
+}
+
+// Endpoints returns the deprecated endpoints called so far, each endpoint once
+func (d *Deprecations) Endpoints() []Deprecation {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	endpoints := make([]Deprecation, len(d.endpoints))
+	copy(endpoints, d.endpoints)
+	return endpoints
+}
+
+func (d *Deprecations) add(e Deprecation) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, existing := range d.endpoints {
+		if existing.Method == e.Method && existing.Path == e.Path {
+			return
+		}
+	}
+	d.endpoints = append(d.endpoints, e)
+}
+
+// DeprecationRoundTripper implements http.RoundTripper. It keeps the endpoints whose responses announce a deprecation.
+type DeprecationRoundTripper struct {
+	Proxied      http.RoundTripper
+	Deprecations *Deprecations
+}
+
+// RoundTrip executes the request and checks the response for deprecation headers
+func (c DeprecationRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	resp, err := c.Proxied.RoundTrip(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Header.Get("Deprecation") != "" || resp.Header.Get("Sunset") != "" {
+		c.Deprecations.add(Deprecation{
+			Method: r.Method,
+			Path:   r.URL.Path,
+			Sunset: resp.Header.Get("Sunset"),
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,37 @@
+package httputil
+
+import (
+	"net/http"
+)
+
+// LimitRoundTripper implements http.RoundTripper. It caps the number of requests sent in parallel,
+// so that bulk operations do not open more connections than the API accepts.
+// A slot is held until the response headers are received, requests waiting for a slot
+// give up when their context is done.
+type LimitRoundTripper struct {
+	Proxied http.RoundTripper
+	slots   chan struct{}
+}
+
+// NewLimitRoundTripper creates a LimitRoundTripper sending at most limit requests in parallel
+func NewLimitRoundTripper(proxied http.RoundTripper, limit int) *LimitRoundTripper {
+	if limit < 1 {
+		limit = 1
+	}
+	return &LimitRoundTripper{
+		Proxied: proxied,
+		slots:   make(chan struct{}, limit),
+	}
+}
+
+// RoundTrip executes the request once a slot is available
+func (c *LimitRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	select {
+	case c.slots <- struct{}{}:
+	case <-r.Context().Done():
+		return nil, r.Context().Err()
+	}
+	defer func() { <-c.slots }()
+
+	return c.Proxied.RoundTrip(r)
+}
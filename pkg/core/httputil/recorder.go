@@ -0,0 +1,221 @@
+package httputil
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// Redacted replaces sensitive values in recorded exchanges
+	Redacted = "REDACTED"
+	// maxRecordedBodySize is the maximum number of bytes kept from each body
+	maxRecordedBodySize = 64 * 1024
+)
+
+// sensitiveHeaders are the headers which are always redacted
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// sensitiveKeyParts are the substrings of JSON keys and form fields whose values are redacted
+var sensitiveKeyParts = []string{"secret", "password", "token", "credential"}
+
+// operationIDHeaders are the response headers which identify a request on the server
+var operationIDHeaders = []string{"X-Operation-Id", "X-Request-Id"}
+
+// Exchange is a redacted HTTP request and its response
+type Exchange struct {
+	Time            time.Time   `json:"time"`
+	Method          string      `json:"method"`
+	URL             string      `json:"url"`
+	RequestHeaders  http.Header `json:"request_headers,omitempty"`
+	RequestBody     string      `json:"request_body,omitempty"`
+	StatusCode      int         `json:"status_code,omitempty"`
+	ResponseHeaders http.Header `json:"response_headers,omitempty"`
+	ResponseBody    string      `json:"response_body,omitempty"`
+	OperationID     string      `json:"operation_id,omitempty"`
+	Error           string      `json:"error,omitempty"`
+}
+
+// Recorder keeps the redacted HTTP exchanges made by a command
+type Recorder struct {
+	mu        sync.Mutex
+	exchanges []Exchange
+}
+
+// Exchanges returns the exchanges recorded so far
+func (r *Recorder) Exchanges() []Exchange {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	exchanges := make([]Exchange, len(r.exchanges))
+	copy(exchanges, r.exchanges)
+	return exchanges
+}
+
+func (r *Recorder) add(e Exchange) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.exchanges = append(r.exchanges, e)
+}
+
+// RecordingRoundTripper implements http.RoundTripper. It records every request and response in the Recorder.
+type RecordingRoundTripper struct {
+	Proxied  http.RoundTripper
+	Recorder *Recorder
+}
+
+// RoundTrip executes the request and records a redacted copy of the exchange
+func (c RecordingRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	exchange := Exchange{
+		Time:           time.Now(),
+		Method:         r.Method,
+		URL:            redactURL(r.URL),
+		RequestHeaders: redactHeaders(r.Header),
+	}
+
+	if r.Body != nil && r.GetBody != nil {
+		if body, err := r.GetBody(); err == nil {
+			exchange.RequestBody = readRedactedBody(body, r.Header.Get("Content-Type"))
+		}
+	}
+
+	resp, err := c.Proxied.RoundTrip(r)
+	if err != nil {
+		exchange.Error = err.Error()
+		c.Recorder.add(exchange)
+		return nil, err
+	}
+
+	exchange.StatusCode = resp.StatusCode
+	exchange.ResponseHeaders = redactHeaders(resp.Header)
+
+	if resp.Body != nil {
+		data, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+		if readErr != nil {
+			return nil, readErr
+		}
+
+		exchange.ResponseBody = redactBody(data, resp.Header.Get("Content-Type"))
+		exchange.OperationID = operationID(resp.Header, data)
+	}
+
+	c.Recorder.add(exchange)
+	return resp, nil
+}
+
+func readRedactedBody(body io.ReadCloser, contentType string) string {
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return ""
+	}
+	return redactBody(data, contentType)
+}
+
+// redactBody removes sensitive values from JSON and form encoded bodies
+func redactBody(data []byte, contentType string) string {
+	if len(data) == 0 {
+		return ""
+	}
+
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(data)); err == nil {
+			return truncate(redactValues(values).Encode())
+		}
+	}
+
+	var document interface{}
+	if err := json.Unmarshal(data, &document); err == nil {
+		if redacted, err := json.Marshal(redactJSON(document)); err == nil {
+			return truncate(string(redacted))
+		}
+	}
+
+	return truncate(string(data))
+}
+
+func redactJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			if IsSensitive(key) {
+				v[key] = Redacted
+			} else {
+				v[key] = redactJSON(nested)
+			}
+		}
+	case []interface{}:
+		for i, nested := range v {
+			v[i] = redactJSON(nested)
+		}
+	}
+	return value
+}
+
+func redactValues(values url.Values) url.Values {
+	for key := range values {
+		if IsSensitive(key) {
+			values[key] = []string{Redacted}
+		}
+	}
+	return values
+}
+
+func redactHeaders(headers http.Header) http.Header {
+	redacted := headers.Clone()
+	for _, name := range sensitiveHeaders {
+		if redacted.Get(name) != "" {
+			redacted.Set(name, Redacted)
+		}
+	}
+	return redacted
+}
+
+func redactURL(u *url.URL) string {
+	redacted := *u
+	redacted.User = nil
+	redacted.RawQuery = redactValues(u.Query()).Encode()
+	return redacted.String()
+}
+
+// IsSensitive returns whether a key or flag name is expected to hold a secret value
+func IsSensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// operationID returns the server side identifier of the request, from the headers or an error body
+func operationID(headers http.Header, body []byte) string {
+	for _, name := range operationIDHeaders {
+		if id := headers.Get(name); id != "" {
+			return id
+		}
+	}
+
+	var apiError struct {
+		OperationID string `json:"operation_id"`
+	}
+	if err := json.Unmarshal(body, &apiError); err == nil {
+		return apiError.OperationID
+	}
+	return ""
+}
+
+func truncate(body string) string {
+	if len(body) <= maxRecordedBodySize {
+		return body
+	}
+	return body[:maxRecordedBodySize] + "...(truncated)"
+}
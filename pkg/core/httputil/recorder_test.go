@@ -0,0 +1,53 @@
+package httputil
+
+import (
+	"net/http"
+	"testing"
+)
+
+func Test_redactBody(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		contentType string
+		want        string
+	}{
+		{
+			name:        "nested JSON secrets",
+			body:        `{"name":"app","service_account":{"client_id":"id","client_secret":"s3cret"}}`,
+			contentType: "application/json",
+			want:        `{"name":"app","service_account":{"client_id":"id","client_secret":"REDACTED"}}`,
+		},
+		{
+			name:        "form encoded token request",
+			body:        "grant_type=refresh_token&refresh_token=abc",
+			contentType: "application/x-www-form-urlencoded",
+			want:        "grant_type=refresh_token&refresh_token=REDACTED",
+		},
+		{
+			name:        "plain text",
+			body:        "service unavailable",
+			contentType: "text/plain",
+			want:        "service unavailable",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactBody([]byte(tt.body), tt.contentType); got != tt.want {
+				t.Errorf("redactBody() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_operationID(t *testing.T) {
+	headers := http.Header{}
+	if got := operationID(headers, []byte(`{"kind":"Error","operation_id":"op-1"}`)); got != "op-1" {
+		t.Errorf("operationID() = %v, want op-1", got)
+	}
+
+	headers.Set("X-Operation-Id", "op-2")
+	if got := operationID(headers, []byte(`{"operation_id":"op-1"}`)); got != "op-2" {
+		t.Errorf("operationID() = %v, want op-2", got)
+	}
+}
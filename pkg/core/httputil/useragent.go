@@ -0,0 +1,85 @@
+package httputil
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// UserAgent builds the User-Agent header of the API requests.
+// It identifies the CLI version and the command being run, so that the traffic can be attributed,
+// and can be tagged with a suffix, for example to tell automation runs apart.
+type UserAgent struct {
+	mu      sync.Mutex
+	product string
+	command string
+	suffix  string
+}
+
+// NewUserAgent creates a User-Agent for the product, like "rhoas-cli_1.0.0"
+func NewUserAgent(product string) *UserAgent {
+	return &UserAgent{product: product}
+}
+
+// SetCommand sets the path of the command being run, like "rhoas kafka list"
+func (u *UserAgent) SetCommand(commandPath string) {
+	if u == nil {
+		return
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.command = sanitizeHeaderValue(commandPath)
+}
+
+// SetSuffix sets the text appended to the User-Agent
+func (u *UserAgent) SetSuffix(suffix string) {
+	if u == nil {
+		return
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.suffix = sanitizeHeaderValue(suffix)
+}
+
+// String returns the value of the User-Agent header, like "rhoas-cli_1.0.0 (command: rhoas kafka list) nightly-sync"
+func (u *UserAgent) String() string {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	value := u.product
+	if u.command != "" {
+		value += " (command: " + u.command + ")"
+	}
+	if u.suffix != "" {
+		value += " " + u.suffix
+	}
+	return value
+}
+
+// sanitizeHeaderValue replaces the characters which are not allowed in a header value
+func sanitizeHeaderValue(value string) string {
+	value = strings.Map(func(r rune) rune {
+		if r > unicode.MaxASCII || unicode.IsControl(r) {
+			return '_'
+		}
+		return r
+	}, value)
+	return strings.TrimSpace(value)
+}
+
+// UserAgentRoundTripper implements http.RoundTripper. It sets the User-Agent header of every request.
+type UserAgentRoundTripper struct {
+	Proxied   http.RoundTripper
+	UserAgent *UserAgent
+}
+
+// RoundTrip executes the request with the User-Agent header
+func (c UserAgentRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.Header.Set("User-Agent", c.UserAgent.String())
+
+	return c.Proxied.RoundTrip(r)
+}
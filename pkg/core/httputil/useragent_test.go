@@ -0,0 +1,47 @@
+package httputil
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUserAgentRoundTripper(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("User-Agent")
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		command string
+		suffix  string
+		want    string
+	}{
+		{name: "product only", want: "rhoas-cli_1.0.0"},
+		{name: "with command", command: "rhoas kafka list", want: "rhoas-cli_1.0.0 (command: rhoas kafka list)"},
+		{name: "with suffix", command: "rhoas kafka list", suffix: "nightly-sync", want: "rhoas-cli_1.0.0 (command: rhoas kafka list) nightly-sync"},
+		{name: "control characters are replaced", suffix: "job\r\nX-Injected: 1", want: "rhoas-cli_1.0.0 job__X-Injected: 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userAgent := NewUserAgent("rhoas-cli_1.0.0")
+			userAgent.SetCommand(tt.command)
+			userAgent.SetSuffix(tt.suffix)
+
+			client := &http.Client{Transport: UserAgentRoundTripper{Proxied: http.DefaultTransport, UserAgent: userAgent}}
+			req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+			req.Header.Set("User-Agent", "OpenAPI-Generator/1.0.0/go")
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if got != tt.want {
+				t.Errorf("User-Agent = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -32,7 +32,7 @@ func Error(format string) string {
 func Bold(s string) string {
 	// do not bold the string if the current OS is Windows
 	// Command Prompt does not support ANSI escape characters
-	if runtime.GOOS == "windows" {
+	if runtime.GOOS == "windows" || color.NoColor {
 		return s
 	}
 	return fmt.Sprintf("\033[1m%v\033[0m", s)
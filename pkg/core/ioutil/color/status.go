@@ -0,0 +1,113 @@
+package color
+
+import (
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// Names of the color themes
+const (
+	// ThemeDark is the default theme, using bright colors readable on dark backgrounds
+	ThemeDark = "dark"
+	// ThemeLight uses darker colors readable on light backgrounds
+	ThemeLight = "light"
+)
+
+// Themes are the names of the supported color themes
+var Themes = []string{ThemeDark, ThemeLight}
+
+// statusKind is the meaning of a status, which decides its color
+type statusKind int
+
+const (
+	statusUnknown statusKind = iota
+	statusReady
+	statusFailed
+	statusPending
+	statusInactive
+)
+
+// statusKinds maps the lower case statuses of the service resources to their meaning
+var statusKinds = map[string]statusKind{
+	"ready":     statusReady,
+	"running":   statusReady,
+	"succeeded": statusReady,
+	"passed":    statusReady,
+	"valid":     statusReady,
+	"stable":    statusReady,
+	"enabled":   statusReady,
+	"assigned":  statusReady,
+
+	"failed":  statusFailed,
+	"error":   statusFailed,
+	"invalid": statusFailed,
+	"dead":    statusFailed,
+
+	"accepted":             statusPending,
+	"preparing":            statusPending,
+	"provisioning":         statusPending,
+	"deprovision":          statusPending,
+	"deprovisioning":       statusPending,
+	"deleting":             statusPending,
+	"resuming":             statusPending,
+	"suspending":           statusPending,
+	"assigning":            statusPending,
+	"updating":             statusPending,
+	"preparing_rebalance":  statusPending,
+	"completing_rebalance": statusPending,
+
+	"suspended":  statusInactive,
+	"stopped":    statusInactive,
+	"deleted":    statusInactive,
+	"expired":    statusInactive,
+	"skipped":    statusInactive,
+	"disabled":   statusInactive,
+	"deprecated": statusInactive,
+	"empty":      statusInactive,
+}
+
+var themes = map[string]map[statusKind]*color.Color{
+	ThemeDark: {
+		statusReady:    color.New(color.FgHiGreen),
+		statusFailed:   color.New(color.FgHiRed),
+		statusPending:  color.New(color.FgHiYellow),
+		statusInactive: color.New(color.FgHiBlack),
+	},
+	ThemeLight: {
+		statusReady:    color.New(color.FgGreen),
+		statusFailed:   color.New(color.FgRed),
+		statusPending:  color.New(color.FgYellow, color.Bold),
+		statusInactive: color.New(color.FgHiBlack),
+	},
+}
+
+var statusColors = themes[ThemeDark]
+
+// SetTheme selects the colors of the statuses, unknown themes are ignored
+func SetTheme(theme string) {
+	if colors, ok := themes[theme]; ok {
+		statusColors = colors
+	}
+}
+
+// Disable turns off the colors of all output
+func Disable() {
+	color.NoColor = true
+}
+
+// Enabled returns whether the output is colored
+func Enabled() bool {
+	return !color.NoColor
+}
+
+// Status returns the status colored by its meaning: ready statuses are green, failed
+// statuses are red, transitional statuses are yellow and inactive statuses are gray.
+// Unknown statuses are returned unchanged.
+func Status(status string) string {
+	c, ok := statusColors[statusKinds[strings.ToLower(strings.TrimSpace(status))]]
+	if !ok {
+		return status
+	}
+	return c.Sprint(status)
+}
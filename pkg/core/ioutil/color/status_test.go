@@ -0,0 +1,47 @@
+package color
+
+import (
+	"testing"
+
+	"github.com/fatih/color"
+)
+
+func TestStatus(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = false
+	defer func() { color.NoColor = noColor }()
+
+	tests := []struct {
+		name   string
+		theme  string
+		status string
+		want   string
+	}{
+		{name: "ready", theme: ThemeDark, status: "ready", want: "\x1b[92mready\x1b[0m"},
+		{name: "failed", theme: ThemeDark, status: "failed", want: "\x1b[91mfailed\x1b[0m"},
+		{name: "provisioning", theme: ThemeDark, status: "provisioning", want: "\x1b[93mprovisioning\x1b[0m"},
+		{name: "suspended", theme: ThemeDark, status: "suspended", want: "\x1b[90msuspended\x1b[0m"},
+		{name: "upper case", theme: ThemeDark, status: "STABLE", want: "\x1b[92mSTABLE\x1b[0m"},
+		{name: "unknown", theme: ThemeDark, status: "degraded", want: "degraded"},
+		{name: "light theme", theme: ThemeLight, status: "ready", want: "\x1b[32mready\x1b[0m"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetTheme(tt.theme)
+			defer SetTheme(ThemeDark)
+			if got := Status(tt.status); got != tt.want {
+				t.Errorf("Status() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatusNoColor(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = noColor }()
+
+	if got := Status("ready"); got != "ready" {
+		t.Errorf("Status() = %q, want %q", got, "ready")
+	}
+}
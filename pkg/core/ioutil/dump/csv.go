@@ -0,0 +1,57 @@
+package dump
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// CSVFormat is the output format for comma-separated values
+const CSVFormat = "csv"
+
+// CSV prints the given slice of structs as comma-separated values.
+// As with Table, only properties that have a `header` tag will be printed
+// and the tag values are used for the header record.
+func CSV(stream io.Writer, in interface{}) error {
+	value := reflect.Indirect(reflect.ValueOf(in))
+	if value.Kind() != reflect.Slice {
+		return fmt.Errorf("CSV output requires a slice, got %v", value.Kind())
+	}
+
+	elemType := value.Type().Elem()
+	if elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		return fmt.Errorf("CSV output requires a slice of structs, got a slice of %v", elemType.Kind())
+	}
+
+	var header []string
+	var fields []int
+	for i := 0; i < elemType.NumField(); i++ {
+		if name, ok := elemType.Field(i).Tag.Lookup("header"); ok {
+			header = append(header, name)
+			fields = append(fields, i)
+		}
+	}
+
+	w := csv.NewWriter(stream)
+	if err := w.Write(header); err != nil {
+		return err
+	}
+
+	for i := 0; i < value.Len(); i++ {
+		elem := reflect.Indirect(value.Index(i))
+		record := make([]string, len(fields))
+		for j, field := range fields {
+			record[j] = fmt.Sprint(elem.Field(field).Interface())
+		}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,54 @@
+package dump
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCSV(t *testing.T) {
+	type row struct {
+		Name    string `header:"Name"`
+		Count   int    `header:"Count"`
+		Ignored string
+	}
+
+	tests := []struct {
+		name    string
+		in      interface{}
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "prints header and rows",
+			in:   []row{{Name: "foo", Count: 1, Ignored: "x"}, {Name: "bar, baz", Count: 2}},
+			want: "Name,Count\nfoo,1\n\"bar, baz\",2\n",
+		},
+		{
+			name: "prints only the header for empty slices",
+			in:   []row{},
+			want: "Name,Count\n",
+		},
+		{
+			name: "supports slices of pointers",
+			in:   []*row{{Name: "foo", Count: 1}},
+			want: "Name,Count\nfoo,1\n",
+		},
+		{
+			name:    "rejects values which are not slices",
+			in:      row{Name: "foo"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := CSV(&buf, tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CSV() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := buf.String(); !tt.wantErr && got != tt.want {
+				t.Errorf("CSV() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
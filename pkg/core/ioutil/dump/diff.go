@@ -0,0 +1,154 @@
+package dump
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+)
+
+// Diff is the document printed by update commands run with the "--dry-run" flag.
+// It holds the resource before and after the update, and the changes between them,
+// so that the update can be reviewed before it is applied.
+type Diff struct {
+	Kind    string       `json:"kind" yaml:"kind"`
+	Name    string       `json:"name" yaml:"name"`
+	Before  interface{}  `json:"before" yaml:"before"`
+	After   interface{}  `json:"after" yaml:"after"`
+	Changes []DiffChange `json:"changes" yaml:"changes"`
+}
+
+// DiffChange is the change of a single field. Nested fields are joined with dots, like "kafka.id".
+// The value is null on the side where the field is not set.
+type DiffChange struct {
+	Field  string      `json:"field" yaml:"field"`
+	Before interface{} `json:"before" yaml:"before"`
+	After  interface{} `json:"after" yaml:"after"`
+}
+
+// diffRow is a change printed as a table row
+type diffRow struct {
+	Field  string `header:"Field"`
+	Before string `header:"Before"`
+	After  string `header:"After"`
+}
+
+// NewDiff creates a Diff between the before and after states of a resource.
+// The states are compared in their JSON form, so they can be API models or maps of the updated fields.
+func NewDiff(kind string, name string, before interface{}, after interface{}) (*Diff, error) {
+	beforeValue, err := normalize(before)
+	if err != nil {
+		return nil, err
+	}
+	afterValue, err := normalize(after)
+	if err != nil {
+		return nil, err
+	}
+
+	beforeFields := map[string]interface{}{}
+	flatten("", beforeValue, beforeFields)
+	afterFields := map[string]interface{}{}
+	flatten("", afterValue, afterFields)
+
+	fieldSet := map[string]bool{}
+	for field := range beforeFields {
+		fieldSet[field] = true
+	}
+	for field := range afterFields {
+		fieldSet[field] = true
+	}
+	fields := make([]string, 0, len(fieldSet))
+	for field := range fieldSet {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	changes := []DiffChange{}
+	for _, field := range fields {
+		if !reflect.DeepEqual(beforeFields[field], afterFields[field]) {
+			changes = append(changes, DiffChange{Field: field, Before: beforeFields[field], After: afterFields[field]})
+		}
+	}
+
+	return &Diff{
+		Kind:    kind,
+		Name:    name,
+		Before:  beforeValue,
+		After:   afterValue,
+		Changes: changes,
+	}, nil
+}
+
+// HasChanges returns true when the update changes at least one field
+func (d *Diff) HasChanges() bool {
+	return len(d.Changes) > 0
+}
+
+// PrintDiff prints the diff in the given format, or its changes as a table when no format is given
+func PrintDiff(writer io.Writer, format string, diff *Diff) error {
+	if format != EmptyFormat {
+		return Formatted(writer, format, diff)
+	}
+
+	rows := make([]diffRow, 0, len(diff.Changes))
+	for _, change := range diff.Changes {
+		rows = append(rows, diffRow{
+			Field:  change.Field,
+			Before: diffValue(change.Before),
+			After:  diffValue(change.After),
+		})
+	}
+	Table(writer, rows)
+
+	return nil
+}
+
+// normalize converts a value to its JSON form, made of maps, slices and scalars
+func normalize(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	err = json.Unmarshal(data, &value)
+	return value, err
+}
+
+// flatten collects the leaf values of the nested objects of value, keyed by their dotted path.
+// Lists are compared as a whole.
+func flatten(prefix string, value interface{}, fields map[string]interface{}) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		if prefix != "" {
+			fields[prefix] = value
+		}
+		return
+	}
+
+	for key, child := range object {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		flatten(key, child, fields)
+	}
+}
+
+// diffValue formats a value for a table cell
+func diffValue(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return OrPlaceholder("")
+	case string:
+		return OrPlaceholder(value)
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Sprint(value)
+		}
+		return string(data)
+	default:
+		return fmt.Sprint(value)
+	}
+}
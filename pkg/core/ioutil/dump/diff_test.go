@@ -0,0 +1,62 @@
+package dump
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewDiff(t *testing.T) {
+	type kafka struct {
+		ID  string `json:"id"`
+		URL string `json:"url,omitempty"`
+	}
+	type connector struct {
+		Name   string   `json:"name"`
+		Kafka  kafka    `json:"kafka"`
+		Labels []string `json:"labels"`
+	}
+
+	tests := []struct {
+		name        string
+		before      interface{}
+		after       interface{}
+		wantChanges string
+	}{
+		{
+			name:        "no changes",
+			before:      map[string]interface{}{"owner": "alice"},
+			after:       map[string]interface{}{"owner": "alice"},
+			wantChanges: `[]`,
+		},
+		{
+			name:        "changed fields are sorted",
+			before:      map[string]interface{}{"retention.ms": "100", "cleanup.policy": "delete", "partitions": 1},
+			after:       map[string]interface{}{"retention.ms": "200", "cleanup.policy": "compact", "partitions": 1},
+			wantChanges: `[{"field":"cleanup.policy","before":"delete","after":"compact"},{"field":"retention.ms","before":"100","after":"200"}]`,
+		},
+		{
+			name:        "nested fields are joined with dots",
+			before:      connector{Name: "c1", Kafka: kafka{ID: "k1"}, Labels: []string{"a"}},
+			after:       connector{Name: "c1", Kafka: kafka{ID: "k2", URL: "k2:443"}, Labels: []string{"a", "b"}},
+			wantChanges: `[{"field":"kafka.id","before":"k1","after":"k2"},{"field":"kafka.url","before":null,"after":"k2:443"},{"field":"labels","before":["a"],"after":["a","b"]}]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff, err := NewDiff("Test", "test", tt.before, tt.after)
+			if err != nil {
+				t.Fatalf("NewDiff() error = %v", err)
+			}
+			gotChanges, err := json.Marshal(diff.Changes)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if string(gotChanges) != tt.wantChanges {
+				t.Errorf("NewDiff().Changes = %s, want %s", gotChanges, tt.wantChanges)
+			}
+			if diff.HasChanges() != (tt.wantChanges != `[]`) {
+				t.Errorf("HasChanges() = %v", diff.HasChanges())
+			}
+		})
+	}
+}
@@ -1,4 +1,4 @@
-// Package dump contains functions used to print documents to JSON, YAML and Table formats
+// Package dump contains functions used to print documents to JSON, YAML, CSV and Table formats
 package dump
 
 import (
@@ -12,7 +12,6 @@ import (
 
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
 
-	"github.com/landoop/tableprinter"
 	"gitlab.com/c0b/go-ordered-json"
 	"gopkg.in/yaml.v2"
 )
@@ -61,13 +60,6 @@ func YAML(stream io.Writer, body []byte) error {
 	return dumpYAML(stream, data)
 }
 
-// Table prints the given data into a formatted table. Only properties that have a `header`
-// tag will be printed. See https://github.com/lensesio/tableprinter
-func Table(stream io.Writer, in interface{}) {
-	printer := tableprinter.New(stream)
-	printer.Print(in)
-}
-
 func dumpBytes(stream io.Writer, data []byte) error {
 	_, err := stream.Write(data)
 	if err != nil {
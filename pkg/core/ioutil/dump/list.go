@@ -0,0 +1,29 @@
+package dump
+
+import "reflect"
+
+// List is the document printed by list commands in JSON and YAML formats.
+// All of its fields are always printed, so scripts can rely on them
+// no matter which of them the API returned.
+type List struct {
+	Kind  string      `json:"kind" yaml:"kind"`
+	Page  int32       `json:"page" yaml:"page"`
+	Size  int32       `json:"size" yaml:"size"`
+	Total int32       `json:"total" yaml:"total"`
+	Items interface{} `json:"items" yaml:"items"`
+}
+
+// NewList creates a List for a page of items.
+// A nil slice of items is printed as an empty list rather than null.
+func NewList(kind string, page int32, size int32, total int32, items interface{}) *List {
+	if v := reflect.ValueOf(items); v.Kind() == reflect.Slice && v.IsNil() {
+		items = reflect.MakeSlice(v.Type(), 0, 0).Interface()
+	}
+	return &List{
+		Kind:  kind,
+		Page:  page,
+		Size:  size,
+		Total: total,
+		Items: items,
+	}
+}
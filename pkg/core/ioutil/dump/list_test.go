@@ -0,0 +1,52 @@
+package dump
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+func TestNewList(t *testing.T) {
+	type item struct {
+		Name string `json:"name" yaml:"name"`
+	}
+
+	tests := []struct {
+		name     string
+		list     *List
+		wantJSON string
+		wantYAML string
+	}{
+		{
+			name:     "prints all fields",
+			list:     NewList("ItemList", 1, 10, 1, []item{{Name: "foo"}}),
+			wantJSON: `{"kind":"ItemList","page":1,"size":10,"total":1,"items":[{"name":"foo"}]}`,
+			wantYAML: "kind: ItemList\npage: 1\nsize: 10\ntotal: 1\nitems:\n- name: foo\n",
+		},
+		{
+			name:     "prints nil items as an empty list",
+			list:     NewList("ItemList", 2, 10, 0, []item(nil)),
+			wantJSON: `{"kind":"ItemList","page":2,"size":10,"total":0,"items":[]}`,
+			wantYAML: "kind: ItemList\npage: 2\nsize: 10\ntotal: 0\nitems: []\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotJSON, err := json.Marshal(tt.list)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if string(gotJSON) != tt.wantJSON {
+				t.Errorf("json.Marshal() = %s, want %s", gotJSON, tt.wantJSON)
+			}
+			gotYAML, err := yaml.Marshal(tt.list)
+			if err != nil {
+				t.Fatalf("yaml.Marshal() error = %v", err)
+			}
+			if string(gotYAML) != tt.wantYAML {
+				t.Errorf("yaml.Marshal() = %q, want %q", gotYAML, tt.wantYAML)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package dump
+
+// Placeholder is printed in tables in place of values which are not set
+const Placeholder = "-"
+
+// OrPlaceholder returns the value, or Placeholder when the value is empty
+func OrPlaceholder(value string) string {
+	if value == "" {
+		return Placeholder
+	}
+	return value
+}
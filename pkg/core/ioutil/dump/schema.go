@@ -0,0 +1,177 @@
+package dump
+
+import (
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// SchemaDraft is the JSON Schema dialect of the documents returned by Schema
+const SchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+// JSONSchema is the subset of JSON Schema needed to describe command output
+type JSONSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Type                 interface{}            `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	Items                *JSONSchema            `json:"items,omitempty"`
+	AdditionalProperties *JSONSchema            `json:"additionalProperties,omitempty"`
+}
+
+var (
+	timeType    = reflect.TypeOf(time.Time{})
+	rawJSONType = reflect.TypeOf(json.RawMessage{})
+)
+
+// Schema returns the JSON Schema of the document printed when v is dumped as JSON.
+// Field names and optionality come from the `json` struct tags and the title of a
+// property from its `header` tag. Interface fields are described by the type of the
+// value they hold, so a List created with NewList describes the type of its items.
+func Schema(v interface{}) *JSONSchema {
+	s := schemaOf(reflect.ValueOf(v), map[reflect.Type]bool{})
+	s.Schema = SchemaDraft
+	return s
+}
+
+// PrintSchema prints the JSON Schema of v to the given stream
+func PrintSchema(stream io.Writer, v interface{}) error {
+	return Formatted(stream, JSONFormat, Schema(v))
+}
+
+func schemaOf(v reflect.Value, seen map[reflect.Type]bool) *JSONSchema {
+	if !v.IsValid() {
+		return &JSONSchema{}
+	}
+	t := v.Type()
+
+	switch t.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return &JSONSchema{}
+		}
+		return schemaOf(v.Elem(), seen)
+	case reflect.Ptr:
+		if v.IsNil() {
+			return schemaOf(reflect.Zero(t.Elem()), seen)
+		}
+		return schemaOf(v.Elem(), seen)
+	}
+
+	if t == timeType {
+		return &JSONSchema{Type: "string", Format: "date-time"}
+	}
+	if t == rawJSONType {
+		return &JSONSchema{}
+	}
+	if s, ok := nullableSchema(t, seen); ok {
+		return s
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &JSONSchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &JSONSchema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &JSONSchema{Type: "number"}
+	case reflect.String:
+		return &JSONSchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &JSONSchema{Type: "string", Format: "byte"}
+		}
+		return &JSONSchema{Type: "array", Items: schemaOf(reflect.Zero(t.Elem()), seen)}
+	case reflect.Map:
+		return &JSONSchema{Type: "object", AdditionalProperties: schemaOf(reflect.Zero(t.Elem()), seen)}
+	case reflect.Struct:
+		// recursive types are not expanded a second time
+		if seen[t] {
+			return &JSONSchema{Type: "object"}
+		}
+		seen[t] = true
+		defer delete(seen, t)
+
+		s := &JSONSchema{Type: "object", Properties: map[string]*JSONSchema{}}
+		addStructFields(s, v, seen)
+		return s
+	}
+
+	return &JSONSchema{}
+}
+
+func addStructFields(s *JSONSchema, v reflect.Value, seen map[reflect.Type]bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		fieldValue := v.Field(i)
+		if field.Anonymous && name == "" {
+			embedded := fieldValue
+			if embedded.Kind() == reflect.Ptr {
+				embedded = reflect.Zero(field.Type.Elem())
+			}
+			if embedded.Kind() == reflect.Struct {
+				addStructFields(s, embedded, seen)
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		prop := schemaOf(fieldValue, seen)
+		omitempty := strings.Contains(","+opts+",", ",omitempty,")
+		// a nil pointer is printed as null unless it is omitted
+		if field.Type.Kind() == reflect.Ptr && !omitempty {
+			setNullable(prop)
+		}
+		if header := field.Tag.Get("header"); header != "" {
+			prop.Title = header
+		}
+		s.Properties[name] = prop
+
+		if !omitempty {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+// nullableSchema describes the Nullable types of the generated API clients,
+// which are printed as the value returned by their Get method or as null
+func nullableSchema(t reflect.Type, seen map[reflect.Type]bool) (*JSONSchema, bool) {
+	if !strings.HasPrefix(t.Name(), "Nullable") {
+		return nil, false
+	}
+	get, ok := reflect.PtrTo(t).MethodByName("Get")
+	if !ok || get.Type.NumIn() != 1 || get.Type.NumOut() != 1 {
+		return nil, false
+	}
+
+	s := schemaOf(reflect.Zero(get.Type.Out(0)), seen)
+	setNullable(s)
+	return s, true
+}
+
+func setNullable(s *JSONSchema) {
+	if typ, ok := s.Type.(string); ok {
+		s.Type = []string{typ, "null"}
+	}
+}
@@ -0,0 +1,81 @@
+package dump
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// NullableString mimics the Nullable types of the generated API clients
+type NullableString struct {
+	value *string
+}
+
+func (v *NullableString) Get() *string {
+	return v.value
+}
+
+func TestSchema(t *testing.T) {
+	type base struct {
+		ID string `json:"id"`
+	}
+	type row struct {
+		base
+		Name      string            `json:"name" header:"Name"`
+		Count     *int32            `json:"count,omitempty"`
+		Leader    *int32            `json:"leader"`
+		CreatedAt time.Time         `json:"created_at"`
+		Labels    map[string]string `json:"labels,omitempty"`
+		Ignored   string            `json:"-"`
+		hidden    string
+	}
+
+	tests := []struct {
+		name  string
+		model interface{}
+		want  string
+	}{
+		{
+			name:  "struct fields",
+			model: row{},
+			want: `{"type":"object","properties":{` +
+				`"count":{"type":"integer"},` +
+				`"created_at":{"type":"string","format":"date-time"},` +
+				`"id":{"type":"string"},` +
+				`"labels":{"type":"object","additionalProperties":{"type":"string"}},` +
+				`"leader":{"type":["integer","null"]},` +
+				`"name":{"title":"Name","type":"string"}},` +
+				`"required":["id","name","leader","created_at"]}`,
+		},
+		{
+			name:  "list items",
+			model: NewList("RowList", 0, 0, 0, []base{}),
+			want: `{"type":"object","properties":{` +
+				`"items":{"type":"array","items":{"type":"object","properties":{"id":{"type":"string"}},"required":["id"]}},` +
+				`"kind":{"type":"string"},"page":{"type":"integer"},"size":{"type":"integer"},"total":{"type":"integer"}},` +
+				`"required":["kind","page","size","total","items"]}`,
+		},
+		{
+			name:  "nullable type",
+			model: NullableString{},
+			want:  `{"type":["string","null"]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Schema(tt.model)
+			if s.Schema != SchemaDraft {
+				t.Errorf("Schema() $schema = %q, want %q", s.Schema, SchemaDraft)
+			}
+			s.Schema = ""
+
+			got, err := json.Marshal(s)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Schema() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
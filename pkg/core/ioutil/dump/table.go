@@ -0,0 +1,200 @@
+package dump
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/landoop/tableprinter"
+	"github.com/mattn/go-runewidth"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/color"
+	"golang.org/x/term"
+)
+
+const (
+	// ellipsis is appended to the table cells truncated to fit the terminal
+	ellipsis = "…"
+	// minColumnWidth is the width under which columns are never truncated
+	minColumnWidth = 8
+	// rowLengthTitleMin is the number of rows above which the printer adds the row count to the first header
+	rowLengthTitleMin = 3
+)
+
+// statusHeaders are the lower case headers of the columns colored by color.Status
+var statusHeaders = map[string]bool{"status": true, "state": true}
+
+// NoTruncate disables the truncation of table cells to the width of the terminal.
+// It is bound to the global "--no-truncate" flag.
+var NoTruncate bool
+
+// Table prints the given data into a formatted table. Only properties that have a `header`
+// tag will be printed. See https://github.com/lensesio/tableprinter
+//
+// Cells holding a timestamp are printed relative to now, such as "3d ago", unless Timestamps is set,
+// which is the case when the standard output is not a terminal.
+//
+// When the stream is a terminal, the longest text columns are truncated with an ellipsis so that
+// each row fits on a single line, unless NoTruncate is set, and the statuses are colored.
+// Cells are never wrapped, so tables written to files or pipes always contain the full values.
+func Table(stream io.Writer, in interface{}) {
+	v := reflect.ValueOf(in)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	parser := tableprinter.WhichParser(v.Type())
+	if parser == nil {
+		return
+	}
+
+	headers, rows, nums := parser.Parse(v, nil)
+	if len(headers) == 0 && len(rows) == 0 {
+		return
+	}
+
+	if !Timestamps {
+		formatTimes(rows, time.Now())
+	}
+
+	if width := terminalWidth(stream); width > 0 {
+		if !NoTruncate {
+			fitTable(headers, rows, nums, width)
+		}
+		colorStatuses(headers, rows)
+	}
+
+	printer := tableprinter.New(stream)
+	printer.RowCharLimit = 0
+	printer.Render(headers, rows, nums, true)
+}
+
+// terminalWidth returns the width of the terminal the stream writes to, or 0 if it is not a terminal
+func terminalWidth(stream io.Writer) int {
+	// streams wrapping a terminal, such as a pager, report the width themselves
+	if w, ok := stream.(interface{ TerminalWidth() int }); ok {
+		return w.TerminalWidth()
+	}
+
+	file, ok := stream.(*os.File)
+	if !ok || !term.IsTerminal(int(file.Fd())) {
+		return 0
+	}
+
+	width, _, err := term.GetSize(int(file.Fd()))
+	if err != nil {
+		return 0
+	}
+	return width
+}
+
+// fitTable truncates the cells of the widest text columns so that the rendered table
+// is not wider than maxWidth. Numeric columns and headers are never truncated.
+func fitTable(headers []string, rows [][]string, nums []int, maxWidth int) {
+	columns := len(headers)
+	for _, row := range rows {
+		if len(row) > columns {
+			columns = len(row)
+		}
+	}
+	if columns == 0 {
+		return
+	}
+
+	widths := make([]int, columns)
+	minWidths := make([]int, columns)
+	for i := range headers {
+		header := headers[i]
+		if i == 0 && len(rows) > rowLengthTitleMin {
+			// the printer appends the row count to the first header
+			header = fmt.Sprintf("%s (%d) ", header, len(rows))
+		}
+		minWidths[i] = cellWidth(header)
+	}
+	for i := range minWidths {
+		if minWidths[i] < minColumnWidth {
+			minWidths[i] = minColumnWidth
+		}
+	}
+	for _, i := range nums {
+		if i < columns {
+			minWidths[i] = maxWidth
+		}
+	}
+
+	for _, row := range rows {
+		for i, cell := range row {
+			if w := cellWidth(cell); w > widths[i] {
+				widths[i] = w
+			}
+		}
+	}
+
+	// each column is padded by a space on both sides and separated by a space
+	available := maxWidth - (3*columns + 1)
+	total := 0
+	for _, w := range widths {
+		total += w
+	}
+
+	for total > available {
+		widest := -1
+		for i, w := range widths {
+			if w > minWidths[i] && (widest < 0 || w > widths[widest]) {
+				widest = i
+			}
+		}
+		if widest < 0 {
+			break
+		}
+		widths[widest]--
+		total--
+	}
+
+	for _, row := range rows {
+		for i, cell := range row {
+			if cellWidth(cell) > widths[i] {
+				row[i] = truncateCell(cell, widths[i])
+			}
+		}
+	}
+}
+
+// colorStatuses colors the cells of the status columns by the meaning of the status
+func colorStatuses(headers []string, rows [][]string) {
+	for i, header := range headers {
+		if !statusHeaders[strings.ToLower(header)] {
+			continue
+		}
+		for _, row := range rows {
+			if i < len(row) {
+				row[i] = color.Status(row[i])
+			}
+		}
+	}
+}
+
+// cellWidth returns the display width of the widest line of the cell
+func cellWidth(cell string) int {
+	width := 0
+	for _, line := range strings.Split(cell, "\n") {
+		if w := runewidth.StringWidth(line); w > width {
+			width = w
+		}
+	}
+	return width
+}
+
+// truncateCell truncates each line of the cell to the given display width
+func truncateCell(cell string, width int) string {
+	lines := strings.Split(cell, "\n")
+	for i, line := range lines {
+		lines[i] = runewidth.Truncate(line, width, ellipsis)
+	}
+	return strings.Join(lines, "\n")
+}
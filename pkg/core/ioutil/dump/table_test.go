@@ -0,0 +1,55 @@
+package dump
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_fitTable(t *testing.T) {
+	tests := []struct {
+		name     string
+		headers  []string
+		rows     [][]string
+		nums     []int
+		maxWidth int
+		want     [][]string
+	}{
+		{
+			name:     "table fits",
+			headers:  []string{"ID", "Name"},
+			rows:     [][]string{{"1", "my-kafka"}},
+			maxWidth: 80,
+			want:     [][]string{{"1", "my-kafka"}},
+		},
+		{
+			name:     "widest column is truncated",
+			headers:  []string{"Name", "Bootstrap Host"},
+			rows:     [][]string{{"my-kafka", "my-kafka-abcdefghijklmnop.bf2.kafka.example.com:443"}},
+			maxWidth: 40,
+			want:     [][]string{{"my-kafka", "my-kafka-abcdefghijklmno…"}},
+		},
+		{
+			name:     "columns are not truncated below the minimum width",
+			headers:  []string{"Name", "Owner"},
+			rows:     [][]string{{"a-very-long-kafka-name", "an-owner-with-a-long-name"}},
+			maxWidth: 10,
+			want:     [][]string{{"a-very-…", "an-owne…"}},
+		},
+		{
+			name:     "numeric columns are not truncated",
+			headers:  []string{"Name", "Size"},
+			rows:     [][]string{{"a-very-long-topic-name", "123456789012"}},
+			nums:     []int{1},
+			maxWidth: 30,
+			want:     [][]string{{"a-very-lon…", "123456789012"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fitTable(tt.headers, tt.rows, tt.nums, tt.maxWidth)
+			if !reflect.DeepEqual(tt.rows, tt.want) {
+				t.Errorf("fitTable() rows = %q, want %q", tt.rows, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package dump
+
+import (
+	"fmt"
+	"time"
+)
+
+// Timestamps disables the display of times relative to now in tables.
+// It is bound to the global "--timestamps" flag.
+var Timestamps bool
+
+// timeLayouts are the layouts of the table cells which are displayed as relative times
+var timeLayouts = []string{
+	time.RFC3339,
+	// timestamps of the Service Registry API
+	"2006-01-02T15:04:05Z0700",
+}
+
+// relativeTimeUnits are the units of relative times, from the largest
+var relativeTimeUnits = []struct {
+	suffix string
+	size   time.Duration
+}{
+	{"y", 365 * 24 * time.Hour},
+	{"d", 24 * time.Hour},
+	{"h", time.Hour},
+	{"m", time.Minute},
+	{"s", time.Second},
+}
+
+// RelativeTime returns the time from now to t in its largest whole unit, such as "3d ago" or "in 2h"
+func RelativeTime(t time.Time, now time.Time) string {
+	d := t.Sub(now)
+	future := d > 0
+	if !future {
+		d = -d
+	}
+	if d < time.Second {
+		return "now"
+	}
+
+	var value string
+	for _, unit := range relativeTimeUnits {
+		if d >= unit.size {
+			value = fmt.Sprintf("%d%s", d/unit.size, unit.suffix)
+			break
+		}
+	}
+
+	if future {
+		return "in " + value
+	}
+	return value + " ago"
+}
+
+// formatTimes replaces the cells holding a timestamp with the time relative to now
+func formatTimes(rows [][]string, now time.Time) {
+	for _, row := range rows {
+		for i, cell := range row {
+			if t, ok := parseTime(cell); ok {
+				row[i] = RelativeTime(t, now)
+			}
+		}
+	}
+}
+
+// parseTime parses a table cell holding only a timestamp
+func parseTime(cell string) (time.Time, bool) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, cell); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,48 @@
+package dump
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRelativeTime(t *testing.T) {
+	now := time.Date(2022, 5, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		t    time.Time
+		want string
+	}{
+		{name: "now", t: now.Add(300 * time.Millisecond), want: "now"},
+		{name: "seconds ago", t: now.Add(-45 * time.Second), want: "45s ago"},
+		{name: "minutes ago", t: now.Add(-90 * time.Second), want: "1m ago"},
+		{name: "hours ago", t: now.Add(-5 * time.Hour), want: "5h ago"},
+		{name: "days ago", t: now.Add(-76 * time.Hour), want: "3d ago"},
+		{name: "years ago", t: now.AddDate(-2, 0, -1), want: "2y ago"},
+		{name: "in hours", t: now.Add(2*time.Hour + 10*time.Minute), want: "in 2h"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RelativeTime(tt.t, now); got != tt.want {
+				t.Errorf("RelativeTime() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_formatTimes(t *testing.T) {
+	now := time.Date(2022, 5, 10, 12, 0, 0, 0, time.UTC)
+	rows := [][]string{
+		{"my-job", "2022-05-10T11:30:00Z", "2022-05-07T12:00:00+0000"},
+		{"2022", "-", "2022-05-10 11:30:00"},
+	}
+	want := [][]string{
+		{"my-job", "30m ago", "3d ago"},
+		{"2022", "-", "2022-05-10 11:30:00"},
+	}
+
+	formatTimes(rows, now)
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("formatTimes() rows = %q, want %q", rows, want)
+	}
+}
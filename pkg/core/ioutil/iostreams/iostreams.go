@@ -25,6 +25,11 @@ type IOStreams struct {
 
 	// indicate whether interactive input should be disabled
 	neverPrompt bool
+
+	pagerCommand string
+	pagerProcess *os.Process
+	pagerIn      io.WriteCloser
+	unpagedOut   io.Writer
 }
 
 func (s *IOStreams) SetStdinTTY(isTTY bool) {
@@ -0,0 +1,132 @@
+package iostreams
+
+import (
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"syscall"
+
+	"golang.org/x/term"
+)
+
+// SetPager sets the command used to page long output. An empty command disables paging.
+func (s *IOStreams) SetPager(cmd string) {
+	s.pagerCommand = cmd
+}
+
+// GetPager returns the command used to page long output
+func (s *IOStreams) GetPager() string {
+	return s.pagerCommand
+}
+
+// EnablePager pipes the output written to Out through the pager, when a pager is set and the output
+// is a terminal. It is only enabled for the commands which opt in with cmdutil.PagerAnnotation.
+// The pager is started by the first write to Out, so that commands which print nothing,
+// or only prompt, do not open it. StopPager must be called once the command has returned.
+func (s *IOStreams) EnablePager() {
+	if s.pagerCommand == "" || s.pagerCommand == "cat" || !s.IsStdoutTTY() || s.unpagedOut != nil {
+		return
+	}
+
+	s.unpagedOut = s.Out
+	s.Out = &lazyPagerWriter{streams: s}
+}
+
+// StopPager closes the pager enabled by EnablePager and waits for the user to exit it
+func (s *IOStreams) StopPager() {
+	if s.unpagedOut == nil {
+		return
+	}
+
+	if s.pagerProcess != nil {
+		_ = s.pagerIn.Close()
+		_, _ = s.pagerProcess.Wait()
+	}
+
+	s.Out = s.unpagedOut
+	s.unpagedOut = nil
+	s.pagerIn = nil
+	s.pagerProcess = nil
+}
+
+// startPager starts the pager process, which writes to the output the pager was enabled for
+func (s *IOStreams) startPager() error {
+	pagerCmd := pagerShellCommand(s.pagerCommand)
+	pagerCmd.Env = os.Environ()
+	// make less exit when the output fits on one screen, so only long output is paged
+	if _, ok := os.LookupEnv("LESS"); !ok {
+		pagerCmd.Env = append(pagerCmd.Env, "LESS=FRX")
+	}
+	if _, ok := os.LookupEnv("LV"); !ok {
+		pagerCmd.Env = append(pagerCmd.Env, "LV=-c")
+	}
+	pagerCmd.Stdout = s.unpagedOut
+	pagerCmd.Stderr = s.ErrOut
+
+	pipe, err := pagerCmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	if err = pagerCmd.Start(); err != nil {
+		return err
+	}
+
+	s.pagerIn = &pagerWriter{WriteCloser: pipe}
+	s.pagerProcess = pagerCmd.Process
+
+	return nil
+}
+
+// lazyPagerWriter starts the pager on the first write.
+// When the pager cannot be started, the output is written without it.
+type lazyPagerWriter struct {
+	streams *IOStreams
+	out     io.Writer
+}
+
+func (w *lazyPagerWriter) Write(p []byte) (int, error) {
+	if w.out == nil {
+		w.out = w.streams.unpagedOut
+		if err := w.streams.startPager(); err == nil {
+			w.out = w.streams.pagerIn
+		}
+	}
+	return w.out.Write(p)
+}
+
+// TerminalWidth returns the width of the terminal the output is written to,
+// so that tables can still be fitted to it when they are paged
+func (w *lazyPagerWriter) TerminalWidth() int {
+	file, ok := w.streams.originalOut.(*os.File)
+	if !ok {
+		return 0
+	}
+	width, _, err := term.GetSize(int(file.Fd()))
+	if err != nil {
+		return 0
+	}
+	return width
+}
+
+// pagerWriter writes to the standard input of the pager
+type pagerWriter struct {
+	io.WriteCloser
+}
+
+// Write ignores the errors raised when the user exits the pager before all output is written
+func (w *pagerWriter) Write(p []byte) (int, error) {
+	n, err := w.WriteCloser.Write(p)
+	if err != nil && (errors.Is(err, io.ErrClosedPipe) || errors.Is(err, syscall.EPIPE)) {
+		return len(p), nil
+	}
+	return n, err
+}
+
+func pagerShellCommand(command string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		return exec.Command("cmd", "/C", command)
+	}
+	return exec.Command("sh", "-c", command)
+}
@@ -0,0 +1,91 @@
+package iostreams
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"testing"
+)
+
+func newTestStreams(pager string, isTTY bool) (*IOStreams, *bytes.Buffer) {
+	out := &bytes.Buffer{}
+	s := &IOStreams{Out: out, ErrOut: &bytes.Buffer{}, originalOut: out}
+	s.SetStdoutTTY(isTTY)
+	s.SetPager(pager)
+	return s, out
+}
+
+func TestPager(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test pager is a POSIX shell command")
+	}
+
+	s, out := newTestStreams("tr a-z A-Z", true)
+	s.EnablePager()
+
+	fmt.Fprint(s.Out, "first ")
+	fmt.Fprint(s.Out, "second")
+	if s.pagerProcess == nil {
+		t.Fatal("the pager was not started by the first write")
+	}
+
+	s.StopPager()
+
+	if got := out.String(); got != "FIRST SECOND" {
+		t.Errorf("output = %q, want the output piped through the pager", got)
+	}
+	if s.Out != out {
+		t.Errorf("Out was not restored by StopPager")
+	}
+}
+
+func TestPager_notStarted(t *testing.T) {
+	tests := []struct {
+		name  string
+		pager string
+		isTTY bool
+		write bool
+	}{
+		{name: "no output", pager: "tr a-z A-Z", isTTY: true, write: false},
+		{name: "not a terminal", pager: "tr a-z A-Z", isTTY: false, write: true},
+		{name: "no pager", pager: "", isTTY: true, write: true},
+		{name: "cat", pager: "cat", isTTY: true, write: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, out := newTestStreams(tt.pager, tt.isTTY)
+			s.EnablePager()
+
+			if tt.write {
+				fmt.Fprint(s.Out, "output")
+			}
+			if s.pagerProcess != nil {
+				t.Errorf("the pager was started")
+			}
+
+			s.StopPager()
+
+			want := ""
+			if tt.write {
+				want = "output"
+			}
+			if got := out.String(); got != want {
+				t.Errorf("output = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestPager_startFailure(t *testing.T) {
+	s, out := newTestStreams("tr a-z A-Z", true)
+	s.EnablePager()
+	// the output is written directly when the pager cannot be started
+	s.pagerCommand = "\x00"
+
+	fmt.Fprint(s.Out, "output")
+	s.StopPager()
+
+	if got := out.String(); got != "output" {
+		t.Errorf("output = %q, want %q", got, "output")
+	}
+}
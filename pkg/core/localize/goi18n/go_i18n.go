@@ -8,6 +8,9 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"io/fs"
+	"path"
+	"strings"
+	"sync"
 
 	"gopkg.in/yaml.v2"
 
@@ -24,13 +27,30 @@ type Goi18n struct {
 	localizer *i18n.Localizer
 	format    string
 	path      string
+
+	onMissing func(id string, err error)
+	reported  sync.Map
 }
 
 type Config struct {
-	files    fs.FS
-	language *language.Tag
-	format   string
-	path     string
+	files     fs.FS
+	language  *language.Tag
+	format    string
+	path      string
+	onMissing func(id string, err error)
+}
+
+// NewConfigWithLanguage returns a configuration which uses
+// the default locale files with the given language
+func NewConfigWithLanguage(lang *language.Tag) *Config {
+	return &Config{language: lang}
+}
+
+// WithMissingMessageHandler makes the localizer resilient to messages which are missing or cannot be rendered:
+// instead of panicking, the localizer returns the message ID and calls handler once for each such message
+func (c *Config) WithMissingMessageHandler(handler func(id string, err error)) *Config {
+	c.onMissing = handler
+	return c
 }
 
 // New creates a new nicksnyder/go-i18n client.
@@ -39,6 +59,7 @@ type Config struct {
 // Default file path: locales
 // Default file format: toml (yaml and json are supported)
 // Default language: English
+// Messages which are not translated to the selected language fall back to English.
 func New(cfg *Config) (localize.Localizer, error) {
 	if cfg == nil {
 		cfg = &Config{}
@@ -55,18 +76,27 @@ func New(cfg *Config) (localize.Localizer, error) {
 		cfg.format = "toml"
 	}
 
-	bundle := i18n.NewBundle(*cfg.language)
+	bundle := i18n.NewBundle(*localize.GetDefaultLanguage())
 	loc := &Goi18n{
 		files:     cfg.files,
 		language:  cfg.language,
 		bundle:    bundle,
-		localizer: i18n.NewLocalizer(bundle),
+		localizer: i18n.NewLocalizer(bundle, cfg.language.String()),
 		format:    cfg.format,
 		path:      cfg.path,
+		onMissing: cfg.onMissing,
 	}
 
 	err := loc.load()
-	return loc, err
+	if err != nil {
+		return loc, err
+	}
+
+	if !loc.hasLanguage(*cfg.language) {
+		return loc, fmt.Errorf("no translations are available for language \"%v\"", cfg.language)
+	}
+
+	return loc, nil
 }
 
 // MustLocalize loads a i18n message from the file system
@@ -81,7 +111,7 @@ func (l *Goi18n) MustLocalize(id string, tmplEntries ...*localize.TemplateEntry)
 		templateData[t.Key] = t.Value
 	}
 	cfg := &i18n.LocalizeConfig{MessageID: id, PluralCount: 1, TemplateData: templateData}
-	return l.localizer.MustLocalize(cfg)
+	return l.localize(cfg)
 }
 
 // MustLocalizePlural loads a pluralized i18n message from the file system
@@ -96,7 +126,7 @@ func (l *Goi18n) MustLocalizePlural(id string, pluralCount int, tmplEntries ...*
 		templateData[t.Key] = t.Value
 	}
 	cfg := &i18n.LocalizeConfig{MessageID: id, PluralCount: pluralCount, TemplateData: templateData}
-	return l.localizer.MustLocalize(cfg)
+	return l.localize(cfg)
 }
 
 // MustLocalizeError loads a i18n message from the file system
@@ -108,6 +138,33 @@ func (l *Goi18n) MustLocalizeError(id string, tmplEntries ...*localize.TemplateE
 	return errors.New(l.MustLocalize(id, tmplEntries...))
 }
 
+// localize renders a message, falling back to the message ID when the localizer is resilient
+func (l *Goi18n) localize(cfg *i18n.LocalizeConfig) string {
+	if l.onMissing == nil {
+		return l.localizer.MustLocalize(cfg)
+	}
+
+	msg, err := l.localizer.Localize(cfg)
+	if err == nil {
+		return msg
+	}
+
+	if _, reported := l.reported.LoadOrStore(cfg.MessageID, true); !reported {
+		l.onMissing(cfg.MessageID, err)
+	}
+	if msg == "" {
+		return cfg.MessageID
+	}
+	return msg
+}
+
+// hasMessage checks if a message with the given ID has been loaded
+func (l *Goi18n) hasMessage(id string) bool {
+	_, err := l.localizer.Localize(&i18n.LocalizeConfig{MessageID: id, PluralCount: 1})
+	var notFound *i18n.MessageNotFoundErr
+	return !errors.As(err, &notFound)
+}
+
 // walk the file system and load each file into memory
 func (l *Goi18n) load() error {
 	return fs.WalkDir(l.files, l.path, func(path string, info fs.DirEntry, err error) error {
@@ -130,7 +187,7 @@ func (l *Goi18n) MustLocalizeFile(files fs.FS, path string) (err error) {
 	if err != nil {
 		return err
 	}
-	fileext := fmt.Sprintf("%v.%v", l.language.String(), l.format)
+	fileext := fmt.Sprintf("%v.%v", l.fileLanguage(path), l.format)
 	var unmarshalFunc i18n.UnmarshalFunc
 	switch l.format {
 	case "toml":
@@ -148,3 +205,27 @@ func (l *Goi18n) MustLocalizeFile(files fs.FS, path string) (err error) {
 
 	return
 }
+
+// fileLanguage returns the language of a message file,
+// which is the name of the directory it is stored in: "<path>/<language>/..."
+func (l *Goi18n) fileLanguage(filePath string) string {
+	relPath := strings.TrimPrefix(filePath, l.path)
+	relPath = strings.TrimPrefix(relPath, "/")
+
+	dir := strings.Split(relPath, "/")[0]
+	if dir == "" || dir == path.Base(relPath) {
+		return l.language.String()
+	}
+	return dir
+}
+
+// hasLanguage checks if any messages have been loaded for the base of the given language
+func (l *Goi18n) hasLanguage(lang language.Tag) bool {
+	langBase, _ := lang.Base()
+	for _, tag := range l.bundle.LanguageTags() {
+		if tagBase, _ := tag.Base(); tagBase == langBase {
+			return true
+		}
+	}
+	return false
+}
@@ -26,6 +26,7 @@ func TestGoi18n_MustLocalize(t *testing.T) {
 		name      string
 		fields    fields
 		args      args
+		resilient bool
 		want      string
 		wantErr   bool
 		wantPanic bool
@@ -90,6 +91,99 @@ func TestGoi18n_MustLocalize(t *testing.T) {
 			},
 			wantPanic: true,
 		},
+		{
+			name: "missing message with resilient localizer",
+			fields: fields{
+				path:   "locales",
+				format: "toml",
+				fs: fstest.MapFS{
+					"locales/en/active.en.toml": {
+						Data: []byte(`
+						[test-case-2]
+						one = 'test case {{.Number}}'
+						`),
+					},
+				},
+			},
+			args: args{
+				id: "test-case-4",
+			},
+			resilient: true,
+			want:      "test-case-4",
+		},
+		{
+			name: "invalid template with resilient localizer",
+			fields: fields{
+				path:   "locales",
+				format: "toml",
+				fs: fstest.MapFS{
+					"locales/en/active.en.toml": {
+						Data: []byte(`
+						[test-case-5]
+						one = 'test case {{.Number'
+						`),
+					},
+				},
+			},
+			args: args{
+				id: "test-case-5",
+			},
+			resilient: true,
+			want:      "test-case-5",
+		},
+		{
+			fields: fields{
+				path:     "locales",
+				format:   "toml",
+				language: &language.French,
+				fs: fstest.MapFS{
+					"locales/en/active.en.toml": {
+						Data: []byte(`
+						[message-1]
+						one = 'message 1'
+
+						[message-2]
+						one = 'message 2'
+						`),
+					},
+					"locales/fr/active.fr.toml": {
+						Data: []byte(`
+						[message-1]
+						one = 'message un'
+						`),
+					},
+				},
+			},
+			args: args{
+				id: "message-2",
+			},
+			want: "message 2",
+		},
+		{
+			fields: fields{
+				path:     "locales",
+				format:   "toml",
+				language: &language.French,
+				fs: fstest.MapFS{
+					"locales/en/active.en.toml": {
+						Data: []byte(`
+						[message-1]
+						one = 'message 1'
+						`),
+					},
+					"locales/fr/active.fr.toml": {
+						Data: []byte(`
+						[message-1]
+						one = 'message un'
+						`),
+					},
+				},
+			},
+			args: args{
+				id: "message-1",
+			},
+			want: "message un",
+		},
 	}
 	for _, tt := range tests {
 		// nolint:scopelint
@@ -100,6 +194,12 @@ func TestGoi18n_MustLocalize(t *testing.T) {
 				format:   tt.fields.format,
 				path:     tt.fields.path,
 			}
+			var missing []string
+			if tt.resilient {
+				cfg.WithMissingMessageHandler(func(id string, _ error) {
+					missing = append(missing, id)
+				})
+			}
 			l, err := New(cfg)
 			if tt.wantErr != (err != nil) {
 				t.Errorf("Goi18n.New(), wantErr = %v, got %v", tt.wantErr, err)
@@ -112,6 +212,13 @@ func TestGoi18n_MustLocalize(t *testing.T) {
 			if got := l.MustLocalize(tt.args.id, tt.args.tmplEntries...); got != tt.want {
 				t.Errorf("Goi18n.MustLocalize() = %v, want %v", got, tt.want)
 			}
+			if tt.resilient {
+				// a missing message is reported only once
+				_ = l.MustLocalize(tt.args.id, tt.args.tmplEntries...)
+				if len(missing) != 1 || missing[0] != tt.args.id {
+					t.Errorf("Goi18n.MustLocalize() reported missing messages %v, want [%v]", missing, tt.args.id)
+				}
+			}
 		})
 	}
 }
@@ -0,0 +1,70 @@
+package goi18n
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// sourceRoot is the root of the repository, relative to this package
+const sourceRoot = "../../../.."
+
+var localizeFuncs = map[string]bool{
+	"MustLocalize":       true,
+	"MustLocalizeError":  true,
+	"MustLocalizePlural": true,
+}
+
+// TestMessagesExist checks that all message IDs passed as literals to the localizer are in the default locale files,
+// so that a missing message is caught by the tests rather than by a panic of the CLI
+func TestMessagesExist(t *testing.T) {
+	l, err := New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loc := l.(*Goi18n)
+
+	fset := token.NewFileSet()
+	for _, dir := range []string{"cmd", "internal", "pkg"} {
+		err = filepath.WalkDir(filepath.Join(sourceRoot, dir), func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+				return err
+			}
+
+			file, err := parser.ParseFile(fset, path, nil, 0)
+			if err != nil {
+				return err
+			}
+
+			ast.Inspect(file, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok || len(call.Args) == 0 {
+					return true
+				}
+				sel, ok := call.Fun.(*ast.SelectorExpr)
+				if !ok || !localizeFuncs[sel.Sel.Name] {
+					return true
+				}
+				lit, ok := call.Args[0].(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					return true
+				}
+				id, err := strconv.Unquote(lit.Value)
+				if err == nil && !loc.hasMessage(id) {
+					t.Errorf("%v: message %q does not exist", fset.Position(lit.Pos()), id)
+				}
+				return true
+			})
+
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -2,7 +2,9 @@ package localize
 
 import (
 	"embed"
+	"fmt"
 	"io/fs"
+	"os"
 
 	"golang.org/x/text/language"
 )
@@ -44,3 +46,24 @@ func GetDefaultLocales() fs.FS {
 func GetDefaultLanguage() *language.Tag {
 	return defaultLanguage
 }
+
+// LanguageEnvName is the environment variable used to override the language of the CLI
+const LanguageEnvName = "RHOAS_LANG"
+
+// GetLanguage returns the language to use for the CLI.
+// The language passed in takes precedence over the one set in the environment,
+// and the default language is used when neither is set.
+func GetLanguage(lang string) (*language.Tag, error) {
+	if lang == "" {
+		lang = os.Getenv(LanguageEnvName)
+	}
+	if lang == "" {
+		return defaultLanguage, nil
+	}
+
+	tag, err := language.Parse(lang)
+	if err != nil {
+		return nil, fmt.Errorf("invalid language \"%v\": %w", lang, err)
+	}
+	return &tag, nil
+}
@@ -0,0 +1,150 @@
+// Package reproducer saves the details of a failed command to a file that can be attached to bug reports
+package reproducer
+
+import (
+	"encoding/json"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/redhat-developer/app-services-cli/pkg/core/httputil"
+	"github.com/spf13/pflag"
+)
+
+// Bundle is the content of a reproducer file
+type Bundle struct {
+	Time         time.Time           `json:"time"`
+	Version      string              `json:"version"`
+	OS           string              `json:"os"`
+	Arch         string              `json:"arch"`
+	Command      []string            `json:"command"`
+	Error        string              `json:"error"`
+	OperationIDs []string            `json:"operation_ids,omitempty"`
+	Exchanges    []httputil.Exchange `json:"exchanges"`
+}
+
+// New creates a bundle for the failed command, flags are the flags of the executed command
+func New(version string, args []string, flags *pflag.FlagSet, cmdErr error, exchanges []httputil.Exchange) *Bundle {
+	bundle := &Bundle{
+		Time:      time.Now(),
+		Version:   version,
+		OS:        runtime.GOOS,
+		Arch:      runtime.GOARCH,
+		Command:   RedactArgs(args, flags),
+		Exchanges: exchanges,
+	}
+	if cmdErr != nil {
+		bundle.Error = cmdErr.Error()
+	}
+	if bundle.Exchanges == nil {
+		bundle.Exchanges = []httputil.Exchange{}
+	}
+
+	for _, e := range exchanges {
+		if e.OperationID != "" {
+			bundle.OperationIDs = append(bundle.OperationIDs, e.OperationID)
+		}
+	}
+
+	return bundle
+}
+
+// Write saves the bundle as JSON, readable only by the current user
+func (b *Bundle) Write(path string) error {
+	data, err := json.MarshalIndent(b, "", cmdutil.DefaultJSONIndent)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// RedactArgs replaces the values of flags which hold secrets, such as "--client-secret".
+// Flags are looked up in flags, the flags of the executed command, so that secret flags
+// are also redacted when given by their shorthand, such as "-t <token>" or "-t<token>".
+// flags may be nil, in which case only long flag names are checked.
+func RedactArgs(args []string, flags *pflag.FlagSet) []string {
+	redacted := make([]string, len(args))
+	copy(redacted, args)
+
+	for i := 0; i < len(redacted); i++ {
+		arg := redacted[i]
+		if arg == "--" {
+			break
+		}
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			continue
+		}
+
+		var valueStart int
+		var sensitive, takesValue bool
+		if strings.HasPrefix(arg, "--") {
+			name, _, hasValue := strings.Cut(arg[2:], "=")
+			sensitive = httputil.IsSensitive(name) || isSecretFlag(lookupFlag(flags, name))
+			takesValue = true
+			if hasValue {
+				valueStart = strings.Index(arg, "=") + 1
+			}
+		} else {
+			valueStart, sensitive, takesValue = shorthandValue(arg, flags)
+		}
+
+		if !sensitive || !takesValue {
+			continue
+		}
+
+		if valueStart > 0 && valueStart < len(arg) {
+			redacted[i] = arg[:valueStart] + httputil.Redacted
+		} else if i+1 < len(redacted) && !strings.HasPrefix(redacted[i+1], "-") {
+			redacted[i+1] = httputil.Redacted
+			i++
+		}
+	}
+
+	return redacted
+}
+
+// shorthandValue walks a group of shorthand flags, such as "-vt<token>", up to the first flag
+// which takes a value. It returns the index in arg where that value starts, or 0 when the value is
+// the next argument, and whether the flag is a secret.
+func shorthandValue(arg string, flags *pflag.FlagSet) (valueStart int, sensitive bool, takesValue bool) {
+	if flags == nil {
+		return 0, false, false
+	}
+	for j := 1; j < len(arg); j++ {
+		flag := flags.ShorthandLookup(arg[j : j+1])
+		if flag == nil {
+			return 0, false, false
+		}
+		if flag.NoOptDefVal != "" {
+			continue
+		}
+		valueStart = j + 1
+		if valueStart < len(arg) && arg[valueStart] == '=' {
+			valueStart++
+		}
+		if valueStart == len(arg) {
+			valueStart = 0
+		}
+		return valueStart, isSecretFlag(flag), true
+	}
+	return 0, false, false
+}
+
+func lookupFlag(flags *pflag.FlagSet, name string) *pflag.Flag {
+	if flags == nil {
+		return nil
+	}
+	return flags.Lookup(name)
+}
+
+// isSecretFlag reports whether the flag was added as a secret flag
+func isSecretFlag(flag *pflag.Flag) bool {
+	if flag == nil {
+		return false
+	}
+	_, ok := flag.Annotations[flagutil.SecretAnnotation]
+	return ok
+}
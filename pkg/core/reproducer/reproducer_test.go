@@ -0,0 +1,71 @@
+package reproducer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
+	"github.com/spf13/pflag"
+)
+
+func TestRedactArgs(t *testing.T) {
+	flags := pflag.NewFlagSet("login", pflag.ContinueOnError)
+	flags.StringP("token", "t", "", "")
+	_ = flags.SetAnnotation("token", flagutil.SecretAnnotation, []string{"true"})
+	flags.StringP("name", "n", "", "")
+	flags.BoolP("verbose", "v", false, "")
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "flag with separate value",
+			args: []string{"connector", "clone", "abc", "--client-secret", "s3cret", "--name", "copy"},
+			want: []string{"connector", "clone", "abc", "--client-secret", "REDACTED", "--name", "copy"},
+		},
+		{
+			name: "flag with inline value",
+			args: []string{"login", "--token=abc.def", "-v"},
+			want: []string{"login", "--token=REDACTED", "-v"},
+		},
+		{
+			name: "boolean flag followed by another flag",
+			args: []string{"login", "--password", "--insecure"},
+			want: []string{"login", "--password", "--insecure"},
+		},
+		{
+			name: "no sensitive flags",
+			args: []string{"kafka", "topic", "describe", "--name", "orders"},
+			want: []string{"kafka", "topic", "describe", "--name", "orders"},
+		},
+		{
+			name: "secret shorthand with separate value",
+			args: []string{"login", "-t", "x"},
+			want: []string{"login", "-t", "REDACTED"},
+		},
+		{
+			name: "secret shorthand with attached value",
+			args: []string{"login", "-tx"},
+			want: []string{"login", "-tREDACTED"},
+		},
+		{
+			name: "secret shorthand after boolean shorthand",
+			args: []string{"login", "-vt=x"},
+			want: []string{"login", "-vt=REDACTED"},
+		},
+		{
+			name: "non secret shorthand",
+			args: []string{"login", "-n", "x", "-v"},
+			want: []string{"login", "-n", "x", "-v"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RedactArgs(tt.args, flags); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RedactArgs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
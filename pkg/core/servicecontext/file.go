@@ -21,8 +21,12 @@ const errorFormat = "%v: %w"
 
 const ContextEnvName = "RHOAS_CONTEXT"
 
+// ContextFileEnvName is the environment variable used to load the service contexts from another file,
+// like KUBECONFIG. It takes precedence over ContextEnvName.
+const ContextFileEnvName = "RHOAS_CONTEXT_FILE"
+
 // Load loads the profiles from the context file. If the context file doesn't exist
-// it will return an empty context object.
+// it will return an empty context object. The contexts of the groups are refreshed from their members.
 func (c *File) Load() (*Context, error) {
 	file, err := c.Location()
 	if err != nil {
@@ -46,15 +50,18 @@ func (c *File) Load() (*Context, error) {
 	if err != nil {
 		return nil, fmt.Errorf(errorFormat, "unable to parse contexts", err)
 	}
+	RefreshGroups(&ctx)
 	return &ctx, nil
 }
 
 // Save saves the given profiles to the context file.
+// The services changed in the context of a group are written back to its member contexts.
 func (c *File) Save(cfg *Context) error {
 	file, err := c.Location()
 	if err != nil {
 		return err
 	}
+	WriteBackGroups(cfg)
 	data, err := json.MarshalIndent(cfg, "", "  ")
 	if err != nil {
 		return fmt.Errorf("%v: %w", "unable to marshal context", err)
@@ -96,7 +103,7 @@ func (c *File) Remove() error {
 // Location gets the path to the context file
 func (c *File) Location() (path string, err error) {
 
-	if rhoasContext := os.Getenv(ContextEnvName); rhoasContext != "" {
+	if rhoasContext := customLocation(); rhoasContext != "" {
 		path = rhoasContext
 	} else {
 		rhoasCtxDir, err := DefaultDir()
@@ -113,8 +120,15 @@ func (c *File) Location() (path string, err error) {
 
 // Checks if context has custom location
 func HasCustomLocation() bool {
-	rhoasContext := os.Getenv(ContextEnvName)
-	return rhoasContext != ""
+	return customLocation() != ""
+}
+
+// customLocation returns the context file set in the environment, if any
+func customLocation() string {
+	if rhoasContext := os.Getenv(ContextFileEnvName); rhoasContext != "" {
+		return rhoasContext
+	}
+	return os.Getenv(ContextEnvName)
 }
 
 // DefaultDir returns the default parent directory of the context file
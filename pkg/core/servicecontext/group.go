@@ -0,0 +1,97 @@
+package servicecontext
+
+import "sort"
+
+// The services of a group are stored as a regular context with the name of the group, so every command
+// which reads the current context works with a group unchanged. That context is kept in sync with the
+// member contexts: it is refreshed from them when the contexts are loaded, and the services a command
+// changes in it are written back to them when the contexts are saved.
+
+// RefreshGroups regenerates the context of each group which has one from its member contexts
+func RefreshGroups(c *Context) {
+	for name := range c.Groups {
+		if _, ok := c.Contexts[name]; ok {
+			c.Contexts[name] = groupConfig(c, name)
+		}
+	}
+}
+
+// WriteBackGroups writes the services changed in the context of each group to its member contexts,
+// then regenerates the context of the group. A service is written to the member context which provides it,
+// or to the first member context when none does.
+func WriteBackGroups(c *Context) {
+	for name, group := range c.Groups {
+		generated, ok := c.Contexts[name]
+		if !ok {
+			continue
+		}
+		merged := groupConfig(c, name)
+
+		changed := serviceIDs(&generated)
+		for i, current := range serviceIDs(&merged) {
+			if *changed[i] == *current {
+				continue
+			}
+			if member, ok := providingMember(c, group, i); ok {
+				cfg := c.Contexts[member]
+				*serviceIDs(&cfg)[i] = *changed[i]
+				c.Contexts[member] = cfg
+			}
+		}
+
+		c.Contexts[name] = groupConfig(c, name)
+	}
+}
+
+// providingMember returns the member context which provides the service at index i of serviceIDs
+func providingMember(c *Context, group Group, i int) (string, bool) {
+	first := ""
+	for _, member := range group.Contexts {
+		cfg, ok := c.Contexts[member]
+		if !ok {
+			continue
+		}
+		if first == "" {
+			first = member
+		}
+		if *serviceIDs(&cfg)[i] != "" {
+			return member, true
+		}
+	}
+	return first, first != ""
+}
+
+// groupConfig merges the member contexts of a group, keeping the description of the group context
+func groupConfig(c *Context, name string) ServiceConfig {
+	members := c.Groups[name].Contexts
+	configs := make([]ServiceConfig, 0, len(members))
+	for _, member := range members {
+		if cfg, ok := c.Contexts[member]; ok {
+			configs = append(configs, cfg)
+		}
+	}
+
+	merged := Merge(configs...)
+	merged.Description = c.Contexts[name].Description
+	return merged
+}
+
+// serviceIDs returns the identifiers of the services of cfg, in the order Merge applies them
+func serviceIDs(cfg *ServiceConfig) []*string {
+	return []*string{&cfg.KafkaID, &cfg.ServiceRegistryID, &cfg.NamespaceID, &cfg.ConnectorID}
+}
+
+// GroupsOf returns the names of the groups which include the context
+func GroupsOf(c *Context, ctxName string) []string {
+	var names []string
+	for name, group := range c.Groups {
+		for _, member := range group.Contexts {
+			if member == ctxName {
+				names = append(names, name)
+				break
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,97 @@
+package servicecontext
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newGroupContext() *Context {
+	return &Context{
+		Contexts: map[string]ServiceConfig{
+			"kafka-eu":    {KafkaID: "kafka-a"},
+			"registry-us": {ServiceRegistryID: "registry-b"},
+			"prod":        {KafkaID: "stale", Description: "Production"},
+		},
+		CurrentContext: "prod",
+		Groups: map[string]Group{
+			"prod": {Contexts: []string{"kafka-eu", "registry-us"}},
+		},
+	}
+}
+
+func TestRefreshGroups(t *testing.T) {
+	svcContext := newGroupContext()
+
+	RefreshGroups(svcContext)
+
+	want := ServiceConfig{KafkaID: "kafka-a", ServiceRegistryID: "registry-b", Description: "Production"}
+	if got := svcContext.Contexts["prod"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("group context = %+v, want %+v", got, want)
+	}
+}
+
+func TestWriteBackGroups(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(cfg *ServiceConfig)
+		want   map[string]ServiceConfig
+	}{
+		{
+			name:   "service provided by a member",
+			change: func(cfg *ServiceConfig) { cfg.KafkaID = "kafka-c" },
+			want: map[string]ServiceConfig{
+				"kafka-eu":    {KafkaID: "kafka-c"},
+				"registry-us": {ServiceRegistryID: "registry-b"},
+			},
+		},
+		{
+			name:   "service provided by a later member",
+			change: func(cfg *ServiceConfig) { cfg.ServiceRegistryID = "" },
+			want: map[string]ServiceConfig{
+				"kafka-eu":    {KafkaID: "kafka-a"},
+				"registry-us": {},
+			},
+		},
+		{
+			name:   "service not provided by any member",
+			change: func(cfg *ServiceConfig) { cfg.ConnectorID = "connector-d" },
+			want: map[string]ServiceConfig{
+				"kafka-eu":    {KafkaID: "kafka-a", ConnectorID: "connector-d"},
+				"registry-us": {ServiceRegistryID: "registry-b"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svcContext := newGroupContext()
+			RefreshGroups(svcContext)
+
+			cfg := svcContext.Contexts["prod"]
+			tt.change(&cfg)
+			svcContext.Contexts["prod"] = cfg
+
+			WriteBackGroups(svcContext)
+
+			for name, want := range tt.want {
+				if got := svcContext.Contexts[name]; !reflect.DeepEqual(got, want) {
+					t.Errorf("context %q = %+v, want %+v", name, got, want)
+				}
+			}
+			if got := svcContext.Contexts["prod"]; !reflect.DeepEqual(got, cfg) {
+				t.Errorf("group context = %+v, want %+v", got, cfg)
+			}
+		})
+	}
+}
+
+func TestGroupsOf(t *testing.T) {
+	svcContext := newGroupContext()
+	svcContext.Groups["eu"] = Group{Contexts: []string{"kafka-eu"}}
+
+	if got, want := GroupsOf(svcContext, "kafka-eu"), []string{"eu", "prod"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GroupsOf() = %v, want %v", got, want)
+	}
+	if got := GroupsOf(svcContext, "prod"); got != nil {
+		t.Errorf("GroupsOf() = %v, want none", got)
+	}
+}
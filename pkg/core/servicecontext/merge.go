@@ -0,0 +1,24 @@
+package servicecontext
+
+// Merge combines the service configs of a group of contexts into a single config.
+// The configs are applied in order, so the first non-empty identifier of each service wins.
+func Merge(configs ...ServiceConfig) ServiceConfig {
+	var merged ServiceConfig
+
+	for _, cfg := range configs {
+		if merged.KafkaID == "" {
+			merged.KafkaID = cfg.KafkaID
+		}
+		if merged.ServiceRegistryID == "" {
+			merged.ServiceRegistryID = cfg.ServiceRegistryID
+		}
+		if merged.NamespaceID == "" {
+			merged.NamespaceID = cfg.NamespaceID
+		}
+		if merged.ConnectorID == "" {
+			merged.ConnectorID = cfg.ConnectorID
+		}
+	}
+
+	return merged
+}
@@ -0,0 +1,48 @@
+package servicecontext
+
+import "testing"
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name    string
+		configs []ServiceConfig
+		want    ServiceConfig
+	}{
+		{
+			name: "no configs",
+			want: ServiceConfig{},
+		},
+		{
+			name: "services from different contexts",
+			configs: []ServiceConfig{
+				{KafkaID: "kafka-a"},
+				{ServiceRegistryID: "registry-b"},
+				{NamespaceID: "namespace-c", ConnectorID: "connector-c"},
+			},
+			want: ServiceConfig{
+				KafkaID:           "kafka-a",
+				ServiceRegistryID: "registry-b",
+				NamespaceID:       "namespace-c",
+				ConnectorID:       "connector-c",
+			},
+		},
+		{
+			name: "first context wins",
+			configs: []ServiceConfig{
+				{KafkaID: "kafka-a"},
+				{KafkaID: "kafka-b", ServiceRegistryID: "registry-b"},
+			},
+			want: ServiceConfig{
+				KafkaID:           "kafka-a",
+				ServiceRegistryID: "registry-b",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Merge(tt.configs...); got != tt.want {
+				t.Errorf("Merge() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
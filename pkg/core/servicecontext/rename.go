@@ -0,0 +1,20 @@
+package servicecontext
+
+// Rename moves the service config of a context to a new name.
+// The current context and the groups which include the context follow the new name.
+func Rename(c *Context, oldName string, newName string) {
+	c.Contexts[newName] = c.Contexts[oldName]
+	delete(c.Contexts, oldName)
+
+	if c.CurrentContext == oldName {
+		c.CurrentContext = newName
+	}
+
+	for _, group := range c.Groups {
+		for i, ctxName := range group.Contexts {
+			if ctxName == oldName {
+				group.Contexts[i] = newName
+			}
+		}
+	}
+}
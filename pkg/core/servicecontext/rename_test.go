@@ -0,0 +1,35 @@
+package servicecontext
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRename(t *testing.T) {
+	svcContext := &Context{
+		Contexts: map[string]ServiceConfig{
+			"dev":     {KafkaID: "kafka-a", Description: "Development"},
+			"staging": {KafkaID: "kafka-b"},
+		},
+		CurrentContext: "dev",
+		Groups: map[string]Group{
+			"team": {Contexts: []string{"staging", "dev"}},
+		},
+	}
+
+	Rename(svcContext, "dev", "development")
+
+	want := &Context{
+		Contexts: map[string]ServiceConfig{
+			"development": {KafkaID: "kafka-a", Description: "Development"},
+			"staging":     {KafkaID: "kafka-b"},
+		},
+		CurrentContext: "development",
+		Groups: map[string]Group{
+			"team": {Contexts: []string{"staging", "development"}},
+		},
+	}
+	if !reflect.DeepEqual(svcContext, want) {
+		t.Errorf("Rename() = %+v, want %+v", svcContext, want)
+	}
+}
@@ -4,6 +4,12 @@ package servicecontext
 type Context struct {
 	Contexts       map[string]ServiceConfig `json:"contexts,omitempty"`
 	CurrentContext string                   `json:"current_context"`
+	Groups         map[string]Group         `json:"groups,omitempty"`
+}
+
+// Group is a named group of contexts which are used together
+type Group struct {
+	Contexts []string `json:"contexts"`
 }
 
 // ServiceConfig is a map of identifiers for the application services
@@ -12,6 +18,7 @@ type ServiceConfig struct {
 	ServiceRegistryID string `json:"serviceregistryID"`
 	NamespaceID       string `json:"namespaceID"`
 	ConnectorID       string `json:"connectorID"`
+	Description       string `json:"description,omitempty"`
 }
 
 // IContext is an interface which describes functions for context file
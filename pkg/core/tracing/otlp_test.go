@@ -0,0 +1,70 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExport(t *testing.T) {
+	var received otlpRequest
+	var traceparent string
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer api.Close()
+
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != tracesPath {
+			t.Errorf("unexpected path %v", r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Fatal(err)
+		}
+	}))
+	defer collector.Close()
+
+	t.Setenv(EnvEndpoint, collector.URL)
+	tracer := FromEnv("1.0.0")
+	tracer.SetCommand("rhoas kafka describe")
+
+	client := &http.Client{Transport: RoundTripper{Proxied: http.DefaultTransport, Tracer: tracer}}
+	resp, err := client.Get(api.URL + "/api/kafkas_mgmt/v1/kafkas/abc?fields=name")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	tracer.Finish(errors.New("Kafka instance not found"))
+	if err = tracer.Export(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	spans := received.ResourceSpans[0].ScopeSpans[0].Spans
+	if len(spans) != 2 {
+		t.Fatalf("got %v spans, want 2", len(spans))
+	}
+
+	command, call := spans[0], spans[1]
+	if command.Name != "rhoas kafka describe" || command.Status.Code != statusCodeError || command.ParentSpanID != "" {
+		t.Errorf("unexpected command span %+v", command)
+	}
+	if call.Name != "GET /api/kafkas_mgmt/v1/kafkas/abc" || call.ParentSpanID != command.SpanID || call.Kind != SpanKindClient || call.Status.Code != statusCodeError {
+		t.Errorf("unexpected API call span %+v", call)
+	}
+	if want := "00-" + call.TraceID + "-" + call.SpanID + "-01"; traceparent != want {
+		t.Errorf("traceparent = %v, want %v", traceparent, want)
+	}
+}
+
+func TestFromEnvDisabled(t *testing.T) {
+	t.Setenv(EnvEndpoint, "")
+	if tracer := FromEnv("1.0.0"); tracer != nil {
+		t.Errorf("FromEnv() = %v, want nil", tracer)
+	}
+}
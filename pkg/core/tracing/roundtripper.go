@@ -0,0 +1,38 @@
+package tracing
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// RoundTripper implements http.RoundTripper. It records a client span for every API call,
+// and propagates the trace to the server with the traceparent header.
+type RoundTripper struct {
+	Proxied http.RoundTripper
+	Tracer  *Tracer
+}
+
+// RoundTrip executes the request in a new span
+func (c RoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	span := c.Tracer.StartSpan(r.Method+" "+r.URL.Path, SpanKindClient)
+	span.Attributes["http.method"] = r.Method
+	span.Attributes["http.url"] = r.URL.Scheme + "://" + r.URL.Host + r.URL.Path
+	span.Attributes["net.peer.name"] = r.URL.Hostname()
+
+	r = r.Clone(r.Context())
+	r.Header.Set("traceparent", c.Tracer.traceparent(span))
+
+	resp, err := c.Proxied.RoundTrip(r)
+	if err != nil {
+		c.Tracer.EndSpan(span, err)
+		return nil, err
+	}
+
+	span.Attributes["http.status_code"] = resp.StatusCode
+	if resp.StatusCode >= http.StatusBadRequest {
+		err = fmt.Errorf("%v", resp.Status)
+	}
+	c.Tracer.EndSpan(span, err)
+
+	return resp, nil
+}
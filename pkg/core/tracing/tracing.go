@@ -0,0 +1,134 @@
+// Package tracing records OpenTelemetry spans for the command and the API calls it makes,
+// and exports them to an OTLP endpoint. Tracing is only enabled when the endpoint is configured.
+package tracing
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"sync"
+	"time"
+)
+
+// EnvEndpoint is the environment variable which holds the URL of the OTLP/HTTP endpoint receiving the traces
+const EnvEndpoint = "RHOAS_OTEL_EXPORTER_OTLP_ENDPOINT"
+
+// SpanKind is the role of a span in the trace, with the values of the OTLP specification
+type SpanKind int
+
+const (
+	SpanKindInternal SpanKind = 1
+	SpanKindClient   SpanKind = 3
+)
+
+// Span is a timed operation of the trace
+type Span struct {
+	SpanID       string
+	ParentSpanID string
+	Name         string
+	Kind         SpanKind
+	Start        time.Time
+	End          time.Time
+	Attributes   map[string]interface{}
+	// Error is the error of a failed operation
+	Error string
+}
+
+// Tracer records the spans of a single command execution
+type Tracer struct {
+	endpoint string
+	version  string
+	traceID  string
+	root     *Span
+
+	mu    sync.Mutex
+	spans []*Span
+}
+
+// FromEnv creates a tracer when the OTLP endpoint is set, it returns nil when tracing is disabled.
+// The root span of the command starts when the tracer is created.
+func FromEnv(version string) *Tracer {
+	endpoint := os.Getenv(EnvEndpoint)
+	if endpoint == "" {
+		return nil
+	}
+
+	t := &Tracer{
+		endpoint: endpoint,
+		version:  version,
+		traceID:  newID(16),
+	}
+	t.root = &Span{
+		SpanID:     newID(8),
+		Name:       "rhoas",
+		Kind:       SpanKindInternal,
+		Start:      time.Now(),
+		Attributes: map[string]interface{}{},
+	}
+	t.spans = append(t.spans, t.root)
+	return t
+}
+
+// SetCommand names the root span after the command which is executed
+func (t *Tracer) SetCommand(commandPath string) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.root.Name = commandPath
+	t.root.Attributes["rhoas.command"] = commandPath
+}
+
+// Finish ends the root span with the result of the command
+func (t *Tracer) Finish(err error) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.root.End = time.Now()
+	if err != nil {
+		t.root.Error = err.Error()
+	}
+}
+
+// StartSpan starts a child span of the command
+func (t *Tracer) StartSpan(name string, kind SpanKind) *Span {
+	span := &Span{
+		SpanID:       newID(8),
+		ParentSpanID: t.root.SpanID,
+		Name:         name,
+		Kind:         kind,
+		Start:        time.Now(),
+		Attributes:   map[string]interface{}{},
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.spans = append(t.spans, span)
+	return span
+}
+
+// EndSpan ends a span started with StartSpan
+func (t *Tracer) EndSpan(span *Span, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	span.End = time.Now()
+	if err != nil {
+		span.Error = err.Error()
+	}
+}
+
+// traceparent returns the W3C Trace Context header which makes the span the parent of the server spans
+func (t *Tracer) traceparent(span *Span) string {
+	return "00-" + t.traceID + "-" + span.SpanID + "-01"
+}
+
+func newID(size int) string {
+	b := make([]byte, size)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,137 @@
+// Package checkpoint records the progress of bulk operations, so that an operation
+// interrupted part way through can be resumed without repeating the completed items
+package checkpoint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+)
+
+// Checkpoint is the list of items completed by a bulk operation
+type Checkpoint struct {
+	// Operation identifies the bulk operation, for example the command and its target
+	Operation string    `json:"operation"`
+	Completed []string  `json:"completed"`
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	path string
+	done map[string]bool
+}
+
+// Store keeps the checkpoints of bulk operations in a directory
+type Store struct {
+	dir string
+}
+
+// NewStore creates a store which keeps checkpoints in the given directory
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// DefaultStore creates a store which keeps checkpoints in the "checkpoints" directory next to the config file
+func DefaultStore(cfg config.IConfig) (*Store, error) {
+	location, err := cfg.Location()
+	if err != nil {
+		return nil, err
+	}
+	return NewStore(filepath.Join(filepath.Dir(location), "checkpoints")), nil
+}
+
+// Open returns the checkpoint of the operation.
+// When resume is true, the items completed by a previous run of the operation are loaded,
+// otherwise the operation starts from the beginning and a previous checkpoint is discarded.
+func (s *Store) Open(operation string, resume bool) (*Checkpoint, error) {
+	c := &Checkpoint{
+		Operation: operation,
+		Completed: []string{},
+		path:      s.path(operation),
+		done:      map[string]bool{},
+	}
+
+	if !resume {
+		if err := os.Remove(c.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+		return c, nil
+	}
+
+	// #nosec G304
+	data, err := os.ReadFile(c.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+	for _, item := range c.Completed {
+		c.done[item] = true
+	}
+
+	return c, nil
+}
+
+// IsCompleted returns whether the item was completed by a previous run of the operation
+func (c *Checkpoint) IsCompleted(item string) bool {
+	return c.done[item]
+}
+
+// Complete records that the item is completed, saving the checkpoint right away
+// so that it survives an interruption of the operation
+func (c *Checkpoint) Complete(item string) error {
+	if c.done[item] {
+		return nil
+	}
+	c.done[item] = true
+	c.Completed = append(c.Completed, item)
+	c.UpdatedAt = time.Now()
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o700); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// the checkpoint is replaced atomically, so that an interruption never leaves it half written
+	tmp, err := os.CreateTemp(filepath.Dir(c.path), filepath.Base(c.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), c.path)
+}
+
+// Remove deletes the checkpoint, once the operation has completed all of its items
+func (c *Checkpoint) Remove() error {
+	if err := os.Remove(c.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// path returns the file of the checkpoint of an operation
+func (s *Store) path(operation string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(operation)))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:8])+".json")
+}
@@ -0,0 +1,59 @@
+package checkpoint
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckpoint(t *testing.T) {
+	store := NewStore(t.TempDir())
+	operation := "kafka topic import instance-1 topics.txt"
+
+	c, err := store.Open(operation, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, item := range []string{"orders", "payments"} {
+		if err = c.Complete(item); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resumed, err := store.Open(operation, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(resumed.Completed, []string{"orders", "payments"}) {
+		t.Errorf("Completed = %v, want [orders payments]", resumed.Completed)
+	}
+	if !resumed.IsCompleted("orders") || resumed.IsCompleted("invoices") {
+		t.Errorf("IsCompleted() does not match the completed items %v", resumed.Completed)
+	}
+
+	other, err := store.Open("kafka topic import instance-2 topics.txt", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(other.Completed) != 0 {
+		t.Errorf("checkpoint of another operation has completed items %v", other.Completed)
+	}
+
+	restarted, err := store.Open(operation, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restarted.IsCompleted("orders") {
+		t.Error("checkpoint opened without resume has completed items")
+	}
+
+	if err = resumed.Remove(); err != nil {
+		t.Fatal(err)
+	}
+	afterRemove, err := store.Open(operation, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(afterRemove.Completed) != 0 {
+		t.Errorf("removed checkpoint has completed items %v", afterRemove.Completed)
+	}
+}
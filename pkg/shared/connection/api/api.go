@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/redhat-developer/app-services-cli/pkg/api/fleetadmin"
 	"github.com/redhat-developer/app-services-cli/pkg/api/generic"
 	"github.com/redhat-developer/app-services-cli/pkg/api/rbac"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
@@ -26,6 +27,7 @@ type API interface {
 	ServiceRegistryInstance(instanceID string) (*registryinstanceclient.APIClient, *registrymgmtclient.Registry, error)
 	AccountMgmt() amsclient.AppServicesApi
 	RBAC() rbac.RbacAPI
+	FleetAdmin() fleetadmin.FleetAdminAPI
 	GenericAPI() generic.GenericAPI
 	GetConfig() Config
 }
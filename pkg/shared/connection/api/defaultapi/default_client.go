@@ -14,6 +14,7 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/shared/kafkautil"
 
 	"github.com/redhat-developer/app-services-cli/internal/build"
+	"github.com/redhat-developer/app-services-cli/pkg/api/fleetadmin"
 	"github.com/redhat-developer/app-services-cli/pkg/api/generic"
 	"github.com/redhat-developer/app-services-cli/pkg/api/rbac"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/connection/api"
@@ -258,6 +259,20 @@ func (a *defaultAPI) RBAC() rbac.RbacAPI {
 	return rbacAPI
 }
 
+// FleetAdmin returns a new fleet manager admin API client instance
+func (a *defaultAPI) FleetAdmin() fleetadmin.FleetAdminAPI {
+	return fleetadmin.FleetAdminAPI{
+		KafkaAPI: func() fleetadmin.KafkaAPI {
+			cfg := fleetadmin.Config{
+				HTTPClient: a.CreateOAuthTransport(a.AccessToken),
+				Debug:      a.Logger.DebugEnabled(),
+				BaseURL:    a.ApiURL,
+			}
+			return fleetadmin.NewKafkaAPIClient(&cfg)
+		},
+	}
+}
+
 // wraps the HTTP client with an OAuth2 Transport layer to provide automatic token refreshing
 func (a *defaultAPI) CreateOAuthTransport(accessToken string) *http.Client {
 	ts := oauth2.StaticTokenSource(
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/redhat-developer/app-services-cli/pkg/core/auth/token"
 	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
@@ -251,6 +252,12 @@ func (b *ConnectionBuilder) createTransport() (transport http.RoundTripper) {
 		},
 		Proxy:             http.ProxyFromEnvironment,
 		DisableKeepAlives: b.disableKeepAlives,
+		// the transport is shared by the clients of all the APIs, so that connections are reused between them
+		ForceAttemptHTTP2:   true,
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: config.DefaultMaxConcurrentRequests,
+		IdleConnTimeout:     90 * time.Second,
+		TLSHandshakeTimeout: 10 * time.Second,
 	}
 
 	// Wrap the transport with the round trippers provided by the user:
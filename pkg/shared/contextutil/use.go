@@ -0,0 +1,99 @@
+package contextutil
+
+import (
+	"fmt"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+)
+
+// Resource is the ID and name of a service resource which can be set in a context
+type Resource struct {
+	ID   string
+	Name string
+}
+
+// UseOptions describe how a "use" command selects a resource of a service into the current context
+type UseOptions struct {
+	// ID and Name are the values of the --id and --name flags, at most one of them can be set
+	ID   string
+	Name string
+
+	// GetByID and GetByName fetch the resource to use
+	GetByID   func(conn connection.Connection, id string) (*Resource, error)
+	GetByName func(conn connection.Connection, name string) (*Resource, error)
+	// InteractiveSelect prompts for the resource when neither ID nor Name is set.
+	// It returns nil when there is no resource to select.
+	InteractiveSelect func(conn connection.Connection) (*Resource, error)
+	// SetID stores the ID of the selected resource in the service config of the context
+	SetID func(svcConfig *servicecontext.ServiceConfig, id string)
+
+	// IDOrNameRequiredError is the message ID of the error returned when the terminal can not prompt
+	IDOrNameRequiredError string
+	// SaveError is the message ID of the error returned when the context could not be saved
+	SaveError string
+	// UseSuccess is the message ID logged when the resource has been set in the context
+	UseSuccess string
+}
+
+// Use sets a resource of a service in the current context, selecting it interactively
+// when neither an ID nor a name is given
+func Use(f *factory.Factory, opts *UseOptions) error {
+	if opts.ID != "" && opts.Name != "" {
+		return f.Localizer.MustLocalizeError("service.error.idAndNameCannotBeUsed")
+	}
+
+	interactive := opts.ID == "" && opts.Name == ""
+	if interactive && !f.IOStreams.CanPrompt() {
+		return f.Localizer.MustLocalizeError(opts.IDOrNameRequiredError)
+	}
+
+	svcContext, err := f.ServiceContext.Load()
+	if err != nil {
+		return err
+	}
+
+	currCtx, err := GetCurrentContext(svcContext, f.Localizer)
+	if err != nil {
+		return err
+	}
+
+	conn, err := f.Connection()
+	if err != nil {
+		return err
+	}
+
+	var resource *Resource
+	switch {
+	case interactive:
+		f.Logger.Debug(f.Localizer.MustLocalize("common.log.debug.startingInteractivePrompt"))
+		resource, err = opts.InteractiveSelect(conn)
+	case opts.Name != "":
+		resource, err = opts.GetByName(conn, opts.Name)
+	default:
+		resource, err = opts.GetByID(conn, opts.ID)
+	}
+	if err != nil {
+		return err
+	}
+	// nothing was selected, exit program
+	if resource == nil {
+		return nil
+	}
+
+	opts.SetID(currCtx, resource.ID)
+	svcContext.Contexts[svcContext.CurrentContext] = *currCtx
+
+	nameTmplEntry := localize.NewEntry("Name", resource.Name)
+	if err = f.ServiceContext.Save(svcContext); err != nil {
+		saveErrMsg := f.Localizer.MustLocalize(opts.SaveError, nameTmplEntry)
+		return fmt.Errorf("%v: %w", saveErrMsg, err)
+	}
+
+	f.Logger.Info(icon.SuccessPrefix(), f.Localizer.MustLocalize(opts.UseSuccess, nameTmplEntry))
+
+	return nil
+}
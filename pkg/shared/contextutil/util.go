@@ -1,8 +1,6 @@
 package contextutil
 
 import (
-	"context"
-
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
 
@@ -23,7 +21,7 @@ func GetContext(svcContext *servicecontext.Context, localizer localize.Localizer
 
 	ctx, ok := svcContext.Contexts[ctxName]
 	if !ok {
-		return nil, localizer.MustLocalizeError("context.common.error.context.notFound", localize.NewEntry("Name", svcContext.CurrentContext))
+		return nil, localizer.MustLocalizeError("context.common.error.context.notFound", localize.NewEntry("Name", ctxName))
 	}
 
 	return &ctx, nil
@@ -70,7 +68,7 @@ func GetKafkaForServiceConfig(currCtx *servicecontext.ServiceConfig, f *factory.
 		return nil, f.Localizer.MustLocalizeError("context.common.error.noKafkaID")
 	}
 
-	kafkaInstance, _, err := conn.API().KafkaMgmt().GetKafkaById(context.Background(), currCtx.KafkaID).Execute()
+	kafkaInstance, _, err := conn.API().KafkaMgmt().GetKafkaById(f.Context, currCtx.KafkaID).Execute()
 	if kafkamgmtv1errors.IsAPIError(err, kafkamgmtv1errors.ERROR_7) {
 		return nil, f.Localizer.MustLocalizeError("context.common.error.kafka.notFound")
 	}
@@ -105,7 +103,7 @@ func GetRegistryForServiceConfig(currCtx *servicecontext.ServiceConfig, f *facto
 		return nil, f.Localizer.MustLocalizeError("context.common.error.noRegistryID")
 	}
 
-	registryInstance, _, err := conn.API().ServiceRegistryMgmt().GetRegistry(context.Background(), currCtx.ServiceRegistryID).Execute()
+	registryInstance, _, err := conn.API().ServiceRegistryMgmt().GetRegistry(f.Context, currCtx.ServiceRegistryID).Execute()
 	if srsmgmtv1errors.IsAPIError(err, srsmgmtv1errors.ERROR_2) {
 		return nil, f.Localizer.MustLocalizeError("context.common.error.registry.notFound")
 	}
@@ -3,13 +3,17 @@ package defaultfactory
 import (
 	"context"
 	"net/http"
+	"path/filepath"
+	"time"
 
+	"github.com/redhat-developer/app-services-cli/pkg/core/cmdutil/flagutil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/config"
 	"github.com/redhat-developer/app-services-cli/pkg/core/httputil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
 	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
+	"github.com/redhat-developer/app-services-cli/pkg/core/tracing"
 
 	"github.com/redhat-developer/app-services-cli/pkg/shared/connection"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/connection/kcconnection"
@@ -18,6 +22,10 @@ import (
 	"github.com/redhat-developer/app-services-cli/internal/build"
 )
 
+// httpCacheTTL is how long API responses are reused without contacting the server.
+// It is short enough that polling commands still see changes as they happen.
+const httpCacheTTL = 2 * time.Second
+
 // New creates a new command factory
 // The command factory is available to all command packages
 // giving centralized access to the config and API connection
@@ -35,7 +43,13 @@ func New(localizer localize.Localizer) *factory.Factory {
 	loggerBuilder = loggerBuilder.Streams(io.Out, io.ErrOut)
 	logger, _ = loggerBuilder.Build()
 
-	ctx := context.Background()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	recorder := &httputil.Recorder{}
+	responseCache := &httputil.ResponseCache{TTL: httpCacheTTL}
+	tracer := tracing.FromEnv(build.Version)
+	deprecations := &httputil.Deprecations{}
+	userAgent := httputil.NewUserAgent(build.DefaultUserAgentPrefix + build.Version)
 
 	connectionFunc := func() (connection.Connection, error) {
 		if conn != nil {
@@ -74,11 +88,47 @@ func New(localizer localize.Localizer) *factory.Factory {
 
 		builder.WithConfig(cfgFile)
 
+		if cfg.HTTPCache == config.HTTPCacheOn {
+			if cacheDir, err := config.CacheDir(); err == nil {
+				responseCache.Dir = filepath.Join(cacheDir, "http")
+			}
+		}
+
+		maxConcurrentRequests := cfg.GetMaxConcurrentRequests()
 		transportWrapper := func(a http.RoundTripper) http.RoundTripper {
-			return &httputil.LoggingRoundTripper{
+			a = httputil.NewLimitRoundTripper(a, maxConcurrentRequests)
+			a = &httputil.CancelRoundTripper{
+				Proxied: a,
+				Context: ctx,
+			}
+			a = &httputil.DeprecationRoundTripper{
+				Proxied:      a,
+				Deprecations: deprecations,
+			}
+			if flagutil.ReproducerFile() != "" {
+				a = &httputil.RecordingRoundTripper{
+					Proxied:  a,
+					Recorder: recorder,
+				}
+			}
+			a = &httputil.LoggingRoundTripper{
 				Proxied: a,
 				Logger:  logger,
 			}
+			if tracer != nil {
+				a = &tracing.RoundTripper{
+					Proxied: a,
+					Tracer:  tracer,
+				}
+			}
+			a = &httputil.UserAgentRoundTripper{
+				Proxied:   a,
+				UserAgent: userAgent,
+			}
+			return &httputil.CachingRoundTripper{
+				Proxied: a,
+				Cache:   responseCache,
+			}
 		}
 
 		builder.WithTransportWrapper(transportWrapper)
@@ -97,12 +147,17 @@ func New(localizer localize.Localizer) *factory.Factory {
 	}
 
 	return &factory.Factory{
-		IOStreams:      io,
-		Config:         cfgFile,
-		Connection:     connectionFunc,
-		Logger:         logger,
-		Localizer:      localizer,
-		Context:        ctx,
-		ServiceContext: ctxFile,
+		IOStreams:       io,
+		Config:          cfgFile,
+		Connection:      connectionFunc,
+		Logger:          logger,
+		Localizer:       localizer,
+		Context:         ctx,
+		Cancel:          cancel,
+		ServiceContext:  ctxFile,
+		HTTPRecorder:    recorder,
+		APIDeprecations: deprecations,
+		Tracer:          tracer,
+		UserAgent:       userAgent,
 	}
 }
@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/httputil"
 	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
+	"github.com/redhat-developer/app-services-cli/pkg/core/tracing"
 	"github.com/redhat-developer/app-services-cli/pkg/shared/connection"
 
 	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
@@ -27,8 +29,18 @@ type Factory struct {
 	Localizer localize.Localizer
 	// Context returns the default context for the application
 	Context context.Context
+	// Cancel cancels Context, interrupting the API calls of the command
+	Cancel context.CancelFunc
 	// ServiceContext returns the identifiers for currently selected services for the context
 	ServiceContext servicecontext.IContext
+	// HTTPRecorder records the API requests when a reproducer bundle is requested
+	HTTPRecorder *httputil.Recorder
+	// APIDeprecations keeps the deprecated API endpoints called by the command
+	APIDeprecations *httputil.Deprecations
+	// Tracer records the spans of the command when tracing is enabled, it is nil otherwise
+	Tracer *tracing.Tracer
+	// UserAgent is the User-Agent header sent with the API requests
+	UserAgent *httputil.UserAgent
 }
 
 type ConnectionFunc func() (connection.Connection, error)
@@ -0,0 +1,140 @@
+// Package fakes provides a factory backed by in-memory streams, storage and
+// API servers, so that commands can be unit tested without a real environment.
+package fakes
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize/goi18n"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/connection/api"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/connection/api/defaultapi"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+)
+
+// ErrNoServer is returned by the connection of a factory which has no server
+var ErrNoServer = errors.New("no server was set with WithServer")
+
+// Factory is a factory.Factory whose dependencies are in memory.
+// The streams of the commands are buffers which can be written to and inspected by the test.
+type Factory struct {
+	*factory.Factory
+
+	// In is read as the standard input
+	In *bytes.Buffer
+	// Out receives the standard output
+	Out *bytes.Buffer
+	// ErrOut receives the standard error, where the logger writes
+	ErrOut *bytes.Buffer
+
+	// Config is the config loaded by the commands
+	Config *Config
+	// ServiceContext is the service contexts loaded by the commands
+	ServiceContext *ServiceContext
+}
+
+// NewFactory creates a factory for unit tests with:
+// - non-interactive streams backed by buffers
+// - a logger writing to the error buffer
+// - the English localizer
+// - an empty config and no service contexts
+// - a connection which fails until a server is set with WithServer
+func NewFactory(t testing.TB) *Factory {
+	t.Helper()
+
+	in, out, errOut := &bytes.Buffer{}, &bytes.Buffer{}, &bytes.Buffer{}
+
+	streams := &iostreams.IOStreams{
+		In:     io.NopCloser(in),
+		Out:    out,
+		ErrOut: errOut,
+	}
+	streams.SetStdinTTY(false)
+	streams.SetStdoutTTY(false)
+	streams.SetStderrTTY(false)
+
+	logger, err := logging.NewStdLoggerBuilder().Streams(errOut, errOut).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	localizer, err := goi18n.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := NewConfig(&config.Config{})
+	svcContext := NewServiceContext(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	return &Factory{
+		Factory: &factory.Factory{
+			IOStreams: streams,
+			Config:    cfg,
+			Connection: func() (connection.Connection, error) {
+				return nil, ErrNoServer
+			},
+			Logger:         logger,
+			Localizer:      localizer,
+			Context:        ctx,
+			Cancel:         cancel,
+			ServiceContext: svcContext,
+		},
+		In:             in,
+		Out:            out,
+		ErrOut:         errOut,
+		Config:         cfg,
+		ServiceContext: svcContext,
+	}
+}
+
+// WithServer starts a server with handler, closed at the end of the test,
+// and connects the API clients of the factory to it.
+// The same server answers the API, the authentication and the console URLs.
+func (f *Factory) WithServer(t testing.TB, handler http.Handler) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f.Factory.Connection = func() (connection.Connection, error) {
+		return &connection.ConnectionMock{
+			APIFunc: func() api.API {
+				return defaultapi.New(&api.Config{
+					AccessToken: "fake-token",
+					ApiURL:      serverURL,
+					AuthURL:     serverURL,
+					ConsoleURL:  serverURL,
+					UserAgent:   "rhoas-fakes",
+					HTTPClient:  server.Client(),
+					Logger:      f.Logger,
+				})
+			},
+			LogoutFunc: func(ctx context.Context) error {
+				return nil
+			},
+			RefreshTokensFunc: func(ctx context.Context) error {
+				return nil
+			},
+		}, nil
+	}
+
+	return server
+}
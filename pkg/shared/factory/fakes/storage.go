@@ -0,0 +1,150 @@
+package fakes
+
+import (
+	"encoding/json"
+	"io/fs"
+	"sync"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/servicecontext"
+)
+
+// location is the path reported by the in-memory stores
+const location = "memory"
+
+// notExistError is returned when loading a store which has no content,
+// like the file implementations it satisfies os.IsNotExist
+func notExistError() error {
+	return &fs.PathError{Op: "open", Path: location, Err: fs.ErrNotExist}
+}
+
+// Config is an in-memory implementation of config.IConfig
+type Config struct {
+	mu    sync.Mutex
+	value *config.Config
+}
+
+var _ config.IConfig = &Config{}
+
+// NewConfig creates an in-memory config holding cfg, or no config when cfg is nil
+func NewConfig(cfg *config.Config) *Config {
+	c := &Config{}
+	if cfg != nil {
+		_ = c.Save(cfg)
+	}
+	return c
+}
+
+// Load returns a copy of the saved config
+func (c *Config) Load() (*config.Config, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.value == nil {
+		return nil, notExistError()
+	}
+
+	var cfg config.Config
+	if err := deepCopy(c.value, &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// Save stores a copy of cfg
+func (c *Config) Save(cfg *config.Config) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var value config.Config
+	if err := deepCopy(cfg, &value); err != nil {
+		return err
+	}
+	c.value = &value
+	return nil
+}
+
+// Remove deletes the saved config
+func (c *Config) Remove() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.value = nil
+	return nil
+}
+
+// Location returns a placeholder, the config is not stored in a file
+func (c *Config) Location() (string, error) {
+	return location, nil
+}
+
+// ServiceContext is an in-memory implementation of servicecontext.IContext
+type ServiceContext struct {
+	mu    sync.Mutex
+	value *servicecontext.Context
+}
+
+var _ servicecontext.IContext = &ServiceContext{}
+
+// NewServiceContext creates in-memory service contexts holding svcContext, or no contexts when it is nil
+func NewServiceContext(svcContext *servicecontext.Context) *ServiceContext {
+	c := &ServiceContext{}
+	if svcContext != nil {
+		_ = c.Save(svcContext)
+	}
+	return c
+}
+
+// Load returns a copy of the saved contexts, with the contexts of the groups refreshed as the file implementation does
+func (c *ServiceContext) Load() (*servicecontext.Context, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.value == nil {
+		return nil, notExistError()
+	}
+
+	var svcContext servicecontext.Context
+	if err := deepCopy(c.value, &svcContext); err != nil {
+		return nil, err
+	}
+	servicecontext.RefreshGroups(&svcContext)
+	return &svcContext, nil
+}
+
+// Save stores a copy of svcContext, writing the changes to group contexts back to their members as the file implementation does
+func (c *ServiceContext) Save(svcContext *servicecontext.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	servicecontext.WriteBackGroups(svcContext)
+	var value servicecontext.Context
+	if err := deepCopy(svcContext, &value); err != nil {
+		return err
+	}
+	c.value = &value
+	return nil
+}
+
+// Remove deletes the saved contexts
+func (c *ServiceContext) Remove() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.value = nil
+	return nil
+}
+
+// Location returns a placeholder, the contexts are not stored in a file
+func (c *ServiceContext) Location() (string, error) {
+	return location, nil
+}
+
+// deepCopy copies src into dst through JSON, as the file implementations would
+func deepCopy(src interface{}, dst interface{}) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dst)
+}
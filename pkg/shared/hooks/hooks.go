@@ -0,0 +1,140 @@
+// Package hooks runs the shell commands configured by the user
+// after the lifecycle events of service instances
+package hooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/core/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+)
+
+// Event is a lifecycle event of a service instance
+type Event string
+
+const (
+	PostCreate Event = "post-create"
+	PostDelete Event = "post-delete"
+)
+
+// Resource types passed to the hooks
+const (
+	KafkaResource           = "kafka"
+	ServiceRegistryResource = "service-registry"
+)
+
+// Resource describes the service instance which triggered the event
+type Resource struct {
+	Type string
+	ID   string
+	Name string
+	// Data is the full representation of the resource, passed to the hooks as JSON
+	Data interface{}
+}
+
+// Runner runs the hooks configured in the CLI config
+type Runner struct {
+	Config    config.IConfig
+	IO        *iostreams.IOStreams
+	Logger    logging.Logger
+	Localizer localize.Localizer
+	Context   context.Context
+}
+
+// NewRunner creates a hooks runner from the factory
+func NewRunner(f *factory.Factory) *Runner {
+	return &Runner{
+		Config:    f.Config,
+		IO:        f.IOStreams,
+		Logger:    f.Logger,
+		Localizer: f.Localizer,
+		Context:   f.Context,
+	}
+}
+
+// Run runs the hooks configured for the event.
+// A failing hook does not undo the event, so failures are only reported.
+func (r *Runner) Run(event Event, resource *Resource) {
+	cfg, err := r.Config.Load()
+	if err != nil {
+		r.Logger.Debug("Could not load the config to run hooks:", err)
+		return
+	}
+
+	commands := commandsForEvent(cfg.Hooks, event)
+	if len(commands) == 0 {
+		return
+	}
+
+	env, err := environment(event, resource)
+	if err != nil {
+		r.Logger.Debug("Could not build the hook environment:", err)
+		return
+	}
+
+	for _, command := range commands {
+		r.Logger.Info(r.Localizer.MustLocalize("hooks.log.info.running",
+			localize.NewEntry("Event", event),
+			localize.NewEntry("Command", command),
+		))
+
+		cmd := shellCommand(r.Context, command)
+		cmd.Env = append(os.Environ(), env...)
+		// hook output goes to stderr so that it does not mix with the command output
+		cmd.Stdout = r.IO.ErrOut
+		cmd.Stderr = r.IO.ErrOut
+
+		if err := cmd.Run(); err != nil {
+			r.Logger.Info(r.Localizer.MustLocalize("hooks.log.info.failed",
+				localize.NewEntry("Command", command),
+				localize.NewEntry("Error", err),
+			))
+		}
+	}
+}
+
+func commandsForEvent(hooks *config.HooksConfig, event Event) []string {
+	if hooks == nil {
+		return nil
+	}
+
+	switch event {
+	case PostCreate:
+		return hooks.PostCreate
+	case PostDelete:
+		return hooks.PostDelete
+	default:
+		return nil
+	}
+}
+
+// environment returns the variables describing the event and the resource
+func environment(event Event, resource *Resource) ([]string, error) {
+	data, err := json.Marshal(resource.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{
+		fmt.Sprintf("RHOAS_HOOK_EVENT=%v", event),
+		fmt.Sprintf("RHOAS_RESOURCE_TYPE=%v", resource.Type),
+		fmt.Sprintf("RHOAS_RESOURCE_ID=%v", resource.ID),
+		fmt.Sprintf("RHOAS_RESOURCE_NAME=%v", resource.Name),
+		fmt.Sprintf("RHOAS_RESOURCE_JSON=%v", string(data)),
+	}, nil
+}
+
+func shellCommand(ctx context.Context, command string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		return exec.CommandContext(ctx, "cmd", "/C", command)
+	}
+	return exec.CommandContext(ctx, "sh", "-c", command)
+}
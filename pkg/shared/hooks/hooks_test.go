@@ -0,0 +1,59 @@
+package hooks
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+)
+
+func TestCommandsForEvent(t *testing.T) {
+	hooksConfig := &config.HooksConfig{
+		PostCreate: []string{"echo created"},
+		PostDelete: []string{"echo deleted"},
+	}
+
+	tests := []struct {
+		name  string
+		hooks *config.HooksConfig
+		event Event
+		want  []string
+	}{
+		{name: "post-create", hooks: hooksConfig, event: PostCreate, want: []string{"echo created"}},
+		{name: "post-delete", hooks: hooksConfig, event: PostDelete, want: []string{"echo deleted"}},
+		{name: "unknown event", hooks: hooksConfig, event: Event("pre-create"), want: nil},
+		{name: "no hooks configured", hooks: nil, event: PostCreate, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := commandsForEvent(tt.hooks, tt.event); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("commandsForEvent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnvironment(t *testing.T) {
+	resource := &Resource{
+		Type: KafkaResource,
+		ID:   "1234",
+		Name: "my-kafka",
+		Data: map[string]string{"status": "ready"},
+	}
+
+	want := []string{
+		"RHOAS_HOOK_EVENT=post-create",
+		"RHOAS_RESOURCE_TYPE=kafka",
+		"RHOAS_RESOURCE_ID=1234",
+		"RHOAS_RESOURCE_NAME=my-kafka",
+		`RHOAS_RESOURCE_JSON={"status":"ready"}`,
+	}
+
+	got, err := environment(PostCreate, resource)
+	if err != nil {
+		t.Fatalf("environment() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("environment() = %v, want %v", got, want)
+	}
+}
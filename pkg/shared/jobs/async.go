@@ -0,0 +1,33 @@
+package jobs
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/color"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+)
+
+// RunAsync runs the current command line as a background job and prints the ID of the job
+func RunAsync(f *factory.Factory) error {
+	store, err := DefaultStore(f.Config)
+	if err != nil {
+		return err
+	}
+
+	job, err := Start(store, StripAsyncFlag(os.Args[1:]))
+	if err != nil {
+		return err
+	}
+
+	f.Logger.Info(icon.SuccessPrefix(), f.Localizer.MustLocalize("job.log.info.started", localize.NewEntry("ID", color.Info(job.ID))))
+	f.Logger.Info(f.Localizer.MustLocalize("job.log.info.monitorHint",
+		localize.NewEntry("LogsCommand", color.CodeSnippet("rhoas job logs --id "+job.ID)),
+		localize.NewEntry("WaitCommand", color.CodeSnippet("rhoas job wait --id "+job.ID)),
+	))
+	fmt.Fprintln(f.IOStreams.Out, job.ID)
+
+	return nil
+}
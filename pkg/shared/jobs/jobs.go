@@ -0,0 +1,183 @@
+// Package jobs runs long commands in a background process and keeps track of their state,
+// so that they can be monitored after the command which started them has returned
+package jobs
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+)
+
+// EnvJobID is the environment variable which holds the ID of the job run by the current process
+const EnvJobID = "RHOAS_JOB_ID"
+
+// Status is the state of a job
+type Status string
+
+const (
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+)
+
+// startTimeout is the time after which a job whose process has not recorded itself is considered failed
+const startTimeout = time.Minute
+
+// ErrNotFound is returned when there is no job with the given ID
+var ErrNotFound = errors.New("job not found")
+
+// Job is a command running in a background process
+type Job struct {
+	ID         string     `json:"id"`
+	Command    string     `json:"command"`
+	Args       []string   `json:"args"`
+	PID        int        `json:"pid,omitempty"`
+	Status     Status     `json:"status"`
+	Error      string     `json:"error,omitempty"`
+	StartedAt  time.Time  `json:"startedAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
+// Store persists the state and logs of jobs in a directory
+type Store struct {
+	dir string
+}
+
+// NewStore creates a store which keeps jobs in the given directory
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// DefaultStore creates a store which keeps jobs in the "jobs" directory next to the config file
+func DefaultStore(cfg config.IConfig) (*Store, error) {
+	location, err := cfg.Location()
+	if err != nil {
+		return nil, err
+	}
+	return NewStore(filepath.Join(filepath.Dir(location), "jobs")), nil
+}
+
+// Save writes the state of the job
+func (s *Store) Save(job *Job) error {
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(job, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// the job is replaced atomically, as it is read by other processes while it runs
+	tmp, err := os.CreateTemp(s.dir, job.ID+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.path(job.ID))
+}
+
+// Get returns the job with the given ID
+func (s *Store) Get(id string) (*Job, error) {
+	job, err := s.read(id)
+	if err != nil {
+		return nil, err
+	}
+	return s.refresh(job)
+}
+
+// List returns all jobs, most recently started first
+func (s *Store) List() ([]Job, error) {
+	matches, err := filepath.Glob(filepath.Join(s.dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]Job, 0, len(matches))
+	for _, match := range matches {
+		job, err := s.Get(filepath.Base(match[:len(match)-len(".json")]))
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, *job)
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].StartedAt.After(jobs[j].StartedAt)
+	})
+
+	return jobs, nil
+}
+
+// LogPath returns the path of the file which holds the output of the job
+func (s *Store) LogPath(id string) string {
+	return filepath.Join(s.dir, id+".log")
+}
+
+func (s *Store) path(id string) string {
+	return filepath.Join(s.dir, id+".json")
+}
+
+func (s *Store) read(id string) (*Job, error) {
+	data, err := os.ReadFile(s.path(id))
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var job Job
+	if err = json.Unmarshal(data, &job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// refresh marks a running job as failed when its process has exited without recording the result,
+// or has not recorded itself within startTimeout
+func (s *Store) refresh(job *Job) (*Job, error) {
+	if job.Status != StatusRunning {
+		return job, nil
+	}
+	if job.PID == 0 && time.Since(job.StartedAt) < startTimeout {
+		return job, nil
+	}
+	if job.PID != 0 && processRunning(job.PID) {
+		return job, nil
+	}
+
+	// the process may have recorded its result after the job was read
+	job, err := s.read(job.ID)
+	if err != nil || job.Status != StatusRunning {
+		return job, err
+	}
+
+	if job.PID == 0 {
+		return job, s.fail(job, "the job process did not start")
+	}
+	return job, s.fail(job, "the job process exited unexpectedly")
+}
+
+// fail records that the job has failed with the given error
+func (s *Store) fail(job *Job, message string) error {
+	now := time.Now()
+	job.Status = StatusFailed
+	job.Error = message
+	job.FinishedAt = &now
+	return s.Save(job)
+}
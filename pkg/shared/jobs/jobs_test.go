@@ -0,0 +1,148 @@
+package jobs
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+)
+
+func TestStore_SaveGetList(t *testing.T) {
+	store := NewStore(t.TempDir())
+
+	jobs, err := store.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 0 {
+		t.Errorf("List() = %v, want no jobs", jobs)
+	}
+
+	if _, err = store.Get("missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() error = %v, want %v", err, ErrNotFound)
+	}
+
+	now := time.Now()
+	older := &Job{ID: "older", Command: "rhoas kafka list", Status: StatusSucceeded, StartedAt: now.Add(-time.Hour)}
+	newer := &Job{ID: "newer", Command: "rhoas kafka create", Status: StatusRunning, PID: os.Getpid(), StartedAt: now}
+	for _, job := range []*Job{older, newer} {
+		if err = store.Save(job); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := store.Get("older")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Command != older.Command || got.Status != older.Status {
+		t.Errorf("Get() = %+v, want %+v", got, older)
+	}
+
+	jobs, err = store.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 2 || jobs[0].ID != "newer" || jobs[1].ID != "older" {
+		t.Errorf("List() = %v, want the newer job first", jobs)
+	}
+	// the process of the test is running, so the job is kept running
+	if jobs[0].Status != StatusRunning {
+		t.Errorf("status of a job with a running process = %v, want %v", jobs[0].Status, StatusRunning)
+	}
+}
+
+func TestStore_Refresh(t *testing.T) {
+	exited := exec.Command(os.Args[0], "-test.run=^$")
+	if err := exited.Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		job        Job
+		wantStatus Status
+	}{
+		{
+			name:       "process exited",
+			job:        Job{PID: exited.Process.Pid, Status: StatusRunning, StartedAt: time.Now()},
+			wantStatus: StatusFailed,
+		},
+		{
+			name:       "process not started yet",
+			job:        Job{Status: StatusRunning, StartedAt: time.Now()},
+			wantStatus: StatusRunning,
+		},
+		{
+			name:       "process not started before the deadline",
+			job:        Job{Status: StatusRunning, StartedAt: time.Now().Add(-2 * startTimeout)},
+			wantStatus: StatusFailed,
+		},
+		{
+			name:       "finished job",
+			job:        Job{PID: exited.Process.Pid, Status: StatusSucceeded, StartedAt: time.Now().Add(-2 * startTimeout)},
+			wantStatus: StatusSucceeded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewStore(t.TempDir())
+			job := tt.job
+			job.ID = "job"
+			if err := store.Save(&job); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := store.Get(job.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Status != tt.wantStatus {
+				t.Errorf("status = %v, want %v", got.Status, tt.wantStatus)
+			}
+			if tt.wantStatus == StatusFailed && (got.Error == "" || got.FinishedAt == nil) {
+				t.Errorf("failed job = %+v, want an error and a finish time", got)
+			}
+
+			// the new status is saved
+			saved, err := store.read(job.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if saved.Status != tt.wantStatus {
+				t.Errorf("saved status = %v, want %v", saved.Status, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestStartedAndFinish(t *testing.T) {
+	store := NewStore(t.TempDir())
+	job := &Job{ID: "job", Status: StatusRunning, StartedAt: time.Now()}
+	if err := store.Save(job); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Started(store, job.ID); err != nil {
+		t.Fatal(err)
+	}
+	got, err := store.Get(job.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.PID != os.Getpid() || got.Status != StatusRunning {
+		t.Errorf("started job = %+v, want the PID of the test and running", got)
+	}
+
+	if err = Finish(store, job.ID, errors.New("boom")); err != nil {
+		t.Fatal(err)
+	}
+	got, err = store.Get(job.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != StatusFailed || got.Error != "boom" || got.FinishedAt == nil {
+		t.Errorf("finished job = %+v, want failed with the error", got)
+	}
+}
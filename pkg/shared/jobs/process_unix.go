@@ -0,0 +1,23 @@
+//go:build !windows
+// +build !windows
+
+package jobs
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// detach starts the process in a new session, so it is not stopped when the terminal is closed
+func detach(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+}
+
+func processRunning(pid int) bool {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	return process.Signal(syscall.Signal(0)) == nil
+}
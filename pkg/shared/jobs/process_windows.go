@@ -0,0 +1,37 @@
+//go:build windows
+// +build windows
+
+package jobs
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// detachedProcess starts the process without a console, so it is not stopped when the terminal is closed
+const detachedProcess = 0x00000008
+
+// processQueryLimitedInformation is the access right needed to read the exit code of a process
+const processQueryLimitedInformation = 0x1000
+
+// stillActive is the exit code of a process which is still running
+const stillActive = 259
+
+func detach(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{CreationFlags: detachedProcess | syscall.CREATE_NEW_PROCESS_GROUP}
+}
+
+func processRunning(pid int) bool {
+	handle, err := syscall.OpenProcess(processQueryLimitedInformation, false, uint32(pid))
+	if err != nil {
+		// the process exists but belongs to another user
+		return err == syscall.ERROR_ACCESS_DENIED
+	}
+	defer syscall.CloseHandle(handle)
+
+	var exitCode uint32
+	if err = syscall.GetExitCodeProcess(handle, &exitCode); err != nil {
+		return false
+	}
+	return exitCode == stillActive
+}
@@ -0,0 +1,109 @@
+package jobs
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// AsyncFlagName is the name of the flag which runs a command as a background job
+const AsyncFlagName = "async"
+
+// Start runs the CLI with the given arguments in a new background process.
+// The output of the process is written to the log file of the job.
+func Start(store *Store, args []string) (*Job, error) {
+	executable, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+
+	job := &Job{
+		ID:        id,
+		Command:   strings.Join(append([]string{"rhoas"}, args...), " "),
+		Args:      args,
+		Status:    StatusRunning,
+		StartedAt: time.Now(),
+	}
+	// the job is saved before the process starts, as the process updates it
+	if err = store.Save(job); err != nil {
+		return nil, err
+	}
+
+	logFile, err := os.Create(store.LogPath(id))
+	if err != nil {
+		_ = store.fail(job, err.Error())
+		return nil, err
+	}
+	defer logFile.Close()
+
+	cmd := exec.Command(executable, args...)
+	cmd.Env = append(os.Environ(), EnvJobID+"="+id)
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	detach(cmd)
+
+	if err = cmd.Start(); err != nil {
+		_ = store.fail(job, err.Error())
+		return nil, err
+	}
+
+	return job, cmd.Process.Release()
+}
+
+// Started records the process of the job run by the current process
+func Started(store *Store, id string) error {
+	job, err := store.read(id)
+	if err != nil {
+		return err
+	}
+
+	job.PID = os.Getpid()
+	return store.Save(job)
+}
+
+// Finish records the result of the job run by the current process
+func Finish(store *Store, id string, cmdErr error) error {
+	job, err := store.read(id)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	job.FinishedAt = &now
+	job.Status = StatusSucceeded
+	if cmdErr != nil {
+		job.Status = StatusFailed
+		job.Error = cmdErr.Error()
+	}
+
+	return store.Save(job)
+}
+
+// StripAsyncFlag removes the async flag from the command line arguments,
+// so that the background process runs the command in the foreground
+func StripAsyncFlag(args []string) []string {
+	stripped := make([]string, 0, len(args))
+	for _, arg := range args {
+		if arg == "--"+AsyncFlagName || strings.HasPrefix(arg, "--"+AsyncFlagName+"=") {
+			continue
+		}
+		stripped = append(stripped, arg)
+	}
+	return stripped
+}
+
+func newID() (string, error) {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
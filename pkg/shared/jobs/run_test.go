@@ -0,0 +1,37 @@
+package jobs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStripAsyncFlag(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "flag",
+			args: []string{"service-registry", "artifact", "export", "--async", "--output-file", "export.zip"},
+			want: []string{"service-registry", "artifact", "export", "--output-file", "export.zip"},
+		},
+		{
+			name: "flag with value",
+			args: []string{"kafka", "wait-for", "--async=true"},
+			want: []string{"kafka", "wait-for"},
+		},
+		{
+			name: "similar flag names are kept",
+			args: []string{"kafka", "wait-for", "--asynchronous"},
+			want: []string{"kafka", "wait-for", "--asynchronous"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StripAsyncFlag(tt.args); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StripAsyncFlag() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,9 +2,9 @@ package kafkautil
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 
+	"github.com/redhat-developer/app-services-cli/pkg/shared/searchutil"
 	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
 	kafkamgmtv1errors "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/error"
 )
@@ -22,7 +22,7 @@ func GetKafkaByID(ctx context.Context, api kafkamgmtclient.DefaultApi, id string
 
 func GetKafkaByName(ctx context.Context, api kafkamgmtclient.DefaultApi, name string) (*kafkamgmtclient.KafkaRequest, *http.Response, error) {
 	r := api.GetKafkas(ctx)
-	r = r.Search(fmt.Sprintf("name = %v", name))
+	r = r.Search(searchutil.Equals("name", name))
 	kafkaList, httpResponse, err := r.Execute()
 	if err != nil {
 		return nil, httpResponse, err
@@ -37,3 +37,21 @@ func GetKafkaByName(ctx context.Context, api kafkamgmtclient.DefaultApi, name st
 
 	return &kafkaReq, httpResponse, err
 }
+
+// ListKafkas returns all Kafka instances visible to the user, optionally filtered by a search query
+func ListKafkas(ctx context.Context, api kafkamgmtclient.DefaultApi, search string) ([]kafkamgmtclient.KafkaRequest, error) {
+	r := api.GetKafkas(ctx).Size(queryLimit)
+	if search != "" {
+		r = r.Search(search)
+	}
+
+	kafkaList, httpRes, err := r.Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return kafkaList.GetItems(), nil
+}
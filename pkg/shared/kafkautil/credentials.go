@@ -0,0 +1,25 @@
+package kafkautil
+
+import (
+	"net/url"
+)
+
+// SASLMechanisms are the SASL mechanisms accepted by the Kafka instances
+var SASLMechanisms = []string{"PLAIN", "OAUTHBEARER"}
+
+// tokenPath is the path of the OAuth token endpoint of the authentication server
+const tokenPath = "/protocol/openid-connect/token"
+
+// CredentialsHint is the information needed to configure the authentication of a Kafka client
+type CredentialsHint struct {
+	SASLMechanisms []string `json:"sasl_mechanisms" yaml:"sasl_mechanisms"`
+	OAuthTokenURL  string   `json:"oauth_token_url" yaml:"oauth_token_url"`
+}
+
+// NewCredentialsHint creates the credentials hint for the authentication server at authURL
+func NewCredentialsHint(authURL *url.URL) *CredentialsHint {
+	return &CredentialsHint{
+		SASLMechanisms: SASLMechanisms,
+		OAuthTokenURL:  authURL.String() + tokenPath,
+	}
+}
@@ -0,0 +1,77 @@
+package kafkautil
+
+import (
+	"context"
+
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+// InstanceLimits describes the limits enforced on a Kafka instance by its size
+type InstanceLimits struct {
+	SizeID                      string `json:"size_id" yaml:"size_id"`
+	MaxPartitions               int32  `json:"max_partitions" yaml:"max_partitions"`
+	TotalMaxConnections         int32  `json:"total_max_connections" yaml:"total_max_connections"`
+	MaxConnectionAttemptsPerSec int32  `json:"max_connection_attempts_per_sec" yaml:"max_connection_attempts_per_sec"`
+	IngressThroughputPerSec     int64  `json:"ingress_throughput_per_sec_bytes" yaml:"ingress_throughput_per_sec_bytes"`
+	EgressThroughputPerSec      int64  `json:"egress_throughput_per_sec_bytes" yaml:"egress_throughput_per_sec_bytes"`
+	MaxDataRetentionSize        int64  `json:"max_data_retention_size_bytes" yaml:"max_data_retention_size_bytes"`
+	MaxDataRetentionPeriod      string `json:"max_data_retention_period,omitempty" yaml:"max_data_retention_period,omitempty"`
+	MaxMessageSize              int64  `json:"max_message_size_bytes" yaml:"max_message_size_bytes"`
+}
+
+// GetKafkaSize fetches the supported size definition of the given Kafka instance
+// from the instance types API. Returns nil if the size could not be found.
+func GetKafkaSize(ctx context.Context, api kafkamgmtclient.DefaultApi, kafkaInstance *kafkamgmtclient.KafkaRequest) (*kafkamgmtclient.SupportedKafkaSize, error) {
+	instanceTypes, httpRes, err := api.GetInstanceTypesByCloudProviderAndRegion(ctx, kafkaInstance.GetCloudProvider(), kafkaInstance.GetRegion()).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, instanceType := range instanceTypes.GetInstanceTypes() {
+		if instanceType.GetId() != kafkaInstance.GetInstanceType() {
+			continue
+		}
+		sizes := instanceType.GetSizes()
+		for i := range sizes {
+			if sizes[i].GetId() == kafkaInstance.GetSizeId() {
+				return &sizes[i], nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// GetKafkaInstanceLimits returns the limits of the size used by the given Kafka instance.
+// Returns nil if the size could not be found.
+func GetKafkaInstanceLimits(ctx context.Context, api kafkamgmtclient.DefaultApi, kafkaInstance *kafkamgmtclient.KafkaRequest) (*InstanceLimits, error) {
+	size, err := GetKafkaSize(ctx, api, kafkaInstance)
+	if err != nil || size == nil {
+		return nil, err
+	}
+
+	return NewInstanceLimits(size), nil
+}
+
+// NewInstanceLimits maps a supported Kafka size to its instance limits
+func NewInstanceLimits(size *kafkamgmtclient.SupportedKafkaSize) *InstanceLimits {
+	ingress := size.GetIngressThroughputPerSec()
+	egress := size.GetEgressThroughputPerSec()
+	retentionSize := size.GetMaxDataRetentionSize()
+	messageSize := size.GetMaxMessageSize()
+
+	return &InstanceLimits{
+		SizeID:                      size.GetId(),
+		MaxPartitions:               size.GetMaxPartitions(),
+		TotalMaxConnections:         size.GetTotalMaxConnections(),
+		MaxConnectionAttemptsPerSec: size.GetMaxConnectionAttemptsPerSec(),
+		IngressThroughputPerSec:     ingress.GetBytes(),
+		EgressThroughputPerSec:      egress.GetBytes(),
+		MaxDataRetentionSize:        retentionSize.GetBytes(),
+		MaxDataRetentionPeriod:      size.GetMaxDataRetentionPeriod(),
+		MaxMessageSize:              messageSize.GetBytes(),
+	}
+}
@@ -0,0 +1,58 @@
+package kafkautil
+
+import (
+	"context"
+	"math"
+
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+// TopicSizeMetric is the metric reporting the bytes retained on disk by a topic partition
+const TopicSizeMetric = "kafka_log_log_size"
+
+// GetTopicSizes returns the bytes retained on disk by each topic of the Kafka instance,
+// summed across its partitions. Each partition is counted once, with the size of its largest replica,
+// so that the sizes do not grow with the replication factor.
+func GetTopicSizes(ctx context.Context, api kafkamgmtclient.DefaultApi, kafkaID string) (map[string]int64, error) {
+	metrics, httpRes, err := api.GetMetricsByInstantQuery(ctx, kafkaID).Filters([]string{TopicSizeMetric}).Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return topicSizes(metrics.GetItems()), nil
+}
+
+// topicSizes sums the largest replica size of each partition of the topics
+func topicSizes(items []kafkamgmtclient.InstantQuery) map[string]int64 {
+	type partition struct {
+		topic string
+		id    string
+	}
+
+	partitionSizes := map[partition]int64{}
+	for _, item := range items {
+		labels := item.GetMetric()
+		if labels["__name__"] != "" && labels["__name__"] != TopicSizeMetric {
+			continue
+		}
+		topic, ok := labels["topic"]
+		if !ok {
+			continue
+		}
+
+		p := partition{topic: topic, id: labels["partition"]}
+		if size := int64(math.Round(item.GetValue())); size > partitionSizes[p] {
+			partitionSizes[p] = size
+		}
+	}
+
+	sizes := map[string]int64{}
+	for p, size := range partitionSizes {
+		sizes[p.topic] += size
+	}
+
+	return sizes
+}
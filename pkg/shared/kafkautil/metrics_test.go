@@ -0,0 +1,35 @@
+package kafkautil
+
+import (
+	"reflect"
+	"testing"
+
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+func sizeItem(labels map[string]string, value float64) kafkamgmtclient.InstantQuery {
+	return kafkamgmtclient.InstantQuery{Metric: &labels, Value: value}
+}
+
+func Test_topicSizes(t *testing.T) {
+	items := []kafkamgmtclient.InstantQuery{
+		// three replicas of partition 0 of "orders", one of them lagging behind
+		sizeItem(map[string]string{"__name__": TopicSizeMetric, "topic": "orders", "partition": "0", "broker": "0"}, 100),
+		sizeItem(map[string]string{"__name__": TopicSizeMetric, "topic": "orders", "partition": "0", "broker": "1"}, 100),
+		sizeItem(map[string]string{"__name__": TopicSizeMetric, "topic": "orders", "partition": "0", "broker": "2"}, 90),
+		// three replicas of partition 1 of "orders"
+		sizeItem(map[string]string{"__name__": TopicSizeMetric, "topic": "orders", "partition": "1", "broker": "0"}, 50),
+		sizeItem(map[string]string{"__name__": TopicSizeMetric, "topic": "orders", "partition": "1", "broker": "1"}, 50),
+		sizeItem(map[string]string{"__name__": TopicSizeMetric, "topic": "orders", "partition": "1", "broker": "2"}, 50),
+		// the name of the metric is optional
+		sizeItem(map[string]string{"topic": "payments", "partition": "0"}, 10.4),
+		// other metrics and metrics without topic are ignored
+		sizeItem(map[string]string{"__name__": "kafka_topic_partitions", "topic": "orders", "partition": "0"}, 3),
+		sizeItem(map[string]string{"__name__": TopicSizeMetric, "partition": "0"}, 1000),
+	}
+
+	want := map[string]int64{"orders": 150, "payments": 10}
+	if got := topicSizes(items); !reflect.DeepEqual(got, want) {
+		t.Errorf("topicSizes() = %v, want %v", got, want)
+	}
+}
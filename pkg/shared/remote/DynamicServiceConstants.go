@@ -9,6 +9,17 @@ type DynamicServiceConstants struct {
 	ServiceRegistry struct {
 		Ams AmsConfig `json:"ams"`
 	} `json:"serviceRegistry"`
+	CLI CliConfig `json:"cli"`
+}
+
+// CliConfig is a struct that contains the CLI versions supported by the API.
+//
+// The "cli" section is a placeholder: the published service constants do not define it yet,
+// so MinVersion is empty and the version check is skipped until the service starts publishing it.
+// The deprecation headers returned by the API are the source of the warnings in the meantime.
+type CliConfig struct {
+	// MinVersion is the oldest CLI version which is known to work with the API
+	MinVersion string `json:"minVersion"`
 }
 
 // AmsConfig is a struct that contains the AMS configuration
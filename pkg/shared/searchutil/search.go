@@ -0,0 +1,78 @@
+package searchutil
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var validSearchRegexp = regexp.MustCompile(`^[a-zA-Z0-9 ._%-]+$`)
+
+var valueEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+// SearchQuery builds the search parameter of the management APIs.
+// Values are always quoted, so user input cannot add comparisons or joins to the query.
+type SearchQuery struct {
+	input   string
+	filters []string
+}
+
+// NewSearchQuery creates a query matching the given input against the filtered fields
+func NewSearchQuery(input string) *SearchQuery {
+	return &SearchQuery{
+		input:   input,
+		filters: make([]string, 0),
+	}
+}
+
+// Filter adds a field which the input is matched against
+func (sq *SearchQuery) Filter(field string) *SearchQuery {
+	sq.filters = append(sq.filters, field)
+	return sq
+}
+
+// Build returns a query matching any of the fields which contain the input
+func (sq *SearchQuery) Build() string {
+	value := Quote("%" + sq.input + "%")
+
+	clauses := make([]string, len(sq.filters))
+	for i, field := range sq.filters {
+		clauses[i] = fmt.Sprintf("%v like %v", field, value)
+	}
+
+	return strings.Join(clauses, " or ")
+}
+
+// Equals returns a query matching the field exactly against the value
+func Equals(field string, value string) string {
+	return fmt.Sprintf("%v = %v", field, Quote(value))
+}
+
+// HasPrefix returns a query matching the fields starting with the value
+func HasPrefix(field string, value string) string {
+	return fmt.Sprintf("%v like %v", field, Quote(value+"%"))
+}
+
+// Quote wraps the value in single quotes, escaping any quotes it contains
+func Quote(value string) string {
+	return "'" + valueEscaper.Replace(value) + "'"
+}
+
+// ValidateSearchInput checks that the search input only contains characters accepted by the management APIs
+func ValidateSearchInput(search string) error {
+	if validSearchRegexp.MatchString(search) {
+		return nil
+	}
+
+	return InvalidSearchValueError(search)
+}
+
+// InvalidSearchValueError is returned when the search input contains characters
+// which are not accepted by the management APIs
+func InvalidSearchValueError(search string) error {
+	return fmt.Errorf(`illegal search value "%v", search input must satisfy the following conditions:
+
+  - must be of 1 or more characters
+  - must only consist of alphanumeric characters, spaces, '-', '_', '.' and '%%'
+	`, search)
+}
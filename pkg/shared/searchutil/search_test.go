@@ -0,0 +1,80 @@
+package searchutil
+
+import "testing"
+
+func TestSearchQueryBuild(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		filters []string
+		want    string
+	}{
+		{
+			name:    "should match a single field",
+			input:   "my-kafka",
+			filters: []string{"name"},
+			want:    "name like '%my-kafka%'",
+		},
+		{
+			name:    "should join multiple fields with or",
+			input:   "kafka",
+			filters: []string{"name", "owner"},
+			want:    "name like '%kafka%' or owner like '%kafka%'",
+		},
+		{
+			name:    "should keep joins in the input inside the value",
+			input:   "a or owner like b",
+			filters: []string{"name"},
+			want:    "name like '%a or owner like b%'",
+		},
+		{
+			name:    "should escape quotes in the input",
+			input:   `it's`,
+			filters: []string{"name"},
+			want:    `name like '%it\'s%'`,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint
+		t.Run(tt.name, func(t *testing.T) {
+			q := NewSearchQuery(tt.input)
+			for _, f := range tt.filters {
+				q = q.Filter(f)
+			}
+			if got := q.Build(); got != tt.want {
+				t.Errorf("Build() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEquals(t *testing.T) {
+	if got, want := Equals("name", `my'kafka\`), `name = 'my\'kafka\\'`; got != want {
+		t.Errorf("Equals() = %v, want %v", got, want)
+	}
+}
+
+func TestValidateSearchInput(t *testing.T) {
+	tests := []struct {
+		name    string
+		search  string
+		wantErr bool
+	}{
+		{name: "should be valid with alphanumeric characters", search: "Kafka1", wantErr: false},
+		{name: "should be valid with spaces and dots", search: "Amazon S3 v1.0", wantErr: false},
+		{name: "should be valid with wildcard", search: "kaf%", wantErr: false},
+		{name: "should be invalid when empty", search: "", wantErr: true},
+		{name: "should be invalid with quotes", search: "kafka'", wantErr: true},
+		{name: "should be invalid with special characters", search: "search*instance", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		// nolint
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateSearchInput(tt.search); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSearchInput() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -2,9 +2,9 @@ package serviceregistryutil
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 
+	"github.com/redhat-developer/app-services-cli/pkg/shared/searchutil"
 	srsmgmtv1 "github.com/redhat-developer/app-services-sdk-go/registrymgmt/apiv1/client"
 	srsmgmtv1errors "github.com/redhat-developer/app-services-sdk-go/registrymgmt/apiv1/error"
 )
@@ -20,9 +20,14 @@ func GetServiceRegistryByID(ctx context.Context, api srsmgmtv1.RegistriesApi, re
 	return &registry, nil, err
 }
 
+// NameQuery returns the search query matching the Service Registry instance with the given name
+func NameQuery(name string) string {
+	return searchutil.Equals("name", name)
+}
+
 func GetServiceRegistryByName(ctx context.Context, api srsmgmtv1.RegistriesApi, name string) (*srsmgmtv1.Registry, *http.Response, error) {
 	r := api.GetRegistries(ctx)
-	r = r.Search(fmt.Sprintf("name=%v", name))
+	r = r.Search(NameQuery(name))
 	registryList, httpResponse, err := r.Execute()
 	if registryList.GetTotal() == 0 {
 		return nil, nil, NotFoundByNameError(name)
@@ -33,3 +38,21 @@ func GetServiceRegistryByName(ctx context.Context, api srsmgmtv1.RegistriesApi,
 
 	return &registryReq, httpResponse, err
 }
+
+// ListServiceRegistries returns all Service Registry instances visible to the user, optionally filtered by a search query
+func ListServiceRegistries(ctx context.Context, api srsmgmtv1.RegistriesApi, search string) ([]srsmgmtv1.Registry, error) {
+	r := api.GetRegistries(ctx).Size(queryLimit)
+	if search != "" {
+		r = r.Search(search)
+	}
+
+	registryList, httpRes, err := r.Execute()
+	if httpRes != nil {
+		defer httpRes.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return registryList.GetItems(), nil
+}
@@ -2,17 +2,26 @@ package svcstatus
 
 type ServiceStatus = string
 
-// accepted, preparing, provisioning, ready, failed, deprovision, deleting
+// accepted, preparing, provisioning, ready, failed, deprovision, deleting, suspending, suspended, resuming
 const (
 	StatusAccepted     ServiceStatus = "accepted"
 	StatusPreparing    ServiceStatus = "preparing"
 	StatusProvisioning ServiceStatus = "provisioning"
+	StatusReady        ServiceStatus = "ready"
 	StatusFailed       ServiceStatus = "failed"
 	StatusDeprovision  ServiceStatus = "deprovision"
 	StatusDeleting     ServiceStatus = "deleting"
+	StatusSuspending   ServiceStatus = "suspending"
+	StatusSuspended    ServiceStatus = "suspended"
+	StatusResuming     ServiceStatus = "resuming"
 )
 
 // IsInstanceCreating returns whether the Kafka instance is still being created
 func IsInstanceCreating(status string) bool {
 	return status == StatusAccepted || status == StatusPreparing || status == StatusProvisioning
 }
+
+// IsInstanceSuspended returns whether the Kafka instance is suspended or being suspended
+func IsInstanceSuspended(status string) bool {
+	return status == StatusSuspending || status == StatusSuspended
+}
@@ -0,0 +1,107 @@
+// Package versioncheck warns when the CLI is older than the version supported by the API.
+//
+// The supported version is read from the "cli.minVersion" key of the service constants, which is a placeholder
+// the service does not publish yet: until it does, no CLI version is reported as outdated.
+package versioncheck
+
+import (
+	"context"
+	"time"
+
+	"github.com/blang/semver"
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/remote"
+)
+
+// fetchTimeout is the maximum time spent fetching the supported version in the background
+const fetchTimeout = 3 * time.Second
+
+// Check is the result of a check of the oldest CLI version supported by the API
+type Check struct {
+	// MinVersion is the oldest supported version, empty when it is not known
+	MinVersion string
+	// Cached is true when the version was read from the config instead of being fetched
+	Cached bool
+	// Err is the reason why the version could not be fetched
+	Err error
+}
+
+// CheckOnceADay returns the oldest CLI version supported by the API.
+// The version cached in the config is returned when it was checked during the last day,
+// otherwise it is fetched from the service constants in the background and must be saved with Save.
+func CheckOnceADay(f *factory.Factory) <-chan Check {
+	checks := make(chan Check, 1)
+
+	cfg, err := f.Config.Load()
+	if err != nil {
+		close(checks)
+		return checks
+	}
+
+	if cfg.VersionCheck != nil && cfg.VersionCheck.CheckedAt >= time.Now().AddDate(0, 0, -1).UnixMilli() {
+		checks <- Check{MinVersion: cfg.VersionCheck.MinVersion, Cached: true}
+		close(checks)
+		return checks
+	}
+
+	go func() {
+		defer close(checks)
+
+		ctx, cancel := context.WithTimeout(f.Context, fetchTimeout)
+		defer cancel()
+
+		err, constants := remote.GetRemoteServiceConstants(ctx, f.Logger)
+		if err != nil {
+			checks <- Check{Err: err}
+			return
+		}
+		checks <- Check{MinVersion: constants.CLI.MinVersion}
+	}()
+
+	return checks
+}
+
+// Save records the time of a check which was not read from the cache, so the next check is done a day later.
+// Failed checks are recorded too, keeping the version of the last successful check,
+// so that commands run without access to the service constants do not retry on every run.
+func Save(f *factory.Factory, check Check) error {
+	if check.Cached {
+		return nil
+	}
+
+	cfg, err := f.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	minVersion := check.MinVersion
+	if check.Err != nil && cfg.VersionCheck != nil {
+		minVersion = cfg.VersionCheck.MinVersion
+	}
+
+	cfg.VersionCheck = &config.VersionCheckConfig{
+		CheckedAt:  time.Now().UnixMilli(),
+		MinVersion: minVersion,
+	}
+	return f.Config.Save(cfg)
+}
+
+// IsOutdated returns true if the version is older than the minimum version.
+// Versions which cannot be compared, such as dev builds, are never outdated.
+func IsOutdated(version string, minVersion string) bool {
+	if minVersion == "" {
+		return false
+	}
+
+	current, err := semver.ParseTolerant(version)
+	if err != nil {
+		return false
+	}
+	min, err := semver.ParseTolerant(minVersion)
+	if err != nil {
+		return false
+	}
+
+	return current.LT(min)
+}
@@ -0,0 +1,88 @@
+package versioncheck
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/config"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory/fakes"
+)
+
+func TestIsOutdated(t *testing.T) {
+	tests := []struct {
+		name       string
+		version    string
+		minVersion string
+		want       bool
+	}{
+		{name: "older version", version: "0.38.0", minVersion: "0.40.0", want: true},
+		{name: "same version", version: "0.40.0", minVersion: "0.40.0", want: false},
+		{name: "newer version", version: "0.41.2", minVersion: "0.40.0", want: false},
+		{name: "v prefix", version: "v0.38.0", minVersion: "v0.40.0", want: true},
+		{name: "no minimum version", version: "0.38.0", minVersion: "", want: false},
+		{name: "dev build", version: "dev", minVersion: "0.40.0", want: false},
+		{name: "invalid minimum version", version: "0.38.0", minVersion: "latest", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsOutdated(tt.version, tt.minVersion); got != tt.want {
+				t.Errorf("IsOutdated() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckOnceADay_cached(t *testing.T) {
+	f := fakes.NewFactory(t)
+	cfg := &config.Config{VersionCheck: &config.VersionCheckConfig{CheckedAt: time.Now().UnixMilli(), MinVersion: "0.40.0"}}
+	if err := f.Config.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	check, ok := <-CheckOnceADay(f.Factory)
+	if !ok || !check.Cached || check.MinVersion != "0.40.0" {
+		t.Errorf("CheckOnceADay() = %+v, want the cached version", check)
+	}
+}
+
+func TestSave(t *testing.T) {
+	yesterday := time.Now().AddDate(0, 0, -2).UnixMilli()
+
+	tests := []struct {
+		name    string
+		check   Check
+		want    string
+		checked bool
+	}{
+		{name: "fetched", check: Check{MinVersion: "0.41.0"}, want: "0.41.0", checked: true},
+		{name: "not published", check: Check{}, want: "", checked: true},
+		{name: "failed", check: Check{Err: errors.New("timeout")}, want: "0.40.0", checked: true},
+		{name: "cached", check: Check{MinVersion: "0.40.0", Cached: true}, want: "0.40.0", checked: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := fakes.NewFactory(t)
+			cfg := &config.Config{VersionCheck: &config.VersionCheckConfig{CheckedAt: yesterday, MinVersion: "0.40.0"}}
+			if err := f.Config.Save(cfg); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := Save(f.Factory, tt.check); err != nil {
+				t.Fatal(err)
+			}
+
+			cfg, err := f.Config.Load()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cfg.VersionCheck.MinVersion != tt.want {
+				t.Errorf("MinVersion = %q, want %q", cfg.VersionCheck.MinVersion, tt.want)
+			}
+			// failed checks are recorded too, so they are not retried on every command
+			if checked := cfg.VersionCheck.CheckedAt > yesterday; checked != tt.checked {
+				t.Errorf("CheckedAt updated = %v, want %v", checked, tt.checked)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package waitutil
+
+import (
+	"errors"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/color"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/icon"
+	"github.com/redhat-developer/app-services-cli/pkg/core/ioutil/spinner"
+	"github.com/redhat-developer/app-services-cli/pkg/core/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/shared/factory"
+)
+
+// UntilWithSpinner polls the resource until it satisfies the condition,
+// showing the current value of the condition field in a spinner
+func UntilWithSpinner(f *factory.Factory, name string, fetch FetchFunc, condition *Condition, opts Options) (interface{}, error) {
+	nameEntry := localize.NewEntry("Name", name)
+	conditionEntry := localize.NewEntry("Condition", condition.String())
+
+	s := spinner.New(f.IOStreams.ErrOut, f.Localizer)
+	s.SetLocalizedSuffix("waitFor.log.info.waiting", nameEntry, conditionEntry)
+	s.Start()
+
+	opts.OnPoll = func(value string) {
+		f.Logger.Debug("Current value of", condition.Field, "is", value)
+		s.SetLocalizedSuffix("waitFor.log.info.polling", nameEntry, conditionEntry,
+			localize.NewEntry("Field", condition.Field),
+			localize.NewEntry("Value", color.Info(value)),
+		)
+	}
+
+	resource, err := Until(f.Context, fetch, condition, opts)
+	s.Stop()
+
+	var timeoutErr *TimeoutError
+	if errors.As(err, &timeoutErr) {
+		return nil, f.Localizer.MustLocalizeError("waitFor.error.timeout", nameEntry, conditionEntry,
+			localize.NewEntry("Timeout", timeoutErr.Timeout),
+			localize.NewEntry("Field", condition.Field),
+			localize.NewEntry("Value", timeoutErr.LastValue),
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	f.Logger.Info(icon.SuccessPrefix(), f.Localizer.MustLocalize("waitFor.log.info.success", nameEntry, conditionEntry))
+
+	return resource, nil
+}
@@ -0,0 +1,147 @@
+// Package waitutil polls service resources until they satisfy a condition
+package waitutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/core/httputil"
+)
+
+// Condition is satisfied when a field of a resource has the expected value.
+// Nested fields are separated by dots, for example "status.state".
+type Condition struct {
+	Field string
+	Value string
+}
+
+// ParseCondition parses a condition in the "field=value" format
+func ParseCondition(condition string) (*Condition, error) {
+	field, value, ok := strings.Cut(condition, "=")
+	field, value = strings.TrimSpace(field), strings.TrimSpace(value)
+	if !ok || field == "" || value == "" {
+		return nil, fmt.Errorf(`invalid condition "%v": condition must be in the format "field=value"`, condition)
+	}
+
+	return &Condition{Field: field, Value: value}, nil
+}
+
+func (c *Condition) String() string {
+	return c.Field + "=" + c.Value
+}
+
+// CurrentValue returns the value of the condition field in the resource,
+// or an empty string if the resource does not have the field
+func (c *Condition) CurrentValue(resource interface{}) (string, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return "", err
+	}
+
+	var value interface{}
+	if err = json.Unmarshal(data, &value); err != nil {
+		return "", err
+	}
+
+	for _, key := range strings.Split(c.Field, ".") {
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			return "", nil
+		}
+		if value, ok = fields[key]; !ok || value == nil {
+			return "", nil
+		}
+	}
+
+	return fmt.Sprint(value), nil
+}
+
+// Matches returns whether the resource satisfies the condition
+func (c *Condition) Matches(resource interface{}) (bool, string, error) {
+	value, err := c.CurrentValue(resource)
+	if err != nil {
+		return false, "", err
+	}
+
+	return strings.EqualFold(value, c.Value), value, nil
+}
+
+// TimeoutError is returned when the condition was not satisfied before the timeout
+type TimeoutError struct {
+	Condition *Condition
+	Timeout   time.Duration
+	LastValue string
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf(`timed out after %v waiting for condition "%v", current value is "%v"`, e.Timeout, e.Condition, e.LastValue)
+}
+
+// FetchFunc fetches the current state of the resource
+type FetchFunc func(ctx context.Context) (interface{}, error)
+
+// Options configures how a resource is polled
+type Options struct {
+	// Interval is the time to wait between two polls
+	Interval time.Duration
+	// Timeout is the maximum time to wait for the condition
+	Timeout time.Duration
+	// OnPoll is called with the current value of the condition field after each poll
+	OnPoll func(value string)
+	// Abort is called when the resource does not satisfy the condition yet.
+	// Returning an error stops the polling, for example when the resource has failed.
+	Abort func(resource interface{}) error
+}
+
+// Until polls the resource until it satisfies the condition, the context is done or the timeout expires.
+// It returns the last fetched state of the resource.
+func Until(ctx context.Context, fetch FetchFunc, condition *Condition, opts Options) (interface{}, error) {
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+	// every poll must see the current state of the resource
+	ctx = httputil.WithoutCache(ctx)
+
+	ticker := time.NewTicker(opts.Interval)
+	defer ticker.Stop()
+
+	var lastValue string
+	for {
+		resource, err := fetch(ctx)
+		if err != nil {
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, &TimeoutError{Condition: condition, Timeout: opts.Timeout, LastValue: lastValue}
+			}
+			return nil, err
+		}
+
+		matches, value, err := condition.Matches(resource)
+		if err != nil {
+			return nil, err
+		}
+		lastValue = value
+		if opts.OnPoll != nil {
+			opts.OnPoll(value)
+		}
+		if matches {
+			return resource, nil
+		}
+		if opts.Abort != nil {
+			if err = opts.Abort(resource); err != nil {
+				return resource, err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return resource, &TimeoutError{Condition: condition, Timeout: opts.Timeout, LastValue: lastValue}
+			}
+			return resource, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
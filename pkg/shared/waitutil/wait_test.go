@@ -0,0 +1,108 @@
+package waitutil
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type resource struct {
+	Status string `json:"status"`
+	Nested *struct {
+		State string `json:"state"`
+	} `json:"nested,omitempty"`
+}
+
+func TestParseCondition(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition string
+		want      Condition
+		wantErr   bool
+	}{
+		{name: "should parse field and value", condition: "status=ready", want: Condition{Field: "status", Value: "ready"}},
+		{name: "should trim spaces", condition: " status.state = ready ", want: Condition{Field: "status.state", Value: "ready"}},
+		{name: "should fail without separator", condition: "ready", wantErr: true},
+		{name: "should fail without value", condition: "status=", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		// nolint
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCondition(tt.condition)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCondition() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && *got != tt.want {
+				t.Errorf("ParseCondition() = %v, want %v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConditionMatches(t *testing.T) {
+	r := resource{Status: "Ready"}
+	r.Nested = &struct {
+		State string `json:"state"`
+	}{State: "provisioning"}
+
+	tests := []struct {
+		name      string
+		condition Condition
+		want      bool
+		wantValue string
+	}{
+		{name: "should match ignoring case", condition: Condition{Field: "status", Value: "ready"}, want: true, wantValue: "Ready"},
+		{name: "should read nested fields", condition: Condition{Field: "nested.state", Value: "ready"}, want: false, wantValue: "provisioning"},
+		{name: "should not match missing fields", condition: Condition{Field: "missing.state", Value: "ready"}, want: false, wantValue: ""},
+	}
+
+	for _, tt := range tests {
+		// nolint
+		t.Run(tt.name, func(t *testing.T) {
+			got, value, err := tt.condition.Matches(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want || value != tt.wantValue {
+				t.Errorf("Matches() = %v, %v, want %v, %v", got, value, tt.want, tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestUntil(t *testing.T) {
+	condition := &Condition{Field: "status", Value: "ready"}
+	opts := Options{Interval: time.Millisecond, Timeout: time.Second}
+
+	polls := 0
+	fetch := func(ctx context.Context) (interface{}, error) {
+		polls++
+		if polls < 3 {
+			return resource{Status: "provisioning"}, nil
+		}
+		return resource{Status: "ready"}, nil
+	}
+
+	if _, err := Until(context.Background(), fetch, condition, opts); err != nil {
+		t.Fatalf("Until() error = %v", err)
+	}
+	if polls != 3 {
+		t.Errorf("Until() polled %v times, want 3", polls)
+	}
+
+	never := func(ctx context.Context) (interface{}, error) {
+		return resource{Status: "provisioning"}, nil
+	}
+	opts.Timeout = 10 * time.Millisecond
+
+	_, err := Until(context.Background(), never, condition, opts)
+	var timeoutErr *TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("Until() error = %v, want TimeoutError", err)
+	}
+	if timeoutErr.LastValue != "provisioning" {
+		t.Errorf("TimeoutError.LastValue = %v, want provisioning", timeoutErr.LastValue)
+	}
+}